@@ -21,7 +21,8 @@
 package blockstore
 
 const (
-	DefaultAvailabilityWindow = 8192
+	DefaultAvailabilityWindow      = 8192
+	DefaultReconstructionCacheSize = 64
 )
 
 // Config is the configuration for the block service.
@@ -32,13 +33,21 @@ type Config struct {
 	PrunerEnabled bool `mapstructure:"pruner-enabled"`
 	// AvailabilityWindow is the number of slots to keep in the store.
 	AvailabilityWindow uint64 `mapstructure:"availability-window"`
+	// Blinded stores blocks with their execution payload replaced by its
+	// header, reconstructing the payload from the execution client on read.
+	Blinded bool `mapstructure:"blinded"`
+	// ReconstructionCacheSize is the number of payload bodies to keep in
+	// the reconstruction cache.
+	ReconstructionCacheSize int `mapstructure:"reconstruction-cache-size"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
 func DefaultConfig() Config {
 	return Config{
-		Enabled:            false,
-		PrunerEnabled:      false,
-		AvailabilityWindow: DefaultAvailabilityWindow,
+		Enabled:                 false,
+		PrunerEnabled:           false,
+		AvailabilityWindow:      DefaultAvailabilityWindow,
+		Blinded:                 false,
+		ReconstructionCacheSize: DefaultReconstructionCacheSize,
 	}
 }
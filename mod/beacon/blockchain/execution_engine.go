@@ -44,7 +44,11 @@ func (s *Service[
 		return
 	}
 
-	if !s.shouldBuildOptimisticPayloads() && s.lb.Enabled() {
+	// The payload for the next slot is built on every finalized block if
+	// this node is likely to propose the next slot, even if it was built
+	// optimistically already, as this node may not have verified the block
+	// during its proposal.
+	if s.lb.Enabled() && s.shouldPrebuildPayload(ctx, blk.GetSlot()+1) {
 		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
 	} else {
 		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
@@ -76,7 +80,7 @@ func (s *Service[
 		ctx,
 		stCopy,
 		blk.GetSlot()+1,
-		s.calculateNextTimestamp(blk),
+		s.calculateNextTimestamp(lph),
 		prevBlockRoot,
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
@@ -120,15 +124,15 @@ func (s *Service[
 }
 
 // calculateNextTimestamp calculates the next timestamp for an execution
-// payload.
+// payload built on top of the given execution head.
 //
 // TODO: This is hood and needs to be improved.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
-]) calculateNextTimestamp(blk BeaconBlockT) uint64 {
+	_, _, _, _, _, _, _, _, ExecutionPayloadHeaderT, _, _, _,
+]) calculateNextTimestamp(lph ExecutionPayloadHeaderT) uint64 {
 	//#nosec:G701 // not an issue in practice.
 	return max(
 		uint64(time.Now().Unix()+int64(s.cs.TargetSecondsPerEth1Block())),
-		uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
+		uint64(lph.GetTimestamp()+1),
 	)
 }
@@ -108,6 +108,25 @@ func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
 	)
 }
 
+// markPayloadPrebuildSkipped increments the counter for the number of
+// times the payload for the next slot was not built ahead of time, as this
+// node is not likely to propose it.
+func (cm *chainMetrics) markPayloadPrebuildSkipped() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.payload_prebuild_skipped",
+	)
+}
+
+// markProposerPredictionFailure increments the counter for the number of
+// times the proposer of the next slot could not be predicted.
+func (cm *chainMetrics) markProposerPredictionFailure(err error) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.proposer_prediction_failure",
+		"error",
+		err.Error(),
+	)
+}
+
 // measureStateRootVerificationTime measures the time taken to verify the state
 // root of a block.
 // It records the duration from the provided start time to the current time.
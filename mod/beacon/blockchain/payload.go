@@ -145,7 +145,7 @@ func (s *Service[
 }
 
 // handleOptimisticPayloadBuild handles optimistically
-// building for the next slot.
+// building for the next slot, if this node is likely to propose it.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
 ]) handleOptimisticPayloadBuild(
@@ -153,6 +153,10 @@ func (s *Service[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) {
+	if !s.shouldPrebuildPayload(ctx, blk.GetSlot()+1) {
+		return
+	}
+
 	if err := s.optimisticPayloadBuild(ctx, st, blk); err != nil {
 		s.logger.Error(
 			"Failed to build optimistic payload",
@@ -186,27 +190,32 @@ func (s *Service[
 		return err
 	}
 
-	// We then trigger a request for the next payload.
-	payload := blk.GetBody().GetExecutionPayload()
-	if _, err := s.lb.RequestPayloadAsync(
+	// We then trigger a request for the next payload, on top of the
+	// execution head left by the block we just processed. It is the payload
+	// of the block, unless the block kept the head of its parent.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	if _, err = s.lb.RequestPayloadAsync(
 		ctx, st,
 		slot,
 		// TODO: this is hood as fuck.
 		max(
 			//#nosec:G701
 			uint64(time.Now().Unix()+int64(s.cs.TargetSecondsPerEth1Block())),
-			uint64((payload.GetTimestamp()+1)),
+			uint64((lph.GetTimestamp()+1)),
 		),
 		// The previous block root is simply the root of the block we just
 		// processed.
 		blk.HashTreeRoot(),
 		// We set the head of our chain to the block we just processed.
-		payload.GetBlockHash(),
+		lph.GetBlockHash(),
 		// We can say that the payload from the previous block is *finalized*,
 		// This is safe to do since this block was accepted and the thus the
 		// parent hash was deemed valid by the state transition function we
 		// just processed.
-		payload.GetParentHash(),
+		lph.GetParentHash(),
 	); err != nil {
 		s.metrics.markOptimisticPayloadBuildFailure(slot, err)
 		return err
@@ -214,3 +223,29 @@ func (s *Service[
 	s.metrics.markOptimisticPayloadBuildSuccess(slot)
 	return nil
 }
+
+// shouldPrebuildPayload returns true if a payload should be built ahead of
+// time for the given slot, that is if this node is likely to propose it. If
+// the proposer cannot be predicted, the payload is built regardless.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) shouldPrebuildPayload(
+	ctx context.Context,
+	slot math.Slot,
+) bool {
+	likely, err := s.pp.IsLikelyProposer(ctx, slot)
+	if err != nil {
+		s.logger.Debug(
+			"Failed to predict proposer, building payload ahead of time",
+			"for_slot", slot.Base10(),
+			"error", err,
+		)
+		s.metrics.markProposerPredictionFailure(err)
+		return true
+	}
+
+	if !likely {
+		s.metrics.markPayloadPrebuildSkipped()
+	}
+	return likely
+}
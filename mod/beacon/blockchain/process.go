@@ -78,6 +78,10 @@ func (s *Service[
 		return nil, ErrDataNotAvailable
 	}
 
+	// Record whether the block was imported before its payload was
+	// verified by the execution client.
+	s.trackBlockImport(blk)
+
 	// If required, we want to forkchoice at the end of post
 	// block processing.
 	// TODO: this is hood as fuck.
@@ -96,6 +100,46 @@ func (s *Service[
 	return valUpdates.RemoveDuplicates().Sort(), nil
 }
 
+// trackBlockImport records the import of the block with the optimistic
+// tracker. Failing to do so is not fatal, since the block has already been
+// finalized.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
+]) trackBlockImport(blk BeaconBlockT) {
+	var (
+		root        = blk.HashTreeRoot()
+		payloadHash = blk.GetBody().GetExecutionPayload().GetBlockHash()
+	)
+
+	if err := s.ot.ImportBlock(
+		root, blk.GetSlot(), blk.GetParentBlockRoot(), payloadHash,
+	); err != nil {
+		s.logger.Error(
+			"Failed to track block import",
+			"slot", blk.GetSlot().Base10(),
+			"error", err,
+		)
+		return
+	}
+
+	optimistic, err := s.ot.IsOptimistic(root)
+	if err != nil {
+		s.logger.Error(
+			"Failed to read optimistic status of block",
+			"slot", blk.GetSlot().Base10(),
+			"error", err,
+		)
+		return
+	} else if optimistic {
+		s.logger.Warn(
+			"Imported block optimistically, execution client is not synced",
+			"slot", blk.GetSlot().Base10(),
+			"block_root", root,
+			"payload_hash", payloadHash,
+		)
+	}
+}
+
 // executeStateTransition runs the stf.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
@@ -70,6 +70,11 @@ type Service[
 	ee ExecutionEngine[PayloadAttributesT]
 	// lb is a local builder for constructing new beacon states.
 	lb LocalBuilder[BeaconStateT]
+	// pp predicts whether this node is the upcoming proposer, payloads are
+	// only built ahead of time for the slots it is likely to propose.
+	pp ProposerPredictor
+	// ot tracks the blocks that were imported optimistically.
+	ot OptimisticTracker
 	// sp is the state processor for beacon blocks and states.
 	sp StateProcessor[
 		BeaconBlockT,
@@ -88,7 +93,8 @@ type Service[
 	// validatorUpdateBroker is the event feed for validator updates.
 	validatorUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]]
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
-	// builder is enabled.
+	// builder is enabled, payloads are then also built ahead of time from
+	// the blocks verified during the proposal.
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
@@ -126,6 +132,8 @@ func NewService[
 	cs common.ChainSpec,
 	ee ExecutionEngine[PayloadAttributesT],
 	lb LocalBuilder[BeaconStateT],
+	pp ProposerPredictor,
+	ot OptimisticTracker,
 	sp StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
@@ -155,6 +163,8 @@ func NewService[
 		cs:                      cs,
 		ee:                      ee,
 		lb:                      lb,
+		pp:                      pp,
+		ot:                      ot,
 		sp:                      sp,
 		metrics:                 newChainMetrics(ts),
 		genesisBroker:           genesisBroker,
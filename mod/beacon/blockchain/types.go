@@ -149,6 +149,29 @@ type LocalBuilder[BeaconStateT any] interface {
 	) error
 }
 
+// OptimisticTracker is the interface for tracking beacon blocks that were
+// imported before their payloads were verified by the execution client.
+type OptimisticTracker interface {
+	// ImportBlock records the import of a beacon block.
+	ImportBlock(
+		root common.Root,
+		slot math.Slot,
+		parentRoot common.Root,
+		executionHash gethprimitives.ExecutionHash,
+	) error
+	// IsOptimistic returns true if the block with the given root was
+	// imported optimistically and has not been verified yet.
+	IsOptimistic(root common.Root) (bool, error)
+}
+
+// ProposerPredictor is the interface for predicting the upcoming proposer
+// duties of this node.
+type ProposerPredictor interface {
+	// IsLikelyProposer returns true if this node is likely to propose the
+	// block at the given slot.
+	IsLikelyProposer(ctx context.Context, slot math.Slot) (bool, error)
+}
+
 // ReadOnlyBeaconState defines the interface for accessing various components of
 // the beacon state.
 type ReadOnlyBeaconState[
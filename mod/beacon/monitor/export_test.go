@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor
+
+import "context"
+
+// ProcessBlock exports processBlock for testing.
+func (s *Service[BeaconBlockT, _, _, _, _, _, _, _, _, _]) ProcessBlock(
+	blk BeaconBlockT,
+) {
+	s.processBlock(blk)
+}
+
+// ProcessDecided processes the next height queued for missed proposals, if
+// any, and returns whether one was queued.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) ProcessDecided(
+	ctx context.Context,
+) bool {
+	select {
+	case decided := <-s.decided:
+		s.processDecided(ctx, decided)
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// monitorMetrics is a struct that contains the metrics of the monitored
+// validators, labelled with the pubkey or the index they are configured
+// with.
+type monitorMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newMonitorMetrics creates a new monitorMetrics.
+func newMonitorMetrics(sink TelemetrySink) *monitorMetrics {
+	return &monitorMetrics{
+		sink: sink,
+	}
+}
+
+// markProposal increments the counter of the proposals of the validator.
+func (m *monitorMetrics) markProposal(v *monitoredValidator) {
+	m.sink.IncrementCounter(
+		"beacon_kit.validator_monitor.proposals", "validator", v.id,
+	)
+}
+
+// markMissedProposal increments the counter of the proposals of the
+// validator which were not committed.
+func (m *monitorMetrics) markMissedProposal(v *monitoredValidator) {
+	m.sink.IncrementCounter(
+		"beacon_kit.validator_monitor.missed_proposals", "validator", v.id,
+	)
+}
+
+// markVote increments the counter of the CometBFT votes of the validator.
+func (m *monitorMetrics) markVote(v *monitoredValidator) {
+	m.sink.IncrementCounter(
+		"beacon_kit.validator_monitor.votes", "validator", v.id,
+	)
+}
+
+// markMissedVote increments the counter of the CometBFT votes missing from
+// the commits while the validator was in the validator set.
+func (m *monitorMetrics) markMissedVote(v *monitoredValidator) {
+	m.sink.IncrementCounter(
+		"beacon_kit.validator_monitor.missed_votes", "validator", v.id,
+	)
+}
+
+// markWithdrawal increments the counter of the withdrawals received by the
+// validator.
+func (m *monitorMetrics) markWithdrawal(v *monitoredValidator) {
+	m.sink.IncrementCounter(
+		"beacon_kit.validator_monitor.withdrawals", "validator", v.id,
+	)
+}
+
+// markDeposit increments the counter of the deposits processed for the
+// validator.
+func (m *monitorMetrics) markDeposit(v *monitoredValidator) {
+	m.sink.IncrementCounter(
+		"beacon_kit.validator_monitor.deposits", "validator", v.id,
+	)
+}
+
+// setBalance sets the balance of the validator at the end of an epoch and
+// its change over the epoch.
+func (m *monitorMetrics) setBalance(
+	v *monitoredValidator, balance math.Gwei, change int64,
+) {
+	//#nosec:G701 // balances are far smaller than max int64.
+	m.sink.SetGauge(
+		"beacon_kit.validator_monitor.balance_gwei",
+		int64(balance.Unwrap()), "validator", v.id,
+	)
+	m.sink.SetGauge(
+		"beacon_kit.validator_monitor.balance_change_gwei",
+		change, "validator", v.id,
+	)
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor_test
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// errNotFound is returned by the test state for unknown validators and by
+// the test schedule for unknown heights.
+var errNotFound = errors.New("validator not found")
+
+// testBlock is a finalized beacon block.
+type testBlock struct {
+	slot     math.Slot
+	proposer math.ValidatorIndex
+	body     testBody
+}
+
+func (b *testBlock) GetSlot() math.Slot                    { return b.slot }
+func (b *testBlock) GetProposerIndex() math.ValidatorIndex { return b.proposer }
+func (b *testBlock) GetBody() testBody                     { return b.body }
+
+// testBody is the body of a finalized beacon block.
+type testBody struct {
+	deposits []testDeposit
+	payload  testPayload
+}
+
+func (b testBody) GetDeposits() []testDeposit       { return b.deposits }
+func (b testBody) GetExecutionPayload() testPayload { return b.payload }
+
+// testPayload is the execution payload of a finalized beacon block.
+type testPayload struct {
+	withdrawals []testWithdrawal
+}
+
+func (p testPayload) GetWithdrawals() []testWithdrawal { return p.withdrawals }
+
+// testDeposit is a deposit processed by a beacon block.
+type testDeposit struct {
+	pubkey crypto.BLSPubkey
+	amount math.Gwei
+}
+
+func (d testDeposit) GetPubkey() crypto.BLSPubkey { return d.pubkey }
+func (d testDeposit) GetAmount() math.Gwei        { return d.amount }
+
+// testWithdrawal is a withdrawal of an execution payload.
+type testWithdrawal struct {
+	index  math.ValidatorIndex
+	amount math.Gwei
+}
+
+func (w testWithdrawal) GetValidatorIndex() math.ValidatorIndex {
+	return w.index
+}
+func (w testWithdrawal) GetAmount() math.Gwei { return w.amount }
+
+// testValidator is a validator of the beacon state.
+type testValidator struct {
+	pubkey crypto.BLSPubkey
+}
+
+func (v testValidator) GetPubkey() crypto.BLSPubkey { return v.pubkey }
+
+// testState is a beacon state holding the pubkeys and the balances of the
+// validators by their index.
+type testState struct {
+	pubkeys  map[math.ValidatorIndex]crypto.BLSPubkey
+	balances map[math.ValidatorIndex]math.Gwei
+}
+
+func (s *testState) GetBalance(index math.ValidatorIndex) (math.Gwei, error) {
+	balance, ok := s.balances[index]
+	if !ok {
+		return 0, errNotFound
+	}
+	return balance, nil
+}
+
+func (s *testState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (testValidator, error) {
+	pubkey, ok := s.pubkeys[index]
+	if !ok {
+		return testValidator{}, errNotFound
+	}
+	return testValidator{pubkey: pubkey}, nil
+}
+
+func (s *testState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for index, p := range s.pubkeys {
+		if p == pubkey {
+			return index, nil
+		}
+	}
+	return 0, errNotFound
+}
+
+// testNode records the heights the beacon state is read at.
+type testNode struct {
+	heights []int64
+}
+
+func (n *testNode) CreateQueryContext(
+	height int64, _ bool,
+) (context.Context, error) {
+	n.heights = append(n.heights, height)
+	return context.Background(), nil
+}
+
+// testStorage returns the same beacon state for every context.
+type testStorage struct {
+	state *testState
+}
+
+func (s *testStorage) StateFromContext(context.Context) *testState {
+	return s.state
+}
+
+// testSchedule serves the proposers of the rounds by height.
+type testSchedule struct {
+	proposers map[int64][][]byte
+}
+
+func (s *testSchedule) Proposers(
+	_ context.Context, height int64, round int32,
+) ([][]byte, error) {
+	proposers, ok := s.proposers[height]
+	if !ok || int(round) >= len(proposers) {
+		return nil, errNotFound
+	}
+	return proposers[:round+1], nil
+}
+
+// testSink records the metrics of the monitored validators, keyed by the
+// metric and the validator.
+type testSink struct {
+	counters map[string]int
+	gauges   map[string]int64
+}
+
+func newTestSink() *testSink {
+	return &testSink{
+		counters: make(map[string]int),
+		gauges:   make(map[string]int64),
+	}
+}
+
+func (s *testSink) IncrementCounter(key string, args ...string) {
+	s.counters[metricKey(key, args)]++
+}
+
+func (s *testSink) SetGauge(key string, value int64, args ...string) {
+	s.gauges[metricKey(key, args)] = value
+}
+
+// metricKey returns the key of a metric labelled with a validator.
+func metricKey(key string, args []string) string {
+	return key + "/" + args[len(args)-1]
+}
+
+// counter returns the key of the counter of the given validator.
+func counter(name, validator string) string {
+	return "beacon_kit.validator_monitor." + name + "/" + validator
+}
+
+// address returns the CometBFT address of the given pubkey.
+func address(pubkey crypto.BLSPubkey) []byte {
+	hash := sha256.Sum256(pubkey[:])
+	return hash[:20]
+}
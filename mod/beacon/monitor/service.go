@@ -0,0 +1,427 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// decidedHeightsBuffer is the number of committed heights which can be queued
+// for missed proposals.
+const decidedHeightsBuffer = 16
+
+// Service is the validator monitor. It follows the finalized blocks for the
+// proposals, deposits and withdrawals of the monitored validators and the
+// CometBFT commits for their votes, reporting them as metrics and as a
+// summary per epoch along with their balances.
+//
+// A proposal is missed when the validator was the proposer of a round of a
+// height, read from the proposer rotation of CometBFT, and the height was
+// committed with another proposer.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconStateT BeaconState[ValidatorT],
+	ContextT context.Context,
+	DepositT Deposit,
+	ExecutionPayloadT ExecutionPayload[WithdrawalT],
+	NodeT Node[ContextT],
+	StorageBackendT StorageBackend[BeaconStateT],
+	ValidatorT Validator,
+	WithdrawalT Withdrawal,
+] struct {
+	// logger is used for logging information and errors.
+	logger    log.Logger[any]
+	chainSpec common.ChainSpec
+	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]]
+	sb        StorageBackendT
+	// proposers is the proposer rotation the missed proposals are found
+	// from.
+	proposers ProposerSchedule
+	// decided receives the heights committed after their first round, whose
+	// proposers are checked for missed proposals.
+	decided chan decidedHeight
+	// node is used to read the beacon state at the end of each epoch.
+	node    NodeT
+	metrics *monitorMetrics
+
+	// mu protects the fields below, which are updated by both the finalized
+	// blocks and the CometBFT observations.
+	mu  sync.Mutex
+	set *validatorSet
+	// epoch is the current epoch, once a block has been finalized.
+	epoch    math.Epoch
+	hasEpoch bool
+	// lastHeight and lastProposer are the last committed height and the
+	// CometBFT address of its proposer.
+	lastHeight   int64
+	lastProposer []byte
+	// scheduleFailed is set while the proposer rotation cannot be read.
+	scheduleFailed bool
+}
+
+// decidedHeight is a height committed at the given round by the given
+// proposer.
+type decidedHeight struct {
+	height   int64
+	round    int32
+	proposer []byte
+}
+
+// NewService creates a new validator monitor.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconStateT BeaconState[ValidatorT],
+	ContextT context.Context,
+	DepositT Deposit,
+	ExecutionPayloadT ExecutionPayload[WithdrawalT],
+	NodeT Node[ContextT],
+	StorageBackendT StorageBackend[BeaconStateT],
+	ValidatorT Validator,
+	WithdrawalT Withdrawal,
+](
+	cfg Config,
+	logger log.Logger[any],
+	chainSpec common.ChainSpec,
+	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]],
+	sb StorageBackendT,
+	proposers ProposerSchedule,
+	telemetrySink TelemetrySink,
+) (*Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, ContextT, DepositT,
+	ExecutionPayloadT, NodeT, StorageBackendT, ValidatorT, WithdrawalT,
+], error) {
+	set, err := newValidatorSet(cfg.Validators)
+	if err != nil {
+		return nil, err
+	}
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, ContextT, DepositT,
+		ExecutionPayloadT, NodeT, StorageBackendT, ValidatorT, WithdrawalT,
+	]{
+		logger:    logger,
+		chainSpec: chainSpec,
+		blkBroker: blkBroker,
+		sb:        sb,
+		proposers: proposers,
+		decided:   make(chan decidedHeight, decidedHeightsBuffer),
+		metrics:   newMonitorMetrics(telemetrySink),
+		set:       set,
+	}, nil
+}
+
+// AttachNode sets the node used to read the beacon state.
+func (s *Service[_, _, _, _, _, _, NodeT, _, _, _]) AttachNode(node NodeT) {
+	s.node = node
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) Name() string {
+	return "validator-monitor"
+}
+
+// Enabled returns true if any validator is monitored.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) Enabled() bool {
+	return len(s.set.validators) > 0
+}
+
+// Start starts following the finalized blocks, if any validator is
+// monitored.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) Start(
+	ctx context.Context,
+) error {
+	if !s.Enabled() {
+		return nil
+	}
+	subBlkCh, err := s.blkBroker.Subscribe()
+	if err != nil {
+		s.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+	s.logger.Info(
+		"Monitoring validators", "validators", len(s.set.validators),
+	)
+	go s.listen(ctx, subBlkCh)
+	return nil
+}
+
+// listen listens for the finalized blocks and the heights to check for
+// missed proposals.
+func (s *Service[BeaconBlockT, _, _, _, _, _, _, _, _, _]) listen(
+	ctx context.Context,
+	subBlkCh <-chan *asynctypes.Event[BeaconBlockT],
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-subBlkCh:
+			if msg.Is(events.BeaconBlockFinalized) {
+				s.processBlock(msg.Data())
+			}
+		case decided := <-s.decided:
+			s.processDecided(ctx, decided)
+		}
+	}
+}
+
+// processBlock records the activity of the monitored validators in the
+// given finalized block, after summarizing the previous epoch if the block
+// starts a new one.
+func (s *Service[BeaconBlockT, _, _, _, _, _, _, _, _, _]) processBlock(
+	blk BeaconBlockT,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slot := blk.GetSlot()
+	epoch := s.chainSpec.SlotToEpoch(slot)
+	switch {
+	case !s.hasEpoch:
+		// Record the balances at startup as a baseline.
+		s.epoch, s.hasEpoch = epoch, true
+		s.updateFromState(slot, false)
+	case epoch > s.epoch:
+		s.updateFromState(slot, true)
+		s.epoch = epoch
+	}
+
+	if v, ok := s.set.byIndex[blk.GetProposerIndex()]; ok {
+		v.proposals++
+		s.metrics.markProposal(v)
+	}
+	body := blk.GetBody()
+	for _, deposit := range body.GetDeposits() {
+		if v, ok := s.set.byPubkey[deposit.GetPubkey()]; ok {
+			v.deposits++
+			v.deposited += deposit.GetAmount()
+			s.metrics.markDeposit(v)
+		}
+	}
+	for _, withdrawal := range body.GetExecutionPayload().GetWithdrawals() {
+		if v, ok := s.set.byIndex[withdrawal.GetValidatorIndex()]; ok {
+			v.withdrawals++
+			v.withdrawn += withdrawal.GetAmount()
+			s.metrics.markWithdrawal(v)
+		}
+	}
+}
+
+// updateFromState resolves the monitored validators and reads their
+// balances from the state committed before the block at the given slot. If
+// summarize is set, the activity of the epoch which ended with that state is
+// reported beforehand.
+func (s *Service[_, _, BeaconStateT, _, _, _, _, _, _, _]) updateFromState(
+	slot math.Slot,
+	summarize bool,
+) {
+	//#nosec:G701 // not an issue in practice.
+	queryCtx, err := s.node.CreateQueryContext(int64(slot.Unwrap())-1, false)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to read the beacon state of the monitored validators",
+			"slot", slot-1, "error", err,
+		)
+		return
+	}
+	st := s.sb.StateFromContext(queryCtx)
+
+	if s.set.unresolved() {
+		s.resolve(st)
+	}
+	for _, v := range s.set.validators {
+		var (
+			balance    math.Gwei
+			hasBalance bool
+		)
+		if v.hasIndex {
+			balance, err = st.GetBalance(v.index)
+			hasBalance = err == nil
+		}
+		if summarize {
+			s.summarize(v, balance, hasBalance)
+		}
+		v.balance, v.hasBalance = balance, hasBalance
+	}
+}
+
+// resolve looks up the missing pubkeys and indices of the monitored
+// validators in the given state.
+func (s *Service[_, _, BeaconStateT, _, _, _, _, _, _, _]) resolve(
+	st BeaconStateT,
+) {
+	for _, v := range s.set.validators {
+		if !v.hasIndex {
+			index, err := st.ValidatorIndexByPubkey(v.pubkey)
+			if err != nil {
+				continue
+			}
+			v.index, v.hasIndex = index, true
+			s.set.index(v)
+		}
+		if !v.hasPubkey {
+			validator, err := st.ValidatorByIndex(v.index)
+			if err != nil {
+				continue
+			}
+			v.pubkey, v.hasPubkey = validator.GetPubkey(), true
+			s.set.index(v)
+		}
+	}
+}
+
+// summarize reports the activity of the given validator during the current
+// epoch and its balance at the end of it, then resets its activity.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) summarize(
+	v *monitoredValidator,
+	balance math.Gwei,
+	hasBalance bool,
+) {
+	keyVals := []any{"epoch", s.epoch.Base10(), "validator", v.id}
+	if v.hasIndex {
+		keyVals = append(keyVals, "index", v.index.Base10())
+	}
+	if hasBalance {
+		var change int64
+		if v.hasBalance {
+			//#nosec:G701 // balances are far smaller than max int64.
+			change = int64(balance.Unwrap()) - int64(v.balance.Unwrap())
+		}
+		s.metrics.setBalance(v, balance, change)
+		keyVals = append(
+			keyVals,
+			"balance", balance.Base10(),
+			"balance_change", strconv.FormatInt(change, 10),
+		)
+	}
+	keyVals = append(
+		keyVals,
+		"proposals", v.proposals,
+		"missed_proposals", v.missedProposals,
+		"votes", v.votes,
+		"missed_votes", v.missedVotes,
+		"withdrawals", v.withdrawals,
+		"withdrawn", v.withdrawn.Base10(),
+		"deposits", v.deposits,
+		"deposited", v.deposited.Base10(),
+	)
+	s.logger.Info("Validator monitor epoch summary", keyVals...)
+	v.resetEpoch()
+}
+
+// ObserveBlock records the votes of the monitored validators for the
+// previous block, committed at the given round, and queues the previous
+// height for missed proposals if it was not committed at its first round.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) ObserveBlock(
+	height int64,
+	proposer []byte,
+	lastRound int32,
+	voters [][]byte,
+	absent [][]byte,
+) {
+	if !s.Enabled() {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lastRound > 0 && s.lastHeight == height-1 {
+		select {
+		case s.decided <- decidedHeight{
+			height:   s.lastHeight,
+			round:    lastRound,
+			proposer: s.lastProposer,
+		}:
+		default:
+			s.logger.Warn(
+				"Skipping missed proposals of a height, too many queued",
+				"height", s.lastHeight,
+			)
+		}
+	}
+	s.lastHeight, s.lastProposer = height, bytes.Clone(proposer)
+
+	for _, address := range voters {
+		if v, ok := s.set.byAddress[string(address)]; ok {
+			v.votes++
+			s.metrics.markVote(v)
+		}
+	}
+	for _, address := range absent {
+		if v, ok := s.set.byAddress[string(address)]; ok {
+			v.missedVotes++
+			s.metrics.markMissedVote(v)
+		}
+	}
+}
+
+// processDecided records a missed proposal for each monitored validator
+// which was the proposer of a round of the given height, up to the round it
+// was committed at, without being the proposer of the committed block.
+func (s *Service[_, _, _, _, _, _, _, _, _, _]) processDecided(
+	ctx context.Context,
+	decided decidedHeight,
+) {
+	proposers, err := s.proposers.Proposers(
+		ctx, decided.height, decided.round,
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		if !s.scheduleFailed {
+			s.logger.Warn(
+				"Failed to read the proposers, missed proposals are not "+
+					"recorded",
+				"height", decided.height, "error", err,
+			)
+		}
+		s.scheduleFailed = true
+		return
+	}
+	s.scheduleFailed = false
+
+	for round, address := range proposers {
+		if bytes.Equal(address, decided.proposer) {
+			continue
+		}
+		v, ok := s.set.byAddress[string(address)]
+		if !ok {
+			continue
+		}
+		v.missedProposals++
+		s.metrics.markMissedProposal(v)
+		s.logger.Warn(
+			"Monitored validator missed a proposal",
+			"validator", v.id, "height", decided.height, "round", round,
+		)
+	}
+}
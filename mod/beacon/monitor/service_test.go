@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor_test
+
+import (
+	"context"
+	"testing"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/beacon/monitor"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testService is the validator monitor over the test types.
+type testService = monitor.Service[
+	*testBlock, testBody, *testState, context.Context, testDeposit,
+	testPayload, *testNode, *testStorage, testValidator, testWithdrawal,
+]
+
+var (
+	// pubkeyA and pubkeyB are the pubkeys of the monitored validators.
+	pubkeyA = crypto.BLSPubkey{0x0a}
+	pubkeyB = crypto.BLSPubkey{0x0b}
+	// otherAddress is the CometBFT address of an unmonitored validator.
+	otherAddress = []byte("other validator addr")
+)
+
+// newService returns a validator monitor of the given validators, with 4
+// slots per epoch.
+func newService(
+	t *testing.T,
+	validators []string,
+	sb *testStorage,
+	schedule *testSchedule,
+	sink *testSink,
+) *testService {
+	t.Helper()
+	s, err := monitor.NewService[
+		*testBlock, testBody, *testState, context.Context, testDeposit,
+		testPayload, *testNode, *testStorage, testValidator, testWithdrawal,
+	](
+		monitor.Config{Validators: validators},
+		noop.NewLogger[any](),
+		chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType, math.Epoch, common.ExecutionAddress,
+				math.Slot, any,
+			]{SlotsPerEpoch: 4},
+		),
+		monitor.EventFeed[*asynctypes.Event[*testBlock]](nil),
+		sb,
+		schedule,
+		sink,
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func TestNewService_InvalidValidators(t *testing.T) {
+	for _, id := range []string{"", "0x0a", "0xzz", "abc", "-1"} {
+		_, err := monitor.NewService[
+			*testBlock, testBody, *testState, context.Context, testDeposit,
+			testPayload, *testNode, *testStorage, testValidator,
+			testWithdrawal,
+		](
+			monitor.Config{Validators: []string{id}},
+			noop.NewLogger[any](), nil, nil, nil, nil, nil,
+		)
+		require.ErrorIs(t, err, monitor.ErrInvalidMonitoredValidator, id)
+	}
+}
+
+func TestService_Enabled(t *testing.T) {
+	sink := newTestSink()
+	s := newService(t, nil, nil, nil, sink)
+	require.False(t, s.Enabled())
+	s.ObserveBlock(1, otherAddress, 0, nil, nil)
+	s.ObserveBlock(2, otherAddress, 1, [][]byte{address(pubkeyA)}, nil)
+	require.False(t, s.ProcessDecided(context.Background()))
+	require.Empty(t, sink.counters)
+
+	s = newService(t, []string{" 7 ", pubkeyA.String()}, nil, nil, sink)
+	require.True(t, s.Enabled())
+}
+
+func TestService_ObserveBlock(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		sink     = newTestSink()
+		idA, idB = pubkeyA.String(), pubkeyB.String()
+		schedule = &testSchedule{proposers: map[int64][][]byte{
+			10: {address(pubkeyA), address(pubkeyB), otherAddress},
+			11: {otherAddress, address(pubkeyA)},
+			12: {address(pubkeyB), address(pubkeyA)},
+		}}
+	)
+	s := newService(t, []string{idA, idB}, nil, schedule, sink)
+
+	// The first height observed has no previous one to check.
+	s.ObserveBlock(
+		10,
+		otherAddress,
+		2,
+		[][]byte{address(pubkeyA), otherAddress},
+		[][]byte{address(pubkeyB)},
+	)
+	require.False(t, s.ProcessDecided(ctx))
+	require.Equal(t, 1, sink.counters[counter("votes", idA)])
+	require.Equal(t, 1, sink.counters[counter("missed_votes", idB)])
+	require.Len(t, sink.counters, 2)
+
+	// Height 10 was committed at its third round, without A and B proposing
+	// at their turn.
+	s.ObserveBlock(11, address(pubkeyA), 2, nil, nil)
+	require.True(t, s.ProcessDecided(ctx))
+	require.Equal(t, 1, sink.counters[counter("missed_proposals", idA)])
+	require.Equal(t, 1, sink.counters[counter("missed_proposals", idB)])
+
+	// Height 11 was committed at its second round with the block of A.
+	s.ObserveBlock(12, otherAddress, 1, nil, nil)
+	require.True(t, s.ProcessDecided(ctx))
+	require.Equal(t, 1, sink.counters[counter("missed_proposals", idA)])
+
+	// Height 12 was committed at its first round, B did propose.
+	s.ObserveBlock(13, otherAddress, 0, nil, nil)
+	require.False(t, s.ProcessDecided(ctx))
+
+	// Heights which are not consecutive are not checked.
+	s.ObserveBlock(15, otherAddress, 1, nil, nil)
+	require.False(t, s.ProcessDecided(ctx))
+
+	// The proposers of height 15 are not known.
+	s.ObserveBlock(16, otherAddress, 1, nil, nil)
+	require.True(t, s.ProcessDecided(ctx))
+	require.Equal(t, 1, sink.counters[counter("missed_proposals", idB)])
+	require.Len(t, sink.counters, 4)
+}
+
+func TestService_ProcessBlock(t *testing.T) {
+	const idB = "2"
+	var (
+		sink  = newTestSink()
+		node  = new(testNode)
+		state = &testState{
+			pubkeys: map[math.ValidatorIndex]crypto.BLSPubkey{
+				1: pubkeyA, 2: pubkeyB,
+			},
+			balances: map[math.ValidatorIndex]math.Gwei{1: 32e9, 2: 40e9},
+		}
+		idA = pubkeyA.String()
+	)
+	s := newService(
+		t, []string{idA, idB}, &testStorage{state: state}, nil, sink,
+	)
+	s.AttachNode(node)
+
+	// The first block resolves the validators, such that the activity of A
+	// is followed by its index and the votes of B by its pubkey.
+	s.ProcessBlock(&testBlock{
+		slot:     5,
+		proposer: 1,
+		body: testBody{
+			deposits: []testDeposit{{pubkey: pubkeyB, amount: 1e9}},
+			payload: testPayload{
+				withdrawals: []testWithdrawal{{index: 1, amount: 2}},
+			},
+		},
+	})
+	require.Equal(t, []int64{4}, node.heights)
+	require.Equal(t, 1, sink.counters[counter("proposals", idA)])
+	require.Equal(t, 1, sink.counters[counter("withdrawals", idA)])
+	require.Equal(t, 1, sink.counters[counter("deposits", idB)])
+	s.ObserveBlock(5, otherAddress, 0, [][]byte{address(pubkeyB)}, nil)
+	require.Equal(t, 1, sink.counters[counter("votes", idB)])
+
+	// A block of the same epoch does not read the state.
+	s.ProcessBlock(&testBlock{slot: 6, proposer: 3})
+	require.Len(t, node.heights, 1)
+	require.Empty(t, sink.gauges)
+
+	// The first block of the next epoch reports the balances.
+	state.balances[1] = 33e9
+	state.balances[2] = 39e9
+	s.ProcessBlock(&testBlock{slot: 8, proposer: 3})
+	require.Equal(t, []int64{4, 7}, node.heights)
+	for id, want := range map[string][2]int64{
+		idA: {33e9, 1e9},
+		idB: {39e9, -1e9},
+	} {
+		prefix := "beacon_kit.validator_monitor."
+		require.Equal(t, want[0], sink.gauges[prefix+"balance_gwei/"+id])
+		require.Equal(
+			t, want[1], sink.gauges[prefix+"balance_change_gwei/"+id],
+		)
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for the finalized beacon blocks.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the block.
+	GetProposerIndex() math.ValidatorIndex
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is the interface for the body of the beacon blocks.
+type BeaconBlockBody[DepositT, ExecutionPayloadT any] interface {
+	// GetDeposits returns the deposits processed by the block.
+	GetDeposits() []DepositT
+	// GetExecutionPayload returns the execution payload of the block.
+	GetExecutionPayload() ExecutionPayloadT
+}
+
+// BeaconState is the interface for the beacon state the balances of the
+// monitored validators are read from.
+type BeaconState[ValidatorT any] interface {
+	// GetBalance returns the balance of the validator at the given index.
+	GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
+	// ValidatorIndexByPubkey returns the index of the validator with the
+	// given pubkey.
+	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
+}
+
+// Deposit is the interface for the deposits processed by the blocks.
+type Deposit interface {
+	// GetPubkey returns the pubkey of the validator of the deposit.
+	GetPubkey() crypto.BLSPubkey
+	// GetAmount returns the amount of the deposit.
+	GetAmount() math.Gwei
+}
+
+// EventFeed is the interface for subscribing to the block events.
+type EventFeed[EventT any] interface {
+	// Subscribe returns a channel that will receive events.
+	Subscribe() (chan EventT, error)
+}
+
+// ExecutionPayload is the interface for the execution payloads of the
+// blocks.
+type ExecutionPayload[WithdrawalT any] interface {
+	// GetWithdrawals returns the withdrawals of the payload.
+	GetWithdrawals() []WithdrawalT
+}
+
+// Node is the interface for the node the beacon state is read from.
+type Node[ContextT context.Context] interface {
+	// CreateQueryContext creates a query context for the given height.
+	CreateQueryContext(height int64, prove bool) (ContextT, error)
+}
+
+// ProposerSchedule is the interface for the proposer rotation of CometBFT.
+type ProposerSchedule interface {
+	// Proposers returns the CometBFT addresses of the proposers of the
+	// rounds of the given height, from the first one up to the given round.
+	Proposers(ctx context.Context, height int64, round int32) ([][]byte, error)
+}
+
+// StorageBackend is the interface for the beacon storage backend.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext returns the beacon state of the given context.
+	StateFromContext(context.Context) BeaconStateT
+}
+
+// TelemetrySink is the interface for the sink the metrics of the monitored
+// validators are sent to.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the
+	// provided keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by
+	// the provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
+
+// Validator is the interface for the validators of the beacon state.
+type Validator interface {
+	// GetPubkey returns the pubkey of the validator.
+	GetPubkey() crypto.BLSPubkey
+}
+
+// Withdrawal is the interface for the withdrawals of the execution
+// payloads.
+type Withdrawal interface {
+	// GetValidatorIndex returns the index of the withdrawn validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetAmount returns the amount of the withdrawal.
+	GetAmount() math.Gwei
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package monitor
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// cometBFTAddressLen is the length of the CometBFT addresses, the truncated
+// SHA-256 hash of the pubkey of the validator.
+const cometBFTAddressLen = 20
+
+// monitoredValidator is a validator followed by the monitor, along with its
+// activity during the current epoch.
+type monitoredValidator struct {
+	// id is the pubkey or the index the validator was configured with, it
+	// labels its metrics.
+	id string
+	// pubkey and hasPubkey are the pubkey of the validator, once known.
+	pubkey    crypto.BLSPubkey
+	hasPubkey bool
+	// index and hasIndex are the index of the validator, once known.
+	index    math.ValidatorIndex
+	hasIndex bool
+	// balance and hasBalance are the balance of the validator at the end of
+	// the previous epoch, once known.
+	balance    math.Gwei
+	hasBalance bool
+
+	// The activity of the validator during the current epoch.
+	proposals       uint64
+	missedProposals uint64
+	votes           uint64
+	missedVotes     uint64
+	withdrawals     uint64
+	withdrawn       math.Gwei
+	deposits        uint64
+	deposited       math.Gwei
+}
+
+// newMonitoredValidator returns the monitored validator of the given
+// pubkey or index.
+func newMonitoredValidator(id string) (*monitoredValidator, error) {
+	if strings.HasPrefix(id, "0x") {
+		bz, err := hex.ToBytes(id)
+		if err != nil || len(bz) != constants.BLSPubkeyLength {
+			return nil, errors.Wrapf(ErrInvalidMonitoredValidator, "%s", id)
+		}
+		return &monitoredValidator{
+			id:        id,
+			pubkey:    crypto.BLSPubkey(bz),
+			hasPubkey: true,
+		}, nil
+	}
+
+	index, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidMonitoredValidator, "%s", id)
+	}
+	return &monitoredValidator{
+		id:       id,
+		index:    math.ValidatorIndex(index),
+		hasIndex: true,
+	}, nil
+}
+
+// cometBFTAddress returns the CometBFT address of the validator.
+func (v *monitoredValidator) cometBFTAddress() string {
+	hash := sha256.Sum256(v.pubkey[:])
+	return string(hash[:cometBFTAddressLen])
+}
+
+// resetEpoch resets the activity of the validator for a new epoch.
+func (v *monitoredValidator) resetEpoch() {
+	v.proposals, v.missedProposals = 0, 0
+	v.votes, v.missedVotes = 0, 0
+	v.withdrawals, v.withdrawn = 0, 0
+	v.deposits, v.deposited = 0, 0
+}
+
+// validatorSet is the set of the monitored validators, indexed by their
+// pubkey, index and CometBFT address once known.
+type validatorSet struct {
+	validators []*monitoredValidator
+	byPubkey   map[crypto.BLSPubkey]*monitoredValidator
+	byIndex    map[math.ValidatorIndex]*monitoredValidator
+	byAddress  map[string]*monitoredValidator
+}
+
+// newValidatorSet returns the set of the given monitored validators.
+func newValidatorSet(ids []string) (*validatorSet, error) {
+	set := &validatorSet{
+		validators: make([]*monitoredValidator, 0, len(ids)),
+		byPubkey:   make(map[crypto.BLSPubkey]*monitoredValidator),
+		byIndex:    make(map[math.ValidatorIndex]*monitoredValidator),
+		byAddress:  make(map[string]*monitoredValidator),
+	}
+	for _, id := range ids {
+		v, err := newMonitoredValidator(strings.TrimSpace(id))
+		if err != nil {
+			return nil, err
+		}
+		set.validators = append(set.validators, v)
+		set.index(v)
+	}
+	return set, nil
+}
+
+// index indexes the given validator by its known pubkey and index.
+func (s *validatorSet) index(v *monitoredValidator) {
+	if v.hasPubkey {
+		s.byPubkey[v.pubkey] = v
+		s.byAddress[v.cometBFTAddress()] = v
+	}
+	if v.hasIndex {
+		s.byIndex[v.index] = v
+	}
+}
+
+// unresolved returns true if the pubkey or the index of any validator is
+// not known yet.
+func (s *validatorSet) unresolved() bool {
+	for _, v := range s.validators {
+		if !v.hasPubkey || !v.hasIndex {
+			return true
+		}
+	}
+	return false
+}
@@ -36,23 +36,31 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-// buildBlockAndSidecars builds a new beacon block.
+// buildBlockAndSidecars builds a new beacon block. If building the block
+// fails, the fallbacks of the proposal ladder are tried in turn until one
+// succeeds or the proposal deadline is reached.
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, _,
-	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT,
+	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
-	slotData SlotDataT,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
 ) (BeaconBlockT, BlobSidecarsT, error) {
 	var (
 		blk       BeaconBlockT
 		sidecars  BlobSidecarsT
+		step      proposalStep
 		startTime = time.Now()
-		g, _      = errgroup.WithContext(ctx)
 	)
 
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
 
+	// Refuse to propose, and so to sign the randao reveal and the block,
+	// until the key of the validator is known not to be active elsewhere.
+	if err := s.doppelganger.CheckSigning(); err != nil {
+		return blk, sidecars, err
+	}
+
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
 	// the next finalized block in the chain. A byproduct of this design
@@ -62,19 +70,23 @@ func (s *Service[
 
 	// Prepare the state such that it is ready to build a block for
 	// the requested slot
+	phaseStart := time.Now()
 	if _, err := s.stateProcessor.ProcessSlots(
 		st,
 		slotData.GetSlot(),
 	); err != nil {
 		return blk, sidecars, err
 	}
+	s.sim.record("process_slots", phaseStart)
 
 	// Build the reveal for the current slot.
 	// TODO: We can optimize to pre-compute this in parallel?
+	phaseStart = time.Now()
 	reveal, err := s.buildRandaoReveal(st, slotData.GetSlot())
 	if err != nil {
 		return blk, sidecars, err
 	}
+	s.sim.record("randao_reveal", phaseStart)
 
 	// Create a new empty block from the current state.
 	blk, err = s.getEmptyBeaconBlockForSlot(
@@ -84,18 +96,112 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Refuse to build on top of a block the execution client rejected.
+	if err = s.verifyParentAncestry(blk); err != nil {
+		return blk, sidecars, err
+	}
+
+	// Walk down the proposal ladder, every step builds on its own copy of
+	// the state so that a failed step leaves no trace.
+	ctx, cancel := s.proposalDeadline(ctx, startTime)
+	defer cancel()
+	steps := proposalSteps(s.cfg.AllowMinimalProposals)
+	for i := range steps {
+		step = steps[i]
+		phaseStart = time.Now()
+		stepCtx, stepCancel := s.stepContext(ctx, i, len(steps))
+		blk, sidecars, err = s.buildProposal(
+			stepCtx, st.Copy(), step, reveal, slotData,
+		)
+		stepCancel()
+		s.sim.record("step_"+string(step), phaseStart)
+		if err == nil {
+			break
+		}
+
+		s.metrics.incrementProposalStepFailed(step)
+		s.logger.Warn(
+			"Failed to build proposal",
+			"slot", slotData.GetSlot().Base10(),
+			"step", step,
+			"error", err,
+		)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return blk, sidecars, err
+	}
+
+	if step != proposalStepOptimistic {
+		s.metrics.incrementProposalFallbackUsed(step)
+	}
+	s.logger.Info(
+		"Beacon block successfully built",
+		"slot", slotData.GetSlot().Base10(),
+		"state_root", blk.GetStateRoot(),
+		"step", step,
+		"duration", time.Since(startTime).String(),
+	)
+
+	return blk, sidecars, nil
+}
+
+// buildProposal builds a beacon block and its sidecars on top of the given
+// state, following the given step of the proposal ladder.
+func (s *Service[
+	AttestationDataT, BeaconBlockT, _, BeaconStateT,
+	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, _,
+]) buildProposal(
+	ctx context.Context,
+	st BeaconStateT,
+	step proposalStep,
+	reveal crypto.BLSSignature,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
+) (BeaconBlockT, BlobSidecarsT, error) {
+	var (
+		sidecars BlobSidecarsT
+		g, _     = errgroup.WithContext(ctx)
+	)
+
+	// Create a new empty block from the current state.
+	blk, err := s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
+	if err != nil {
+		return blk, sidecars, err
+	}
+
 	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
+	phaseStart := time.Now()
+	envelope, err := s.retrievePayloadForStep(ctx, st, blk, step)
 	if err != nil {
 		return blk, sidecars, err
 	} else if envelope == nil {
 		return blk, sidecars, ErrNilPayload
 	}
+	s.sim.record("payload", phaseStart)
+
+	// The fallbacks must not depend on building sidecars, and the blobs of
+	// a payload cannot be left out of the block.
+	if bundle := envelope.GetBlobsBundle(); step != proposalStepOptimistic &&
+		bundle != nil && len(bundle.GetBlobs()) > 0 {
+		return blk, sidecars, errors.Wrapf(
+			ErrPayloadHasBlobs, "%d blobs", len(bundle.GetBlobs()),
+		)
+	}
 
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
 	if err = s.buildBlockBody(
-		ctx, st, blk, reveal, envelope, slotData,
+		ctx, st, blk, reveal, envelope, slotData, step,
+	); err != nil {
+		return blk, sidecars, err
+	}
+
+	// Make sure the block and its sidecars fit within the proposal, this
+	// may trim deposits off the block.
+	if err = s.fitProposalToBudget(
+		blk, envelope, slotData.GetMaxBytes(),
 	); err != nil {
 		return blk, sidecars, err
 	}
@@ -107,10 +213,11 @@ func (s *Service[
 	// functions
 	// without giving up the parallelization benefits.
 	g.Go(func() error {
-		sidecars, err = s.blobFactory.BuildSidecars(
+		var sidecarsErr error
+		sidecars, sidecarsErr = s.blobFactory.BuildSidecars(
 			blk, envelope.GetBlobsBundle(),
 		)
-		return err
+		return sidecarsErr
 	})
 
 	// Compute the state root for the block.
@@ -119,20 +226,40 @@ func (s *Service[
 	})
 
 	// Wait for all the goroutines to finish.
-	if err = g.Wait(); err != nil {
+	phaseStart = time.Now()
+	err = g.Wait()
+	s.sim.record("sidecars_and_state_root", phaseStart)
+	if err != nil {
 		return blk, sidecars, err
 	}
 
-	s.logger.Info(
-		"Beacon block successfully built",
-		"slot", slotData.GetSlot().Base10(),
-		"state_root", blk.GetStateRoot(),
-		"duration", time.Since(startTime).String(),
-	)
-
+	// Prefer the payload of an external builder if it is worth more, the
+	// block is only revealed to the relay once fully built.
+	if step == proposalStepOptimistic && s.relay.Enabled() {
+		sidecars = s.proposeRelayPayload(
+			ctx, st, blk, envelope, sidecars, slotData.GetMaxBytes(),
+		)
+	}
 	return blk, sidecars, nil
 }
 
+// verifyParentAncestry checks that the parent of the given block was not
+// rejected by the execution client, directly or through one of its
+// ancestors.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) verifyParentAncestry(blk BeaconBlockT) error {
+	invalid, err := s.optimisticTracker.IsInvalid(blk.GetParentBlockRoot())
+	if err != nil {
+		return err
+	} else if invalid {
+		return errors.Wrapf(
+			ErrInvalidAncestry, "parent root %s", blk.GetParentBlockRoot(),
+		)
+	}
+	return nil
+}
+
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
@@ -152,11 +279,13 @@ func (s *Service[
 		)
 	}
 
-	// Get the proposer index for the slot.
-	proposerIndex, err := st.ValidatorIndexByPubkey(
+	// Get the proposer index for the slot, simulations may force it.
+	var proposerIndex math.ValidatorIndex
+	if s.sim != nil && s.sim.proposerIndex != nil {
+		proposerIndex = *s.sim.proposerIndex
+	} else if proposerIndex, err = st.ValidatorIndexByPubkey(
 		s.signer.PublicKey(),
-	)
-	if err != nil {
+	); err != nil {
 		return blk, errors.Newf(
 			"failed to get validator by pubkey: %w",
 			err,
@@ -196,6 +325,9 @@ func (s *Service[
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
 	)
+	if signer, ok := s.signer.(RandaoSigner); ok {
+		return signer.SignRandaoReveal(epoch, signingRoot)
+	}
 	return s.signer.Sign(signingRoot[:])
 }
 
@@ -206,9 +338,6 @@ func (s *Service[
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
@@ -217,56 +346,121 @@ func (s *Service[
 			blk.GetParentBlockRoot(),
 		)
 	if err != nil {
+		s.metrics.incrementPrebuiltPayloadMiss()
 		s.metrics.failedToRetrievePayload(
 			blk.GetSlot(),
 			err,
 		)
 
-		// The latest execution payload header will be from the previous block
-		// during the block building phase.
-		var lph ExecutionPayloadHeaderT
-		lph, err = st.GetLatestExecutionPayloadHeader()
+		// If we failed to retrieve the payload, request a synchrnous payload.
+		envelope, err = s.requestPayloadSync(ctx, st, blk)
 		if err != nil {
 			return nil, err
 		}
+	} else {
+		s.metrics.incrementPrebuiltPayloadHit()
+	}
 
-		// If we failed to retrieve the payload, request a synchrnous payload.
-		//
-		// NOTE: The state here is properly configured by the
-		// prepareStateForBuilding
-		//
-		// call that needs to be called before requesting the Payload.
-		// TODO: We should decouple the PayloadBuilder from BeaconState to make
-		// this less confusing.
-		return s.localPayloadBuilder.RequestPayloadSync(
-			ctx,
-			st,
-			blk.GetSlot(),
-			// TODO: this is hood.
-			max(
-				//#nosec:G701
-				uint64(time.Now().Unix()+1),
-				uint64((lph.GetTimestamp()+1)),
-			),
-			blk.GetParentBlockRoot(),
-			lph.GetBlockHash(),
-			lph.GetParentHash(),
+	return envelope, nil
+}
+
+// retrievePayloadForStep retrieves the execution payload for the block as
+// prescribed by the given step of the proposal ladder.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, _, _, _, _,
+]) retrievePayloadForStep(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	step proposalStep,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	switch step {
+	case proposalStepOptimistic:
+		return s.retrieveExecutionPayload(ctx, st, blk)
+	case proposalStepMinimal:
+		return emptyPayload[ExecutionPayloadT](
+			s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		), nil
+	default:
+		// The execution client keeps improving the payload it builds for
+		// the attributes of the slot, asking for it again retrieves the
+		// latest one, or starts a new build if the previous one was
+		// dropped.
+		return s.requestPayload(
+			ctx, st, blk, s.localPayloadBuilder.RequestPayloadNow,
 		)
 	}
-	return envelope, nil
+}
+
+// requestPayloadSync requests a payload for the block from the local
+// execution client and blocks until it is delivered.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, _, _, _, _,
+]) requestPayloadSync(
+	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.requestPayload(
+		ctx, st, blk, s.localPayloadBuilder.RequestPayloadSync,
+	)
+}
+
+// requestPayload requests a payload for the block from the local execution
+// client through the given request function of the payload builder.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+]) requestPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	request func(
+		context.Context, BeaconStateT, math.Slot, uint64, common.Root,
+		gethprimitives.ExecutionHash, gethprimitives.ExecutionHash,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error),
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	// The latest execution payload header will be from the previous block
+	// during the block building phase.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The state here is properly configured by the
+	// prepareStateForBuilding
+	//
+	// call that needs to be called before requesting the Payload.
+	// TODO: We should decouple the PayloadBuilder from BeaconState to make
+	// this less confusing.
+	return request(
+		ctx,
+		st,
+		blk.GetSlot(),
+		// TODO: this is hood.
+		max(
+			//#nosec:G701
+			uint64(time.Now().Unix()+1),
+			uint64((lph.GetTimestamp()+1)),
+		),
+		blk.GetParentBlockRoot(),
+		lph.GetBlockHash(),
+		lph.GetParentHash(),
+	)
 }
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, BeaconStateT, _,
-	_, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, SlotDataT,
+	DepositT, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, _,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
 	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
-	slotData SlotDataT,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
+	step proposalStep,
 ) error {
 	// Assemble a new block with the payload.
 	body := blk.GetBody()
@@ -286,18 +480,22 @@ func (s *Service[
 	// Set the KZG commitments on the block body.
 	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
 
-	depositIndex, err := st.GetEth1DepositIndex()
-	if err != nil {
-		return ErrNilDepositIndexStart
-	}
+	// Dequeue deposits from the state, minimal blocks leave them to the
+	// following blocks.
+	deposits := make([]DepositT, 0)
+	if step != proposalStepMinimal {
+		depositIndex, err := st.GetEth1DepositIndex()
+		if err != nil {
+			return ErrNilDepositIndexStart
+		}
 
-	// Dequeue deposits from the state.
-	deposits, err := s.bsb.DepositStore().GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
-	)
-	if err != nil {
-		return err
+		deposits, err = s.bsb.DepositStore().GetDepositsByIndex(
+			depositIndex,
+			s.chainSpec.MaxDepositsPerBlock(),
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Set the deposits on the block body.
@@ -312,14 +510,15 @@ func (s *Service[
 	))
 
 	// Set the graffiti on the block body.
-	body.SetGraffiti(bytes.ToBytes32([]byte(s.cfg.Graffiti)))
+	body.SetGraffiti(bytes.ToBytes32([]byte(s.graffiti())))
 
 	// Get the epoch to find the active fork version.
 	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
 	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
 		epoch,
 	)
-	if activeForkVersion >= version.DenebPlus {
+	if activeForkVersion >= version.DenebPlus &&
+		step != proposalStepMinimal {
 		// Set the attestations on the block body.
 		body.SetAttestations(slotData.GetAttestationData())
 
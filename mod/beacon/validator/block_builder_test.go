@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testService is the validator service proposing the test blocks.
+type testService = validator.Service[
+	*testAttestation, *testBlock, *testBlockBody, *testState, testSidecars,
+	uint64, *testDepositStore, *testEth1Data, *testPayload, testHeader,
+	*testForkData, *testSlashing, testSlot,
+]
+
+// newTestService creates a validator service proposing on the payloads of
+// the given builder, with the given deposits pending.
+func newTestService(
+	t *testing.T,
+	cfg *validator.Config,
+	lb *testPayloadBuilder,
+	sink *testSink,
+	deposits []uint64,
+) *testService {
+	t.Helper()
+	settings, err := validator.NewProposerSettings(
+		filepath.Join(t.TempDir(), "proposer_settings.json"),
+		settingsPubkey, defaultFeeRecipient, defaultGraffiti, 0, false,
+	)
+	require.NoError(t, err)
+
+	return validator.NewService[
+		*testAttestation, *testBlock, *testBlockBody, *testState,
+		testSidecars, uint64, *testDepositStore, *testEth1Data,
+		*testPayload, testHeader, *testForkData, *testSlashing, testSlot,
+	](
+		cfg,
+		noop.NewLogger[any](),
+		chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:          32,
+			SlotsPerHistoricalRoot: 8,
+			MaxDepositsPerBlock:    16,
+			ElectraForkEpoch:       1_000,
+		}),
+		&testStorageBackend{
+			st:       &testState{},
+			deposits: &testDepositStore{deposits: deposits},
+		},
+		testStateProcessor{},
+		testSigner{},
+		testBlobFactory{},
+		lb,
+		lb,
+		testRelay{},
+		testTracker{},
+		settings,
+		nil,
+		nil,
+		engineprimitives.ClientVersionV1{},
+		sink,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func TestBuildBlockAndSidecarsWithinBudget(t *testing.T) {
+	// A block carries 1_000 bytes and 100 more per deposit, a sidecar a
+	// little more than a blob.
+	const oneBlobAndDeposit = 132_648
+
+	tests := []struct {
+		name         string
+		numBlobs     int
+		maxBytes     uint64
+		allowMinimal bool
+		wantErr      error
+		wantBlobs    int
+		wantDeposits int
+		wantRequests []string
+		wantCounters []string
+	}{
+		{
+			name:         "fits",
+			numBlobs:     1,
+			maxBytes:     200_000,
+			wantBlobs:    1,
+			wantDeposits: 3,
+			wantRequests: []string{"retrieve"},
+		},
+		{
+			name:         "trims deposits",
+			numBlobs:     1,
+			maxBytes:     oneBlobAndDeposit,
+			wantBlobs:    1,
+			wantDeposits: 1,
+			wantRequests: []string{"retrieve"},
+			wantCounters: []string{
+				"beacon_kit.validator.proposal_deposits_trimmed",
+			},
+		},
+		{
+			// The blobs of a payload cannot be left out, the execution
+			// client keeps serving them and the minimal step builds on an
+			// empty payload without it.
+			name:         "falls back to a minimal block",
+			numBlobs:     2,
+			maxBytes:     200_000,
+			allowMinimal: true,
+			wantRequests: []string{"retrieve", "now"},
+			wantCounters: []string{
+				"beacon_kit.validator.proposal_step_failed:step:optimistic",
+				"beacon_kit.validator.proposal_step_failed:step:no_blobs",
+				"beacon_kit.validator.proposal_fallback_used:step:minimal",
+			},
+		},
+		{
+			name:         "fails without minimal blocks",
+			numBlobs:     2,
+			maxBytes:     200_000,
+			wantErr:      validator.ErrPayloadHasBlobs,
+			wantRequests: []string{"retrieve", "now"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				lb   = &testPayloadBuilder{envelope: newTestEnvelope(tt.numBlobs)}
+				sink = new(testSink)
+				cfg  = validator.DefaultConfig()
+			)
+			cfg.AllowMinimalProposals = tt.allowMinimal
+			s := newTestService(t, &cfg, lb, sink, []uint64{1, 2, 3})
+
+			start := time.Now()
+			blk, sidecars, err := s.BuildBlockAndSidecars(
+				context.Background(),
+				testSlot{slot: 1, maxBytes: tt.maxBytes},
+			)
+			require.Less(t, time.Since(start), cfg.ProposalDeadline)
+			require.Equal(t, tt.wantRequests, lb.requested)
+			require.Subset(t, sink.counters, tt.wantCounters)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			body := blk.GetBody()
+			require.LessOrEqual(t,
+				uint64(blk.SizeSSZ(false))+4+uint64(sidecars)*131_544,
+				tt.maxBytes,
+			)
+			require.Equal(t, testSidecars(tt.wantBlobs), sidecars)
+			require.Len(t, body.commitments, tt.wantBlobs)
+			require.Len(t, body.deposits, tt.wantDeposits)
+			if tt.wantBlobs == 0 {
+				require.Equal(
+					t, &testPayload{ForkVersion: version.DenebPlus},
+					body.payload,
+				)
+			}
+		})
+	}
+}
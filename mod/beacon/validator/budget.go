@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+const (
+	// blobSidecarsOverhead is the size of the offset prefixing the SSZ
+	// encoded list of blob sidecars.
+	blobSidecarsOverhead = 4
+	// blobSidecarSize is the size of a SSZ encoded blob sidecar: index, blob,
+	// commitment, proof, block header and commitment inclusion proof.
+	blobSidecarSize = 8 + len(eip4844.Blob{}) + 48 + 48 + 112 + 8*32
+)
+
+// depositsHolder is a block body whose deposits can be trimmed.
+type depositsHolder[DepositT any] interface {
+	GetDeposits() []DepositT
+	SetDeposits([]DepositT)
+}
+
+// fitProposalToBudget makes sure the block and its sidecars fit within the
+// given byte budget, trimming deposits off the tail of the block if needed.
+// The blobs cannot be trimmed: the execution client would hand back the very
+// same payload for the same attributes. A proposal that still does not fit
+// fails its step of the proposal ladder with ErrProposalTooLarge: the no-blobs
+// step only helps when the execution client drops the blobs, while the
+// minimal step builds on an empty payload without blobs nor deposits, which
+// always fits, so a block still lands when minimal proposals are allowed.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, DepositT, _, _, ExecutionPayloadT, _, _, _, _,
+]) fitProposalToBudget(
+	blk BeaconBlockT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	maxBytes uint64,
+) error {
+	size := proposalSize(blk, envelope)
+	defer func() { s.metrics.measureProposalSize(size, maxBytes) }()
+
+	if maxBytes == 0 || size <= maxBytes {
+		return nil
+	}
+
+	var (
+		body     = blk.GetBody()
+		deposits = len(body.GetDeposits())
+		included int
+	)
+	included, size = trimDepositsToBudget[DepositT](
+		body,
+		func() uint64 { return proposalSize(blk, envelope) },
+		maxBytes,
+	)
+	if size > maxBytes {
+		return errors.Wrapf(
+			ErrProposalTooLarge,
+			"proposal size %d, max bytes %d", size, maxBytes,
+		)
+	}
+
+	s.metrics.incrementProposalDepositsTrimmed()
+	s.logger.Warn(
+		"Trimmed deposits to fit proposal within max bytes",
+		"slot", blk.GetSlot().Base10(),
+		"included_deposits", included,
+		"trimmed_deposits", deposits-included,
+	)
+	return nil
+}
+
+// trimDepositsToBudget trims deposits off the tail of the body until the
+// proposal, whose size is given by the size function, fits within max bytes.
+// The included deposits stay contiguous, the trimmed ones are picked up by
+// the following blocks. It returns the number of deposits included and the
+// resulting size, which still exceeds max bytes if trimming every deposit
+// was not enough.
+func trimDepositsToBudget[DepositT any](
+	body depositsHolder[DepositT],
+	size func() uint64,
+	maxBytes uint64,
+) (int, uint64) {
+	var (
+		deposits = body.GetDeposits()
+		n        = len(deposits)
+		current  = size()
+	)
+	for ; n > 0 && current > maxBytes; n-- {
+		body.SetDeposits(deposits[:n-1])
+		current = size()
+	}
+	return n, current
+}
+
+// proposalSize returns the number of bytes the SSZ encoded block and blob
+// sidecars built from the given envelope occupy.
+func proposalSize[
+	BeaconBlockT interface{ SizeSSZ(fixed bool) uint32 },
+	ExecutionPayloadT any,
+](
+	blk BeaconBlockT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) uint64 {
+	var numBlobs int
+	if blobsBundle := envelope.GetBlobsBundle(); blobsBundle != nil {
+		numBlobs = len(blobsBundle.GetBlobs())
+	}
+	//#nosec:G701 // sizes are never negative.
+	return uint64(blk.SizeSSZ(false)) +
+		blobSidecarsOverhead + uint64(numBlobs*blobSidecarSize)
+}
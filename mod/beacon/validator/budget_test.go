@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/stretchr/testify/require"
+)
+
+// depositSize is the size every deposit adds to the test proposals.
+const depositSize = 100
+
+type testBody struct {
+	deposits []uint64
+}
+
+func (b *testBody) GetDeposits() []uint64         { return b.deposits }
+func (b *testBody) SetDeposits(deposits []uint64) { b.deposits = deposits }
+
+// size returns the size of a proposal of the given base size carrying the
+// deposits of the body.
+func (b *testBody) size(base uint64) func() uint64 {
+	return func() uint64 {
+		return base + uint64(len(b.deposits))*depositSize
+	}
+}
+
+func TestTrimDepositsToBudget(t *testing.T) {
+	tests := []struct {
+		name         string
+		base         uint64
+		maxBytes     uint64
+		wantIncluded int
+		wantSize     uint64
+	}{
+		{
+			name:         "fits untouched",
+			base:         1000,
+			maxBytes:     1500,
+			wantIncluded: 4,
+			wantSize:     1400,
+		},
+		{
+			name:         "trims the tail",
+			base:         1000,
+			maxBytes:     1250,
+			wantIncluded: 2,
+			wantSize:     1200,
+		},
+		{
+			name:         "fits with no deposits",
+			base:         1000,
+			maxBytes:     1000,
+			wantIncluded: 0,
+			wantSize:     1000,
+		},
+		{
+			name:         "does not fit",
+			base:         2000,
+			maxBytes:     1000,
+			wantIncluded: 0,
+			wantSize:     2000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &testBody{deposits: []uint64{0, 1, 2, 3}}
+			included, size := validator.TrimDepositsToBudget[uint64](
+				body, body.size(tt.base), tt.maxBytes,
+			)
+			require.Equal(t, tt.wantIncluded, included)
+			require.Equal(t, tt.wantSize, size)
+
+			// The included deposits stay contiguous from the first one.
+			require.Equal(
+				t, []uint64{0, 1, 2, 3}[:tt.wantIncluded], body.deposits,
+			)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+)
+
+// executionVersions holds the client versions reported by the execution
+// client.
+type executionVersions struct {
+	// mu guards the versions.
+	mu sync.RWMutex
+	// versions are the client versions, empty until the execution client
+	// answered.
+	versions []engineprimitives.ClientVersionV1
+}
+
+// ClientVersions returns the client version of the consensus client and the
+// client versions of the execution client, which are empty until the
+// execution client answered.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ClientVersions() (
+	engineprimitives.ClientVersionV1, []engineprimitives.ClientVersionV1,
+) {
+	s.executionVersions.mu.RLock()
+	defer s.executionVersions.mu.RUnlock()
+	return s.clientVersion, slices.Clone(s.executionVersions.versions)
+}
+
+// trackClientVersions exchanges the client versions with the execution
+// client once the connection to the execution client is established, and
+// again whenever it is restored, until the context is done.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) trackClientVersions(ctx context.Context) {
+	s.metrics.setClientVersion(clientRoleConsensus, s.clientVersion, true)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.executionClient.Reconnected():
+			s.updateExecutionVersions(ctx)
+		}
+	}
+}
+
+// updateExecutionVersions retrieves the client versions of the execution
+// client. The previous versions are kept if the execution client does not
+// answer.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) updateExecutionVersions(ctx context.Context) {
+	versions, err := s.executionClient.GetClientVersionV1(ctx)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to retrieve the execution client version", "error", err,
+		)
+		return
+	}
+
+	s.executionVersions.mu.Lock()
+	previous := s.executionVersions.versions
+	s.executionVersions.versions = versions
+	s.executionVersions.mu.Unlock()
+
+	for _, v := range previous {
+		s.metrics.setClientVersion(clientRoleExecution, v, false)
+	}
+	for _, v := range versions {
+		s.metrics.setClientVersion(clientRoleExecution, v, true)
+		s.logger.Info(
+			"Identified execution client 🪪",
+			"name", v.Name,
+			"version", v.Version,
+			"commit", v.Commit,
+		)
+	}
+}
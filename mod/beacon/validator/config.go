@@ -20,13 +20,43 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
 
+	// defaultGraffitiTemplate is the default template the graffiti is
+	// rendered from, appending the client versions to the graffiti.
+	defaultGraffitiTemplate = "{user} {el}{el_commit}{cl}{cl_commit}"
+
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultProposalDeadline is the default time allowed to build a
+	// proposal, fallbacks included.
+	defaultProposalDeadline = 2 * time.Second
+
+	// defaultAllowMinimalProposals is the default for allowing minimal
+	// blocks to be proposed as a last resort.
+	defaultAllowMinimalProposals = false
+
+	// defaultProposerSettingsPath is the default path of the proposer
+	// settings file, relative to the home directory.
+	defaultProposerSettingsPath = "config/proposer_settings.json"
+
+	// defaultKeystorePath is the default path of the validator keystore,
+	// empty to sign with the CometBFT key file.
+	defaultKeystorePath = ""
+
+	// defaultKeystorePasswordFile is the default path of the keystore
+	// password file, empty to read the password from the environment.
+	defaultKeystorePasswordFile = ""
+
+	// defaultDoppelgangerBlocks is the default number of blocks observed
+	// by the doppelganger protection, zero disables it.
+	defaultDoppelgangerBlocks = 0
 )
 
 // Config is the validator configuration.
@@ -34,17 +64,65 @@ const (
 //nolint:lll // struct tags.
 type Config struct {
 	// Graffiti is the string that will be included in the
-	// graffiti field of the beacon block.
+	// graffiti field of the beacon block, unless overridden through the
+	// keymanager API.
 	Graffiti string `mapstructure:"graffiti"`
 
+	// GraffitiTemplate is the template the graffiti of the blocks is
+	// rendered from. The {user} placeholder is replaced by the graffiti,
+	// {cl}, {cl_commit}, {el} and {el_commit} by the client codes and
+	// commits of the consensus and execution clients. The commits are
+	// shortened, then dropped, when the graffiti exceeds 32 bytes. An empty
+	// template uses the graffiti as is.
+	GraffitiTemplate string `mapstructure:"graffiti-template"`
+
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// ProposalDeadline is the time allowed to build a proposal. When
+	// building the block fails, cheaper fallbacks are tried until the
+	// deadline is reached. Zero disables the deadline.
+	ProposalDeadline time.Duration `mapstructure:"proposal-deadline"`
+
+	// AllowMinimalProposals allows proposing a block without deposits,
+	// attestations, slashings or blobs when every other fallback failed.
+	// The block carries an empty payload and keeps the execution head of
+	// its parent.
+	AllowMinimalProposals bool `mapstructure:"allow-minimal-proposals"`
+
+	// ProposerSettingsPath is the path of the file the proposer settings
+	// changed through the keymanager API are persisted to. Relative paths
+	// are resolved against the home directory.
+	ProposerSettingsPath string `mapstructure:"proposer-settings-path"`
+
+	// KeystorePath is the path of the EIP-2335 keystore holding the key of
+	// the validator. If set, the key is decrypted from it instead of being
+	// read from the CometBFT key file. Relative paths are resolved against
+	// the home directory.
+	KeystorePath string `mapstructure:"keystore-path"`
+
+	// KeystorePasswordFile is the path of the file holding the password of
+	// the keystore. If empty, the password is read from the
+	// BEACOND_KEYSTORE_PASSWORD environment variable.
+	KeystorePasswordFile string `mapstructure:"keystore-password-file"`
+
+	// DoppelgangerBlocks is the number of blocks observed after startup
+	// for the proposals and votes of the validator key on another node,
+	// during which the validator does not sign. Zero disables the check.
+	DoppelgangerBlocks uint64 `mapstructure:"doppelganger-blocks"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
+		GraffitiTemplate:              defaultGraffitiTemplate,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		ProposalDeadline:              defaultProposalDeadline,
+		AllowMinimalProposals:         defaultAllowMinimalProposals,
+		ProposerSettingsPath:          defaultProposerSettingsPath,
+		KeystorePath:                  defaultKeystorePath,
+		KeystorePasswordFile:          defaultKeystorePasswordFile,
+		DoppelgangerBlocks:            defaultDoppelgangerBlocks,
 	}
 }
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// cometBFTAddressLength is the length of the CometBFT address of a key, the
+// truncated SHA-256 hash of its public key.
+const cometBFTAddressLength = 20
+
+// Doppelganger protects the validator from signing while its key is active
+// on another node. Once the node started, the proposals and votes are held
+// back until a number of blocks were committed without any proposal or vote
+// of the validator, which can then only have been signed elsewhere. As the
+// chain cannot progress without the votes of a validator holding a third or
+// more of the voting power, the protection refuses to run for such a
+// validator.
+type Doppelganger struct {
+	// logger is a logger.
+	logger log.Logger[any]
+	// blocks is the number of blocks observed before signing, zero
+	// disables the protection.
+	blocks uint64
+	// startTime is the time the node started at, the blocks committed
+	// before it may carry the proposals and votes of this node.
+	startTime time.Time
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// address is the CometBFT address of the validator key.
+	address []byte
+	// lastHeight is the height of the last observed block.
+	lastHeight int64
+	// prevLive is true if the last observed block was committed after the
+	// node started.
+	prevLive bool
+	// observed is the number of blocks observed so far.
+	observed uint64
+	// detected is true once the validator key was seen active elsewhere.
+	detected bool
+}
+
+// NewDoppelganger creates a new doppelganger protection observing the given
+// number of blocks before allowing the validator to sign.
+func NewDoppelganger(logger log.Logger[any], blocks uint64) *Doppelganger {
+	return &Doppelganger{
+		logger:    logger,
+		blocks:    blocks,
+		startTime: time.Now(),
+	}
+}
+
+// Enabled returns true if the doppelganger protection is enabled.
+func (d *Doppelganger) Enabled() bool {
+	return d != nil && d.blocks > 0
+}
+
+// Watch sets the public key of the validator whose proposals and votes are
+// looked for.
+func (d *Doppelganger) Watch(pubkey crypto.BLSPubkey) {
+	if !d.Enabled() {
+		return
+	}
+
+	hash := sha256.Sum256(pubkey[:])
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.address = hash[:cometBFTAddressLength]
+}
+
+// CheckVotingPower returns an error if the protection is enabled while the
+// validator holds a third or more of the given total voting power, as its
+// votes could not be held back without halting the chain.
+func (d *Doppelganger) CheckVotingPower(power, totalPower math.Gwei) error {
+	if !d.Enabled() || power == 0 || power*3 < totalPower {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrDoppelgangerVotingPower,
+		"voting power %d of %d, set doppelganger-blocks to 0",
+		power, totalPower,
+	)
+}
+
+// ObserveBlock observes the block committed at the given height and time,
+// along with the CometBFT addresses of its proposer and of the validators
+// which voted for the previous block.
+func (d *Doppelganger) ObserveBlock(
+	height int64,
+	blockTime time.Time,
+	proposer []byte,
+	voters [][]byte,
+) {
+	if !d.Enabled() {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.address == nil || d.detected || d.observed >= d.blocks ||
+		height <= d.lastHeight {
+		return
+	}
+	d.lastHeight = height
+
+	// The blocks replayed or synced on startup were committed before the
+	// node started, and so were the votes of the block following them.
+	live, prevLive := blockTime.After(d.startTime), d.prevLive
+	d.prevLive = live
+	if !live {
+		return
+	}
+
+	if bytes.Equal(proposer, d.address) ||
+		(prevLive && slices.ContainsFunc(voters, d.isValidator)) {
+		d.detected = true
+		d.logger.Error(
+			"Validator key is active on another node, refusing to sign",
+			"height", height,
+		)
+		return
+	}
+
+	if d.observed++; d.observed == d.blocks {
+		d.logger.Info(
+			"Doppelganger check passed, signing is allowed",
+			"height", height,
+		)
+	}
+}
+
+// CheckSigning returns an error if the validator must not sign, either
+// because the check is still in progress or because the key of the validator
+// was seen active on another node.
+func (d *Doppelganger) CheckSigning() error {
+	if !d.Enabled() {
+		return nil
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	switch {
+	case d.detected:
+		return ErrDoppelgangerDetected
+	case d.observed < d.blocks:
+		return ErrDoppelgangerCheckPending
+	default:
+		return nil
+	}
+}
+
+// isValidator returns true if the given CometBFT address is the one of the
+// validator.
+func (d *Doppelganger) isValidator(address []byte) bool {
+	return bytes.Equal(address, d.address)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// doppelgangerPubkey is the public key of the watched validator.
+	doppelgangerPubkey = crypto.BLSPubkey{0x01}
+	// otherAddress is the CometBFT address of another validator.
+	otherAddress = []byte("other validator addr")
+)
+
+// watchedAddress returns the CometBFT address of the watched validator.
+func watchedAddress() []byte {
+	hash := sha256.Sum256(doppelgangerPubkey[:])
+	return hash[:20]
+}
+
+// newDoppelganger returns a doppelganger protection observing the given
+// number of blocks for the watched validator.
+func newDoppelganger(blocks uint64) *validator.Doppelganger {
+	d := validator.NewDoppelganger(noop.NewLogger[any](), blocks)
+	d.Watch(doppelgangerPubkey)
+	return d
+}
+
+// liveTime is a time after the start of the node.
+func liveTime() time.Time {
+	return time.Now().Add(time.Hour)
+}
+
+func TestDoppelganger_Disabled(t *testing.T) {
+	d := newDoppelganger(0)
+	require.False(t, d.Enabled())
+	require.NoError(t, d.CheckSigning())
+
+	var nilDoppelganger *validator.Doppelganger
+	require.False(t, nilDoppelganger.Enabled())
+	require.NoError(t, nilDoppelganger.CheckSigning())
+}
+
+func TestDoppelganger_Window(t *testing.T) {
+	d := newDoppelganger(2)
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerCheckPending)
+
+	// The blocks committed before the node started are not counted.
+	d.ObserveBlock(1, time.Time{}, otherAddress, nil)
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerCheckPending)
+
+	// Nor are the blocks observed twice.
+	d.ObserveBlock(2, liveTime(), otherAddress, nil)
+	d.ObserveBlock(2, liveTime(), otherAddress, nil)
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerCheckPending)
+
+	d.ObserveBlock(3, liveTime(), otherAddress, [][]byte{otherAddress})
+	require.NoError(t, d.CheckSigning())
+
+	// The validator signs its own proposals once the check passed.
+	d.ObserveBlock(4, liveTime(), watchedAddress(), nil)
+	require.NoError(t, d.CheckSigning())
+}
+
+func TestDoppelganger_DetectsProposal(t *testing.T) {
+	d := newDoppelganger(2)
+
+	// The proposals of the validator are held back, a block it proposed
+	// after the node started was signed elsewhere.
+	d.ObserveBlock(1, liveTime(), watchedAddress(), nil)
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerDetected)
+
+	// The validator refuses to sign until restarted.
+	for height := int64(2); height < 5; height++ {
+		d.ObserveBlock(height, liveTime(), otherAddress, nil)
+	}
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerDetected)
+}
+
+func TestDoppelganger_DetectsVote(t *testing.T) {
+	voters := [][]byte{otherAddress, watchedAddress()}
+
+	// The votes carried by the first block committed after the node
+	// started may have been signed by this node before it stopped.
+	d := newDoppelganger(3)
+	d.ObserveBlock(1, time.Time{}, otherAddress, nil)
+	d.ObserveBlock(2, liveTime(), otherAddress, voters)
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerCheckPending)
+
+	// The following ones were signed elsewhere, as the votes of the
+	// validator are held back.
+	d.ObserveBlock(3, liveTime(), otherAddress, voters)
+	require.ErrorIs(t, d.CheckSigning(), validator.ErrDoppelgangerDetected)
+}
+
+func TestDoppelganger_CheckVotingPower(t *testing.T) {
+	d := newDoppelganger(2)
+	require.NoError(t, d.CheckVotingPower(0, 0))
+	require.NoError(t, d.CheckVotingPower(0, 32e9))
+	require.NoError(t, d.CheckVotingPower(32e9, 97e9))
+
+	// The chain cannot progress without the votes of a validator holding a
+	// third or more of the voting power.
+	require.ErrorIs(
+		t, d.CheckVotingPower(32e9, 96e9), validator.ErrDoppelgangerVotingPower,
+	)
+	require.NoError(t, newDoppelganger(0).CheckVotingPower(32e9, 32e9))
+}
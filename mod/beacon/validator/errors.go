@@ -37,4 +37,71 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrProposalTooLarge is an error for when the block and its sidecars
+	// do not fit within the byte budget of the proposal.
+	ErrProposalTooLarge = errors.New("proposal exceeds max bytes")
+
+	// ErrInvalidAncestry is an error for when the parent of the block to
+	// propose descends from a block rejected by the execution client.
+	ErrInvalidAncestry = errors.New("parent block has invalid ancestry")
+
+	// ErrPayloadHasBlobs is an error for when a fallback of the proposal
+	// receives a payload carrying blobs from the execution client.
+	ErrPayloadHasBlobs = errors.New("fallback payload carries blobs")
+
+	// ErrRelayBidMismatch is an error for when the payload of a bid of the
+	// relay does not share the withdrawals and prev randao of the local
+	// payload.
+	ErrRelayBidMismatch = errors.New("relay bid does not match local payload")
+
+	// ErrProposerSettingsPubkey is an error for when the proposer settings
+	// file belongs to another validator.
+	ErrProposerSettingsPubkey = errors.New(
+		"proposer settings file belongs to another validator",
+	)
+
+	// ErrGraffitiTooLong is an error for when the graffiti does not fit in
+	// the graffiti field of the block.
+	ErrGraffitiTooLong = errors.New("graffiti exceeds 32 bytes")
+
+	// ErrZeroGasLimit is an error for when the gas limit is set to zero.
+	ErrZeroGasLimit = errors.New("gas limit must not be zero")
+
+	// ErrGasLimitWithoutRelay is an error for when the gas limit is set
+	// while payloads are not requested from the relay, the gas limit of
+	// local payloads being configured in the execution client.
+	ErrGasLimitWithoutRelay = errors.New(
+		"gas limit only applies to payloads of the relay",
+	)
+
+	// ErrDoppelgangerCheckPending is an error for when the validator is
+	// not allowed to sign yet as the doppelganger check is in progress.
+	ErrDoppelgangerCheckPending = errors.New(
+		"doppelganger check in progress, signing is held back",
+	)
+
+	// ErrDoppelgangerDetected is an error for when the key of the validator
+	// was seen active on another node.
+	ErrDoppelgangerDetected = errors.New(
+		"validator key is active on another node",
+	)
+
+	// ErrDoppelgangerVotingPower is an error for when the doppelganger
+	// protection is enabled for a validator the chain cannot progress
+	// without.
+	ErrDoppelgangerVotingPower = errors.New(
+		"doppelganger protection would halt the chain, validator holds a " +
+			"third or more of the voting power",
+	)
+
+	// ErrMockPayloadNotPrebuilt is an error for when a payload built ahead
+	// of time is retrieved while simulating a proposal on a mock payload.
+	ErrMockPayloadNotPrebuilt = errors.New(
+		"mock payloads are not built ahead of time",
+	)
+
+	// ErrRelayDisabled is an error for when a payload is retrieved from a
+	// disabled relay.
+	ErrRelayDisabled = errors.New("relay is disabled")
 )
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+)
+
+// BuildBlockAndSidecars exports buildBlockAndSidecars for testing.
+func (s *Service[
+	AttestationDataT, BeaconBlockT, _, _,
+	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, _,
+]) BuildBlockAndSidecars(
+	ctx context.Context,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
+) (BeaconBlockT, BlobSidecarsT, error) {
+	return s.buildBlockAndSidecars(ctx, slotData)
+}
+
+// TrimDepositsToBudget exports trimDepositsToBudget for testing.
+func TrimDepositsToBudget[DepositT any](
+	body interface {
+		GetDeposits() []DepositT
+		SetDeposits([]DepositT)
+	},
+	size func() uint64,
+	maxBytes uint64,
+) (int, uint64) {
+	return trimDepositsToBudget[DepositT](body, size, maxBytes)
+}
+
+// ProposalSteps returns the names of the steps of the proposal ladder.
+func ProposalSteps(allowMinimal bool) []string {
+	steps := proposalSteps(allowMinimal)
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		names[i] = string(step)
+	}
+	return names
+}
+
+// EmptyPayload exports emptyPayload for testing.
+func EmptyPayload[ExecutionPayloadT ExecutionPayload[ExecutionPayloadT]](
+	forkVersion uint32,
+) engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT] {
+	return emptyPayload[ExecutionPayloadT](forkVersion)
+}
+
+// StepDeadline exports stepDeadline for testing.
+var StepDeadline = stepDeadline
+
+// RenderGraffiti exports renderGraffiti for testing.
+var RenderGraffiti = renderGraffiti
+
+// ShortCommit exports shortCommit for testing.
+var ShortCommit = shortCommit
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// fallbackReserveDivisor divides the proposal deadline to obtain the share
+// of it reserved for each of the fallbacks.
+const fallbackReserveDivisor = 4
+
+// proposalStep is a step of the ladder followed to build a proposal. Each
+// step is cheaper and less dependent on the execution client than the
+// previous one, and is only tried if the previous one failed.
+type proposalStep string
+
+const (
+	// proposalStepOptimistic builds the block on the payload built
+	// optimistically for the slot, or on a payload requested synchronously
+	// from the execution client if there is none.
+	proposalStepOptimistic proposalStep = "optimistic"
+	// proposalStepNoBlobs builds the block on the payload the execution
+	// client assembled so far for the slot, requested again and retrieved
+	// right away, provided it carries no blobs.
+	proposalStepNoBlobs proposalStep = "no_blobs"
+	// proposalStepMinimal builds a block without deposits, attestations,
+	// slashings or blobs, on an empty payload built without the execution
+	// client. The block keeps the execution head of its parent.
+	proposalStepMinimal proposalStep = "minimal"
+)
+
+// proposalSteps returns the steps of the proposal ladder, in order.
+func proposalSteps(allowMinimal bool) []proposalStep {
+	steps := []proposalStep{proposalStepOptimistic, proposalStepNoBlobs}
+	if allowMinimal {
+		steps = append(steps, proposalStepMinimal)
+	}
+	return steps
+}
+
+// emptyPayload returns the envelope of an empty payload for the given fork
+// version, carrying no blobs. A block carrying an empty payload keeps the
+// execution head of its parent.
+func emptyPayload[ExecutionPayloadT ExecutionPayload[ExecutionPayloadT]](
+	forkVersion uint32,
+) engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT] {
+	var payload ExecutionPayloadT
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: payload.Empty(forkVersion),
+		BlockValue:       math.NewU256(0),
+		BlobsBundle: &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{},
+	}
+}
+
+// stepDeadline returns the deadline of the step at the given index of a
+// ladder of numSteps steps, given the proposal deadline. Every step leaves a
+// share of the proposal duration to each of the steps following it.
+func stepDeadline(
+	deadline time.Time,
+	proposalDuration time.Duration,
+	index, numSteps int,
+) time.Time {
+	remaining := time.Duration(numSteps - 1 - index)
+	return deadline.Add(
+		-remaining * (proposalDuration / fallbackReserveDivisor),
+	)
+}
+
+// stepContext returns the context the step at the given index of the
+// proposal ladder runs with.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) stepContext(
+	ctx context.Context, index, numSteps int,
+) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok || index == numSteps-1 {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(
+		ctx,
+		stepDeadline(deadline, s.cfg.ProposalDeadline, index, numSteps),
+	)
+}
+
+// proposalDeadline bounds the given context by the proposal deadline, if
+// any.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) proposalDeadline(
+	ctx context.Context, startTime time.Time,
+) (context.Context, context.CancelFunc) {
+	if s.cfg.ProposalDeadline <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, startTime.Add(s.cfg.ProposalDeadline))
+}
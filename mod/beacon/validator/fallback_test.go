@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalSteps(t *testing.T) {
+	require.Equal(t,
+		[]string{"optimistic", "no_blobs"},
+		validator.ProposalSteps(false),
+	)
+	require.Equal(t,
+		[]string{"optimistic", "no_blobs", "minimal"},
+		validator.ProposalSteps(true),
+	)
+}
+
+func TestEmptyPayload(t *testing.T) {
+	// The minimal step builds on an empty payload of the active fork, it
+	// carries no blobs and is worth nothing.
+	envelope := validator.EmptyPayload[*testPayload](version.Deneb)
+	require.Equal(
+		t, &testPayload{ForkVersion: version.Deneb},
+		envelope.GetExecutionPayload(),
+	)
+	require.Empty(t, envelope.GetBlobsBundle().GetBlobs())
+	require.Empty(t, envelope.GetBlobsBundle().GetCommitments())
+	require.True(t, envelope.GetValue().IsZero())
+}
+
+func TestStepDeadline(t *testing.T) {
+	var (
+		start    = time.Unix(1_700_000_000, 0)
+		duration = 2 * time.Second
+		deadline = start.Add(duration)
+	)
+
+	// Every step gets its own share of the proposal deadline, the last one
+	// runs until the proposal deadline.
+	tests := []struct {
+		numSteps int
+		want     []time.Duration
+	}{
+		{
+			numSteps: 2,
+			want:     []time.Duration{1500 * time.Millisecond, duration},
+		},
+		{
+			numSteps: 3,
+			want: []time.Duration{
+				time.Second, 1500 * time.Millisecond, duration,
+			},
+		},
+	}
+	for _, tt := range tests {
+		for i, want := range tt.want {
+			got := validator.StepDeadline(deadline, duration, i, tt.numSteps)
+			require.Equal(t, start.Add(want), got,
+				"step %d of %d", i, tt.numSteps)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"strings"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+)
+
+const (
+	// graffitiUser is the placeholder of the configured graffiti.
+	graffitiUser = "{user}"
+	// graffitiCL is the placeholder of the consensus client code.
+	graffitiCL = "{cl}"
+	// graffitiCLCommit is the placeholder of the consensus client commit.
+	graffitiCLCommit = "{cl_commit}"
+	// graffitiEL is the placeholder of the execution client code.
+	graffitiEL = "{el}"
+	// graffitiELCommit is the placeholder of the execution client commit.
+	graffitiELCommit = "{el_commit}"
+)
+
+// graffitiCommitLengths are the lengths the commits are shortened to, in
+// order, until the graffiti fits, following the client identification
+// conventions of the Engine API.
+//
+//nolint:gochecknoglobals // constant.
+var graffitiCommitLengths = []int{4, 2, 0}
+
+// renderGraffiti renders the graffiti of a block from the given template,
+// graffiti and client versions. The execution client version is nil while
+// it is unknown. The graffiti is returned as is if the template is empty or
+// cannot be rendered within the maximum graffiti length.
+func renderGraffiti(
+	template string,
+	graffiti string,
+	cl engineprimitives.ClientVersionV1,
+	el *engineprimitives.ClientVersionV1,
+) string {
+	if template == "" {
+		return graffiti
+	}
+
+	var elCode, elCommit string
+	if el != nil {
+		elCode, elCommit = el.Code, el.Commit
+	}
+	for _, length := range graffitiCommitLengths {
+		rendered := strings.TrimSpace(strings.NewReplacer(
+			graffitiUser, graffiti,
+			graffitiCL, cl.Code,
+			graffitiCLCommit, shortCommit(cl.Commit, length),
+			graffitiEL, elCode,
+			graffitiELCommit, shortCommit(elCommit, length),
+		).Replace(template))
+		if len(rendered) <= maxGraffitiLength {
+			return rendered
+		}
+	}
+	return graffiti
+}
+
+// shortCommit returns the first hex characters of the given commit, up to
+// the given length.
+func shortCommit(commit string, length int) string {
+	commit = strings.ToLower(strings.TrimPrefix(commit, "0x"))
+	if len(commit) > length {
+		return commit[:length]
+	}
+	return commit
+}
+
+// graffiti returns the graffiti the blocks are proposed with, rendered from
+// the configured template.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) graffiti() string {
+	cl, els := s.ClientVersions()
+	var el *engineprimitives.ClientVersionV1
+	if len(els) > 0 {
+		el = &els[0]
+	}
+	return renderGraffiti(
+		s.cfg.GraffitiTemplate, s.proposerSettings.Graffiti(), cl, el,
+	)
+}
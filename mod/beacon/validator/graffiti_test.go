@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/stretchr/testify/require"
+)
+
+// graffitiTemplate is a template with every placeholder of the graffiti.
+const graffitiTemplate = "{user} {cl}{cl_commit} {el}{el_commit}"
+
+var (
+	// clVersion is the client version of the consensus client.
+	clVersion = engineprimitives.ClientVersionV1{
+		Code: "BK", Commit: "0xABCDEF12",
+	}
+	// elVersion is the client version of the execution client.
+	elVersion = engineprimitives.ClientVersionV1{
+		Code: "GE", Commit: "12345678",
+	}
+)
+
+func TestRenderGraffiti(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		graffiti string
+		el       *engineprimitives.ClientVersionV1
+		want     string
+	}{
+		{
+			name:     "empty template",
+			graffiti: "beacon",
+			el:       &elVersion,
+			want:     "beacon",
+		},
+		{
+			name:     "full commits",
+			template: graffitiTemplate,
+			graffiti: "beacon",
+			el:       &elVersion,
+			want:     "beacon BKabcd GE1234",
+		},
+		{
+			name:     "unknown execution client",
+			template: graffitiTemplate,
+			graffiti: "beacon",
+			want:     "beacon BKabcd",
+		},
+		{
+			name:     "empty graffiti",
+			template: graffitiTemplate,
+			el:       &elVersion,
+			want:     "BKabcd GE1234",
+		},
+		{
+			name:     "short commits",
+			template: graffitiTemplate,
+			graffiti: strings.Repeat("g", 20),
+			el:       &elVersion,
+			want:     strings.Repeat("g", 20) + " BKab GE12",
+		},
+		{
+			name:     "no commits",
+			template: graffitiTemplate,
+			graffiti: strings.Repeat("g", 24),
+			el:       &elVersion,
+			want:     strings.Repeat("g", 24) + " BK GE",
+		},
+		{
+			name:     "too long",
+			template: graffitiTemplate,
+			graffiti: strings.Repeat("g", 27),
+			el:       &elVersion,
+			want:     strings.Repeat("g", 27),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validator.RenderGraffiti(
+				tt.template, tt.graffiti, clVersion, tt.el,
+			)
+			require.Equal(t, tt.want, got)
+			require.LessOrEqual(t, len(got), 32)
+		})
+	}
+}
+
+func TestShortCommit(t *testing.T) {
+	require.Equal(t, "abcd", validator.ShortCommit("0xABCDEF12", 4))
+	require.Equal(t, "ab", validator.ShortCommit("0xABCDEF12", 2))
+	require.Equal(t, "ab", validator.ShortCommit("ab", 4))
+	require.Empty(t, validator.ShortCommit("0xABCDEF12", 0))
+	require.Empty(t, validator.ShortCommit("", 4))
+}
@@ -23,9 +23,19 @@ package validator
 import (
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+const (
+	// clientRoleConsensus labels the client version of the consensus
+	// client.
+	clientRoleConsensus = "consensus"
+	// clientRoleExecution labels the client versions of the execution
+	// client.
+	clientRoleExecution = "execution"
+)
+
 // validatorMetrics is a struct that contains metrics for the chain.
 type validatorMetrics struct {
 	// sink is the sink for the metrics.
@@ -74,3 +84,105 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// incrementPrebuiltPayloadHit increments the counter for the number of
+// proposals built on a payload that was built ahead of time.
+func (cm *validatorMetrics) incrementPrebuiltPayloadHit() {
+	cm.sink.IncrementCounter("beacon_kit.validator.prebuilt_payload_hit")
+}
+
+// incrementPrebuiltPayloadMiss increments the counter for the number of
+// proposals for which no payload built ahead of time could be retrieved.
+func (cm *validatorMetrics) incrementPrebuiltPayloadMiss() {
+	cm.sink.IncrementCounter("beacon_kit.validator.prebuilt_payload_miss")
+}
+
+// measureProposalSize records the size of a proposal and how much of the
+// byte budget of the proposal it consumes, in percent.
+func (cm *validatorMetrics) measureProposalSize(size, maxBytes uint64) {
+	//#nosec:G701 // proposals are far smaller than max int64.
+	cm.sink.SetGauge(
+		"beacon_kit.validator.proposal_size_bytes", int64(size),
+	)
+	if maxBytes == 0 {
+		return
+	}
+	//#nosec:G701 // proposals are far smaller than max int64.
+	cm.sink.SetGauge(
+		"beacon_kit.validator.proposal_size_utilization",
+		int64(size*100/maxBytes),
+	)
+}
+
+// incrementProposalDepositsTrimmed increments the counter for the number of
+// proposals that left deposits out to fit within their byte budget.
+func (cm *validatorMetrics) incrementProposalDepositsTrimmed() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposal_deposits_trimmed",
+	)
+}
+
+// incrementProposalStepFailed increments the counter for the number of
+// times a step of the proposal ladder failed.
+func (cm *validatorMetrics) incrementProposalStepFailed(step proposalStep) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposal_step_failed",
+		"step",
+		string(step),
+	)
+}
+
+// incrementProposalFallbackUsed increments the counter for the number of
+// proposals built by a fallback of the proposal ladder.
+func (cm *validatorMetrics) incrementProposalFallbackUsed(step proposalStep) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposal_fallback_used",
+		"step",
+		string(step),
+	)
+}
+
+// failedToRetrieveRelayPayload increments the counter for the number of
+// times the payload of the relay was not used.
+func (cm *validatorMetrics) failedToRetrieveRelayPayload(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_retrieve_relay_payload",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
+
+// incrementRelayPayloadUsed increments the counter for the number of
+// proposals built on the payload of an external builder.
+func (cm *validatorMetrics) incrementRelayPayloadUsed() {
+	cm.sink.IncrementCounter("beacon_kit.validator.relay_payload_used")
+}
+
+// setClientVersion sets the gauge of the given client version to one while
+// the client is in use, and to zero once it is not.
+func (cm *validatorMetrics) setClientVersion(
+	role string, v engineprimitives.ClientVersionV1, inUse bool,
+) {
+	var value int64
+	if inUse {
+		value = 1
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.validator.client_version",
+		value,
+		"role",
+		role,
+		"code",
+		v.Code,
+		"name",
+		v.Name,
+		"version",
+		v.Version,
+		"commit",
+		v.Commit,
+	)
+}
@@ -0,0 +1,373 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// errMockPayload is returned by the test payload builder for mock payloads.
+var errMockPayload = errors.New("mock payloads are not built")
+
+// testBlockSize is the size of the test blocks, deposits aside.
+const testBlockSize = 1_000
+
+type (
+	// testAttestation is the attestation data of a slot.
+	testAttestation struct{}
+	// testSlashing is the slashing info of a slot.
+	testSlashing struct{}
+	// testSidecars holds the number of blob sidecars of a block.
+	testSidecars int
+)
+
+// testPayload is an execution payload recording the fork version it was
+// built for.
+type testPayload struct {
+	ForkVersion uint32
+	Number      uint64
+}
+
+func (p *testPayload) Empty(forkVersion uint32) *testPayload {
+	return &testPayload{ForkVersion: forkVersion}
+}
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(bz []byte) error {
+	type payload testPayload
+	return json.Unmarshal(bz, (*payload)(p))
+}
+
+// testHeader is the header of the latest execution payload.
+type testHeader struct{}
+
+func (testHeader) GetTimestamp() math.U64 { return 0 }
+func (testHeader) GetBlockHash() gethprimitives.ExecutionHash {
+	return gethprimitives.ExecutionHash{0x01}
+}
+func (testHeader) GetParentHash() gethprimitives.ExecutionHash {
+	return gethprimitives.ExecutionHash{}
+}
+func (testHeader) GetPrevRandao() common.Bytes32   { return common.Bytes32{} }
+func (testHeader) GetWithdrawalsRoot() common.Root { return common.Root{} }
+
+// testEth1Data is the eth1 data of a block.
+type testEth1Data struct{}
+
+func (*testEth1Data) New(
+	common.Root, math.U64, gethprimitives.ExecutionHash,
+) *testEth1Data {
+	return &testEth1Data{}
+}
+
+// testForkData is the fork data the randao reveal is signed over.
+type testForkData struct{}
+
+func (*testForkData) New(common.Version, common.Root) *testForkData {
+	return &testForkData{}
+}
+func (*testForkData) ComputeDomain(common.DomainType) common.Domain {
+	return common.Domain{}
+}
+func (*testForkData) ComputeRandaoSigningRoot(
+	common.DomainType, math.Epoch,
+) common.Root {
+	return common.Root{}
+}
+
+// testBlockBody is the body of a test block.
+type testBlockBody struct {
+	deposits    []uint64
+	commitments eip4844.KZGCommitments[gethprimitives.ExecutionHash]
+	payload     *testPayload
+}
+
+func (b *testBlockBody) MarshalSSZ() ([]byte, error)         { return nil, nil }
+func (b *testBlockBody) UnmarshalSSZ([]byte) error           { return nil }
+func (b *testBlockBody) IsNil() bool                         { return b == nil }
+func (b *testBlockBody) SetRandaoReveal(crypto.BLSSignature) {}
+func (b *testBlockBody) SetEth1Data(*testEth1Data)           {}
+func (b *testBlockBody) GetDeposits() []uint64 {
+	return b.deposits
+}
+func (b *testBlockBody) SetDeposits(deposits []uint64) {
+	b.deposits = deposits
+}
+func (b *testBlockBody) SetExecutionPayload(payload *testPayload) {
+	b.payload = payload
+}
+func (b *testBlockBody) SetGraffiti(common.Bytes32)         {}
+func (b *testBlockBody) SetAttestations([]*testAttestation) {}
+func (b *testBlockBody) SetSlashingInfo([]*testSlashing)    {}
+func (b *testBlockBody) SetBlobKzgCommitments(
+	commitments eip4844.KZGCommitments[gethprimitives.ExecutionHash],
+) {
+	b.commitments = commitments
+}
+
+// testBlock is a beacon block whose size only depends on its deposits.
+type testBlock struct {
+	slot      math.Slot
+	stateRoot common.Root
+	body      *testBlockBody
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) { return nil, nil }
+func (b *testBlock) UnmarshalSSZ([]byte) error   { return nil }
+func (b *testBlock) NewWithVersion(
+	slot math.Slot, _ math.ValidatorIndex, _ common.Root, _ uint32,
+) (*testBlock, error) {
+	return &testBlock{slot: slot, body: &testBlockBody{}}, nil
+}
+func (b *testBlock) GetSlot() math.Slot        { return b.slot }
+func (b *testBlock) GetStateRoot() common.Root { return b.stateRoot }
+func (b *testBlock) GetBody() *testBlockBody   { return b.body }
+func (b *testBlock) GetProposerIndex() math.ValidatorIndex {
+	return 0
+}
+func (b *testBlock) GetParentBlockRoot() common.Root {
+	return common.Root{}
+}
+func (b *testBlock) SetStateRoot(root common.Root) {
+	b.stateRoot = root
+}
+func (b *testBlock) SizeSSZ(bool) uint32 {
+	//#nosec:G701 // the test blocks are small.
+	return testBlockSize + uint32(len(b.body.deposits))*depositSize
+}
+
+// testState is a beacon state at a slot.
+type testState struct {
+	slot math.Slot
+}
+
+func (s *testState) Copy() *testState {
+	st := *s
+	return &st
+}
+func (s *testState) GetBlockRootAtIndex(uint64) (common.Root, error) {
+	return common.Root{}, nil
+}
+func (s *testState) GetLatestExecutionPayloadHeader() (testHeader, error) {
+	return testHeader{}, nil
+}
+func (s *testState) SetLatestExecutionPayloadHeader(testHeader) error {
+	return nil
+}
+func (s *testState) GetSlot() (math.Slot, error) { return s.slot, nil }
+func (s *testState) HashTreeRoot() common.Root   { return common.Root{} }
+func (s *testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+func (s *testState) GetEth1DepositIndex() (uint64, error) { return 0, nil }
+func (s *testState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+// testDepositStore serves the given deposits.
+type testDepositStore struct {
+	deposits []uint64
+}
+
+func (s *testDepositStore) GetDepositsByIndex(
+	startIndex, numView uint64,
+) ([]uint64, error) {
+	end := min(startIndex+numView, uint64(len(s.deposits)))
+	return s.deposits[startIndex:end], nil
+}
+
+// testStorageBackend serves the state and deposits of the proposals.
+type testStorageBackend struct {
+	st       *testState
+	deposits *testDepositStore
+}
+
+func (b *testStorageBackend) DepositStore() *testDepositStore {
+	return b.deposits
+}
+func (b *testStorageBackend) StateFromContext(context.Context) *testState {
+	return b.st
+}
+
+// testStateProcessor advances the test states through the slots.
+type testStateProcessor struct{}
+
+func (testStateProcessor) ProcessSlots(
+	st *testState, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	st.slot = slot
+	return nil, nil
+}
+func (testStateProcessor) Transition(
+	*transition.Context, *testState, *testBlock,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+// testSigner signs with an empty signature.
+type testSigner struct{}
+
+func (testSigner) PublicKey() crypto.BLSPubkey { return crypto.BLSPubkey{} }
+func (testSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, nil
+}
+func (testSigner) VerifySignature(
+	crypto.BLSPubkey, []byte, crypto.BLSSignature,
+) error {
+	return nil
+}
+
+// testBlobFactory counts the sidecars of the blocks.
+type testBlobFactory struct{}
+
+func (testBlobFactory) BuildSidecars(
+	_ *testBlock, blobs engineprimitives.BlobsBundle,
+) (testSidecars, error) {
+	return testSidecars(len(blobs.GetBlobs())), nil
+}
+
+// testPayloadBuilder serves the same payload, built ahead of time or not,
+// and records the requests of the proposals.
+type testPayloadBuilder struct {
+	envelope  engineprimitives.BuiltExecutionPayloadEnv[*testPayload]
+	requested []string
+}
+
+// newTestEnvelope returns the envelope of a payload carrying the given
+// number of blobs.
+func newTestEnvelope(
+	numBlobs int,
+) engineprimitives.BuiltExecutionPayloadEnv[*testPayload] {
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for range numBlobs {
+		bundle.Commitments = append(
+			bundle.Commitments, eip4844.KZGCommitment{},
+		)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof{})
+		bundle.Blobs = append(bundle.Blobs, new(eip4844.Blob))
+	}
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*testPayload,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: &testPayload{Number: 1},
+		BlockValue:       math.NewU256(0),
+		BlobsBundle:      bundle,
+	}
+}
+
+func (b *testPayloadBuilder) RetrievePayload(
+	context.Context, math.Slot, common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	b.requested = append(b.requested, "retrieve")
+	return b.envelope, nil
+}
+func (b *testPayloadBuilder) RequestPayloadSync(
+	context.Context, *testState, math.Slot, uint64, common.Root,
+	gethprimitives.ExecutionHash, gethprimitives.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	b.requested = append(b.requested, "sync")
+	return b.envelope, nil
+}
+func (b *testPayloadBuilder) BuildMockPayload(
+	context.Context, *testState, math.Slot, uint64, common.Root,
+	gethprimitives.ExecutionHash, gethprimitives.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	return nil, errMockPayload
+}
+func (b *testPayloadBuilder) RequestPayloadNow(
+	context.Context, *testState, math.Slot, uint64, common.Root,
+	gethprimitives.ExecutionHash, gethprimitives.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	b.requested = append(b.requested, "now")
+	return b.envelope, nil
+}
+
+// testRelay is a disabled relay.
+type testRelay struct{}
+
+func (testRelay) Enabled() bool { return false }
+func (testRelay) RegisterValidator(context.Context, common.Domain) error {
+	return nil
+}
+func (testRelay) RetrieveBid(
+	context.Context, math.Slot, gethprimitives.ExecutionHash, *math.U256,
+	common.Domain,
+) (testHeader, []eip4844.KZGCommitment, *math.U256, error) {
+	return testHeader{}, nil, nil, nil
+}
+func (testRelay) SubmitBlindedBlock(
+	context.Context, *testBlock, testHeader, []eip4844.KZGCommitment,
+	*math.U256, common.Domain,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	return nil, nil
+}
+
+// testTracker considers every block valid.
+type testTracker struct{}
+
+func (testTracker) IsInvalid(common.Root) (bool, error) { return false, nil }
+
+// testSink records the counters incremented, along with their labels.
+type testSink struct {
+	counters []string
+}
+
+func (s *testSink) IncrementCounter(key string, args ...string) {
+	for _, arg := range args {
+		key += ":" + arg
+	}
+	s.counters = append(s.counters, key)
+}
+func (s *testSink) SetGauge(string, int64, ...string)         {}
+func (s *testSink) MeasureSince(string, time.Time, ...string) {}
+
+// testSlot is the slot data of a proposal with a byte budget.
+type testSlot struct {
+	slot     math.Slot
+	maxBytes uint64
+}
+
+func (d testSlot) GetSlot() math.Slot                     { return d.slot }
+func (d testSlot) GetAttestationData() []*testAttestation { return nil }
+func (d testSlot) GetSlashingInfo() []*testSlashing       { return nil }
+func (d testSlot) GetMaxBytes() uint64                    { return d.maxBytes }
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// relayRegistrationInterval is the interval at which the validator renews
+// its registration with the relay.
+const relayRegistrationInterval = 5 * time.Minute
+
+// registerWithRelay registers the validator with the relay, and renews the
+// registration periodically until the context is done.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) registerWithRelay(ctx context.Context) {
+	ticker := time.NewTicker(relayRegistrationInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.relay.RegisterValidator(
+			ctx, s.builderDomain(),
+		); err != nil {
+			s.logger.Warn("Failed to register with relay", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// proposeRelayPayload proposes the given block with the payload of the best
+// bid of the relay in place of its local payload, provided the bid is worth
+// more. The block, whose state root was computed on the given state with the
+// local payload, is blinded with the header of the bid, signed and submitted
+// to the relay, which reveals the payload. The block is left with its local
+// payload and the local sidecars are returned if any of this fails.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, BlobSidecarsT, _, _, _,
+	ExecutionPayloadT, _, _, _, _,
+]) proposeRelayPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	sidecars BlobSidecarsT,
+	maxBytes uint64,
+) BlobSidecarsT {
+	var (
+		body      = blk.GetBody()
+		stateRoot = blk.GetStateRoot()
+	)
+
+	envelope, err := s.submitRelayBlock(ctx, st, blk, local)
+	if err == nil && maxBytes > 0 {
+		if size := proposalSize(blk, envelope); size > maxBytes {
+			err = errors.Wrapf(
+				ErrProposalTooLarge,
+				"proposal size %d, max bytes %d", size, maxBytes,
+			)
+		}
+	}
+	var relaySidecars BlobSidecarsT
+	if err == nil {
+		relaySidecars, err = s.blobFactory.BuildSidecars(
+			blk, envelope.GetBlobsBundle(),
+		)
+	}
+	if err != nil {
+		s.metrics.failedToRetrieveRelayPayload(blk.GetSlot(), err)
+		s.logger.Info(
+			"Using local payload",
+			"slot", blk.GetSlot().Base10(),
+			"reason", err,
+		)
+		body.SetExecutionPayload(local.GetExecutionPayload())
+		body.SetBlobKzgCommitments(local.GetBlobsBundle().GetCommitments())
+		blk.SetStateRoot(stateRoot)
+		return sidecars
+	}
+
+	s.metrics.incrementRelayPayloadUsed()
+	return relaySidecars
+}
+
+// submitRelayBlock retrieves the best bid of the relay for the given block
+// and, if it beats the local payload, submits the block blinded with the
+// header of the bid to the relay. The block is given the blob commitments,
+// state root and, once revealed, payload of the bid.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, _, _, _, _,
+]) submitRelayBlock(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	proposerDomain, err := s.proposerDomain(st, blk)
+	if err != nil {
+		return nil, err
+	}
+	localHeader, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	header, commitments, value, err := s.relay.RetrieveBid(
+		ctx,
+		blk.GetSlot(),
+		localHeader.GetParentHash(),
+		local.GetValue(),
+		s.builderDomain(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The state depends on the payload through its header only, provided
+	// the payload processes the same withdrawals and randao as the local
+	// one, so that the state root of the block with the payload of the bid
+	// is the one with the header of the bid in place of the local one.
+	if header.GetWithdrawalsRoot() != localHeader.GetWithdrawalsRoot() ||
+		header.GetPrevRandao() != localHeader.GetPrevRandao() {
+		return nil, errors.Wrapf(
+			ErrRelayBidMismatch, "block hash %s", header.GetBlockHash(),
+		)
+	}
+	if err = st.SetLatestExecutionPayloadHeader(header); err != nil {
+		return nil, err
+	}
+	blk.SetStateRoot(st.HashTreeRoot())
+	blk.GetBody().SetBlobKzgCommitments(commitments)
+
+	envelope, err := s.relay.SubmitBlindedBlock(
+		ctx, blk, header, commitments, value, proposerDomain,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk.GetBody().SetExecutionPayload(envelope.GetExecutionPayload())
+	return envelope, nil
+}
+
+// builderDomain computes the domain of the messages exchanged with the
+// builders, which as per the builder API does not depend on the fork.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, ForkDataT, _, _,
+]) builderDomain() common.Domain {
+	var forkData ForkDataT
+	return forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(0),
+		), common.Root{},
+	).ComputeDomain(s.chainSpec.DomainTypeApplicationMask())
+}
+
+// proposerDomain computes the domain of the proposer signatures for the
+// block.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+]) proposerDomain(
+	st BeaconStateT, blk BeaconBlockT,
+) (common.Domain, error) {
+	var forkData ForkDataT
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return common.Domain{}, err
+	}
+
+	return forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		), genesisValidatorsRoot,
+	).ComputeDomain(s.chainSpec.DomainTypeProposer()), nil
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -41,12 +42,12 @@ type Service[
 	BeaconBlockBodyT BeaconBlockBody[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[BeaconStateT, ExecutionPayloadHeaderT],
 	BlobSidecarsT,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -81,9 +82,32 @@ type Service[
 	// Building blocks are done by submitting forkchoice updates through.
 	// The local Builder.
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT]
-	// remotePayloadBuilders represents a list of remote block builders, these
-	// builders are connected to other execution clients via the EngineAPI.
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// simPayloadBuilder builds the payloads of the simulated proposals, it
+	// keeps its payload IDs apart from the ones of the local builder and
+	// never retrieves the payloads of the latter.
+	simPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// relay is the relay to the external block builders, their payloads are
+	// preferred over the local one when they are worth more.
+	relay Relay[BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT]
+	// optimisticTracker is used to avoid proposing on top of blocks that
+	// were rejected by the execution client.
+	optimisticTracker OptimisticTracker
+	// proposerSettings holds the graffiti the blocks are proposed with.
+	proposerSettings *ProposerSettings
+	// doppelganger holds back the proposals while the key of the validator
+	// may be active on another node.
+	doppelganger *Doppelganger
+	// executionClient is the execution client the client versions are
+	// exchanged with.
+	executionClient ExecutionClient
+	// clientVersion is the client version of this consensus client.
+	clientVersion engineprimitives.ClientVersionV1
+	// executionVersions are the client versions reported by the execution
+	// client.
+	executionVersions *executionVersions
+	// sim is set on the copies of the service simulating proposals, nil
+	// otherwise.
+	sim *simulation
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// blkBroker is a publisher for blocks.
@@ -104,12 +128,12 @@ func NewService[
 	BeaconBlockBodyT BeaconBlockBody[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[BeaconStateT, ExecutionPayloadHeaderT],
 	BlobSidecarsT,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -133,7 +157,13 @@ func NewService[
 		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	simPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	relay Relay[BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT],
+	optimisticTracker OptimisticTracker,
+	proposerSettings *ProposerSettings,
+	doppelganger *Doppelganger,
+	executionClient ExecutionClient,
+	clientVersion engineprimitives.ClientVersionV1,
 	ts TelemetrySink,
 	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]],
 	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]],
@@ -148,19 +178,26 @@ func NewService[
 		BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
 	]{
-		cfg:                   cfg,
-		logger:                logger,
-		bsb:                   bsb,
-		chainSpec:             chainSpec,
-		signer:                signer,
-		stateProcessor:        stateProcessor,
-		blobFactory:           blobFactory,
-		localPayloadBuilder:   localPayloadBuilder,
-		remotePayloadBuilders: remotePayloadBuilders,
-		metrics:               newValidatorMetrics(ts),
-		blkBroker:             blkBroker,
-		sidecarBroker:         sidecarBroker,
-		newSlotSub:            newSlotSub,
+		cfg:                 cfg,
+		logger:              logger,
+		bsb:                 bsb,
+		chainSpec:           chainSpec,
+		signer:              signer,
+		stateProcessor:      stateProcessor,
+		blobFactory:         blobFactory,
+		localPayloadBuilder: localPayloadBuilder,
+		simPayloadBuilder:   simPayloadBuilder,
+		relay:               relay,
+		optimisticTracker:   optimisticTracker,
+		proposerSettings:    proposerSettings,
+		doppelganger:        doppelganger,
+		executionClient:     executionClient,
+		clientVersion:       clientVersion,
+		executionVersions:   new(executionVersions),
+		metrics:             newValidatorMetrics(ts),
+		blkBroker:           blkBroker,
+		sidecarBroker:       sidecarBroker,
+		newSlotSub:          newSlotSub,
 	}
 }
 
@@ -177,6 +214,16 @@ func (s *Service[
 ]) Start(
 	ctx context.Context,
 ) error {
+	if s.doppelganger.Enabled() {
+		s.logger.Info(
+			"Holding back signing until the doppelganger check passes",
+			"blocks", s.cfg.DoppelgangerBlocks,
+		)
+	}
+	if s.relay.Enabled() {
+		go s.registerWithRelay(ctx)
+	}
+	go s.trackClientVersions(ctx)
 	go s.start(ctx)
 	return nil
 }
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+const (
+	// proposerSettingsFileMode is the file mode of the proposer settings
+	// file.
+	proposerSettingsFileMode = 0o600
+	// maxGraffitiLength is the maximum length of the graffiti in bytes.
+	maxGraffitiLength = 32
+)
+
+// proposerSettingsFile is the content of the proposer settings file, only
+// the settings overriding the configuration are present.
+type proposerSettingsFile struct {
+	Pubkey       crypto.BLSPubkey                 `json:"pubkey"`
+	FeeRecipient *gethprimitives.ExecutionAddress `json:"fee_recipient,omitempty"`
+	Graffiti     *string                          `json:"graffiti,omitempty"`
+	GasLimit     *uint64                          `json:"gas_limit,omitempty"`
+}
+
+// ProposerSettings holds the fee recipient, graffiti and gas limit the
+// validator proposes with. Settings changed at runtime are persisted to the
+// proposer settings file and take precedence over the configured defaults,
+// they apply from the next proposal on. The gas limit is only registered
+// with the relay, the gas limit of local payloads is the one configured in
+// the execution client.
+type ProposerSettings struct {
+	// mu guards the overrides.
+	mu sync.RWMutex
+	// path is the path of the proposer settings file.
+	path string
+	// pubkey is the public key of the validator.
+	pubkey crypto.BLSPubkey
+	// feeRecipient is the configured fee recipient.
+	feeRecipient gethprimitives.ExecutionAddress
+	// graffiti is the configured graffiti.
+	graffiti string
+	// gasLimit is the configured gas limit.
+	gasLimit uint64
+	// relayEnabled determines if payloads are requested from the relay,
+	// the gas limit cannot be changed otherwise.
+	relayEnabled bool
+	// overrides are the settings changed at runtime.
+	overrides proposerSettingsFile
+}
+
+// NewProposerSettings creates the proposer settings of the validator with
+// the given public key, loading the settings persisted at the given path on
+// top of the given defaults.
+func NewProposerSettings(
+	path string,
+	pubkey crypto.BLSPubkey,
+	feeRecipient gethprimitives.ExecutionAddress,
+	graffiti string,
+	gasLimit uint64,
+	relayEnabled bool,
+) (*ProposerSettings, error) {
+	s := &ProposerSettings{
+		path:         path,
+		pubkey:       pubkey,
+		feeRecipient: feeRecipient,
+		graffiti:     graffiti,
+		gasLimit:     gasLimit,
+		relayEnabled: relayEnabled,
+		overrides:    proposerSettingsFile{Pubkey: pubkey},
+	}
+
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(bz, &s.overrides); err != nil {
+		return nil, errors.Wrapf(err, "proposer settings file %s", path)
+	}
+	if s.overrides.Pubkey != pubkey {
+		return nil, errors.Wrapf(
+			ErrProposerSettingsPubkey, "%s, expected %s",
+			s.overrides.Pubkey, pubkey,
+		)
+	}
+	return s, nil
+}
+
+// PublicKey returns the public key of the validator.
+func (s *ProposerSettings) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// FeeRecipient returns the fee recipient of the validator.
+func (s *ProposerSettings) FeeRecipient() gethprimitives.ExecutionAddress {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.overrides.FeeRecipient != nil {
+		return *s.overrides.FeeRecipient
+	}
+	return s.feeRecipient
+}
+
+// SetFeeRecipient overrides the fee recipient of the validator.
+func (s *ProposerSettings) SetFeeRecipient(
+	feeRecipient gethprimitives.ExecutionAddress,
+) error {
+	return s.update(func(o *proposerSettingsFile) {
+		o.FeeRecipient = &feeRecipient
+	})
+}
+
+// DeleteFeeRecipient reverts the fee recipient of the validator to the
+// configured one.
+func (s *ProposerSettings) DeleteFeeRecipient() error {
+	return s.update(func(o *proposerSettingsFile) {
+		o.FeeRecipient = nil
+	})
+}
+
+// Graffiti returns the graffiti of the validator.
+func (s *ProposerSettings) Graffiti() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.overrides.Graffiti != nil {
+		return *s.overrides.Graffiti
+	}
+	return s.graffiti
+}
+
+// SetGraffiti overrides the graffiti of the validator.
+func (s *ProposerSettings) SetGraffiti(graffiti string) error {
+	if len(graffiti) > maxGraffitiLength {
+		return errors.Wrapf(ErrGraffitiTooLong, "%d bytes", len(graffiti))
+	}
+	return s.update(func(o *proposerSettingsFile) {
+		o.Graffiti = &graffiti
+	})
+}
+
+// DeleteGraffiti reverts the graffiti of the validator to the configured
+// one.
+func (s *ProposerSettings) DeleteGraffiti() error {
+	return s.update(func(o *proposerSettingsFile) {
+		o.Graffiti = nil
+	})
+}
+
+// GasLimit returns the gas limit of the validator.
+func (s *ProposerSettings) GasLimit() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.overrides.GasLimit != nil {
+		return *s.overrides.GasLimit
+	}
+	return s.gasLimit
+}
+
+// GasLimitEnabled returns true if the gas limit of the validator is used,
+// which is only the case if payloads are requested from the relay.
+func (s *ProposerSettings) GasLimitEnabled() bool {
+	return s.relayEnabled
+}
+
+// SetGasLimit overrides the gas limit of the validator.
+func (s *ProposerSettings) SetGasLimit(gasLimit uint64) error {
+	if !s.relayEnabled {
+		return ErrGasLimitWithoutRelay
+	}
+	if gasLimit == 0 {
+		return ErrZeroGasLimit
+	}
+	return s.update(func(o *proposerSettingsFile) {
+		o.GasLimit = &gasLimit
+	})
+}
+
+// DeleteGasLimit reverts the gas limit of the validator to the configured
+// one.
+func (s *ProposerSettings) DeleteGasLimit() error {
+	return s.update(func(o *proposerSettingsFile) {
+		o.GasLimit = nil
+	})
+}
+
+// update applies the given change to the overrides and persists them. The
+// overrides are left untouched if they cannot be persisted.
+func (s *ProposerSettings) update(change func(*proposerSettingsFile)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	overrides := s.overrides
+	change(&overrides)
+	if err := s.persist(overrides); err != nil {
+		return err
+	}
+	s.overrides = overrides
+	return nil
+}
+
+// persist atomically writes the given overrides to the proposer settings
+// file.
+func (s *ProposerSettings) persist(overrides proposerSettingsFile) error {
+	bz, err := json.MarshalIndent(overrides, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(s.path), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err = os.WriteFile(tmp, bz, proposerSettingsFileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	settingsPubkey      = crypto.BLSPubkey{0x01}
+	defaultFeeRecipient = gethprimitives.ExecutionAddress{0x02}
+	defaultGraffiti     = "default"
+	defaultGasLimit     = uint64(30_000_000)
+	overriddenRecipient = gethprimitives.ExecutionAddress{0x03}
+	overriddenGraffiti  = "overridden"
+	overriddenGasLimit  = uint64(36_000_000)
+	settingsFileRelPath = filepath.Join("config", "proposer_settings.json")
+	foreignPubkey       = crypto.BLSPubkey{0x04}
+)
+
+// newSettings creates the proposer settings of the test validator persisted
+// at the given path.
+func newSettings(
+	t *testing.T, path string, relayEnabled bool,
+) *validator.ProposerSettings {
+	t.Helper()
+	s, err := validator.NewProposerSettings(
+		path, settingsPubkey, defaultFeeRecipient, defaultGraffiti,
+		defaultGasLimit, relayEnabled,
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func TestProposerSettings_Defaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), settingsFileRelPath)
+	s := newSettings(t, path, true)
+
+	require.Equal(t, settingsPubkey, s.PublicKey())
+	require.Equal(t, defaultFeeRecipient, s.FeeRecipient())
+	require.Equal(t, defaultGraffiti, s.Graffiti())
+	require.Equal(t, defaultGasLimit, s.GasLimit())
+
+	// Nothing is persisted until a setting is overridden.
+	_, err := os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestProposerSettings_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), settingsFileRelPath)
+	s := newSettings(t, path, true)
+	require.NoError(t, s.SetFeeRecipient(overriddenRecipient))
+	require.NoError(t, s.SetGraffiti(overriddenGraffiti))
+	require.NoError(t, s.SetGasLimit(overriddenGasLimit))
+
+	// The overrides survive a restart and take precedence over the
+	// configured settings.
+	s = newSettings(t, path, true)
+	require.Equal(t, overriddenRecipient, s.FeeRecipient())
+	require.Equal(t, overriddenGraffiti, s.Graffiti())
+	require.Equal(t, overriddenGasLimit, s.GasLimit())
+
+	// Deleted overrides revert to the configured settings, also after a
+	// restart.
+	require.NoError(t, s.DeleteFeeRecipient())
+	require.NoError(t, s.DeleteGasLimit())
+	require.Equal(t, defaultFeeRecipient, s.FeeRecipient())
+	require.Equal(t, defaultGasLimit, s.GasLimit())
+
+	s = newSettings(t, path, true)
+	require.Equal(t, defaultFeeRecipient, s.FeeRecipient())
+	require.Equal(t, overriddenGraffiti, s.Graffiti())
+	require.Equal(t, defaultGasLimit, s.GasLimit())
+}
+
+func TestProposerSettings_InvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, settingsFileRelPath)
+	require.NoError(t, newSettings(t, path, true).SetGraffiti("graffiti"))
+
+	_, err := validator.NewProposerSettings(
+		path, foreignPubkey, defaultFeeRecipient, defaultGraffiti,
+		defaultGasLimit, true,
+	)
+	require.ErrorIs(t, err, validator.ErrProposerSettingsPubkey)
+
+	// A corrupted file is reported rather than ignored.
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = validator.NewProposerSettings(
+		path, settingsPubkey, defaultFeeRecipient, defaultGraffiti,
+		defaultGasLimit, true,
+	)
+	require.Error(t, err)
+}
+
+func TestProposerSettings_InvalidOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), settingsFileRelPath)
+	s := newSettings(t, path, true)
+
+	require.ErrorIs(
+		t, s.SetGraffiti(string(make([]byte, 33))),
+		validator.ErrGraffitiTooLong,
+	)
+	require.ErrorIs(t, s.SetGasLimit(0), validator.ErrZeroGasLimit)
+
+	// Rejected overrides are not persisted.
+	_, err := os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestProposerSettings_GasLimitWithoutRelay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), settingsFileRelPath)
+	s := newSettings(t, path, false)
+	require.False(t, s.GasLimitEnabled())
+
+	// The gas limit of local payloads is configured in the execution
+	// client, it cannot be overridden without the relay.
+	require.ErrorIs(
+		t, s.SetGasLimit(overriddenGasLimit),
+		validator.ErrGasLimitWithoutRelay,
+	)
+	require.Equal(t, defaultGasLimit, s.GasLimit())
+	require.True(t, newSettings(t, path, true).GasLimitEnabled())
+}
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// simulation holds the settings of a simulated proposal and records the
+// time spent in its phases. Its methods are no-ops on a nil simulation.
+type simulation struct {
+	// proposerIndex is the proposer index the block is built for, the one
+	// of the validator if nil.
+	proposerIndex *math.ValidatorIndex
+	// timings is the time spent in every phase of the proposal.
+	timings map[string]time.Duration
+}
+
+// record adds the time elapsed since start to the given phase.
+func (sim *simulation) record(phase string, start time.Time) {
+	if sim == nil {
+		return
+	}
+	sim.timings[phase] += time.Since(start)
+}
+
+// SimulateProposal builds a block and its sidecars for the slot following
+// the head state of the given context, as the validator would propose it,
+// and returns them along with the time spent in every phase. Nothing is
+// published and no metrics are recorded. The block is built for the given
+// proposer index if not nil, and on a mock payload built without the
+// execution client if mockPayload is true. The payloads requested for the
+// proposals of the validator are never retrieved, as this stops the
+// execution client from improving them, and blocks are never submitted to
+// the relay, as this commits the proposer to them. The state of the context
+// is modified, a query context should be given.
+func (s *Service[
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, BlobSidecarsT, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, SlashingInfoT, _,
+]) SimulateProposal(
+	ctx context.Context,
+	proposerIndex *math.ValidatorIndex,
+	mockPayload bool,
+) (BeaconBlockT, BlobSidecarsT, map[string]time.Duration, error) {
+	var (
+		blk       BeaconBlockT
+		sidecars  BlobSidecarsT
+		startTime = time.Now()
+	)
+
+	slot, err := s.bsb.StateFromContext(ctx).GetSlot()
+	if err != nil {
+		return blk, sidecars, nil, err
+	}
+
+	// Simulate on a copy of the service whose side effects are disabled.
+	sim := *s
+	sim.sim = &simulation{
+		proposerIndex: proposerIndex,
+		timings:       make(map[string]time.Duration),
+	}
+	sim.metrics = newValidatorMetrics(noopTelemetrySink{})
+	sim.relay = disabledRelay[
+		BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]{}
+	sim.doppelganger = nil
+	sim.localPayloadBuilder = s.simPayloadBuilder
+	if mockPayload {
+		sim.localPayloadBuilder = mockPayloadBuilder[
+			BeaconStateT, ExecutionPayloadT,
+		]{s.simPayloadBuilder}
+	}
+
+	blk, sidecars, err = sim.buildBlockAndSidecars(
+		ctx, simulatedSlot[AttestationDataT, SlashingInfoT]{slot: slot + 1},
+	)
+	sim.sim.record("total", startTime)
+	return blk, sidecars, sim.sim.timings, err
+}
+
+// simulatedSlot is the slot data of a simulated proposal, it carries no
+// attestations nor slashings and sets no byte budget.
+type simulatedSlot[AttestationDataT, SlashingInfoT any] struct {
+	// slot is the slot of the proposal.
+	slot math.Slot
+}
+
+// GetSlot returns the slot of the proposal.
+func (d simulatedSlot[_, _]) GetSlot() math.Slot {
+	return d.slot
+}
+
+// GetAttestationData returns no attestation data.
+func (d simulatedSlot[
+	AttestationDataT, _,
+]) GetAttestationData() []AttestationDataT {
+	return nil
+}
+
+// GetSlashingInfo returns no slashing info.
+func (d simulatedSlot[_, SlashingInfoT]) GetSlashingInfo() []SlashingInfoT {
+	return nil
+}
+
+// GetMaxBytes returns zero, the proposal is not limited in size.
+func (d simulatedSlot[_, _]) GetMaxBytes() uint64 {
+	return 0
+}
+
+// mockPayloadBuilder builds mock payloads in place of requesting them from
+// the execution client.
+type mockPayloadBuilder[BeaconStateT, ExecutionPayloadT any] struct {
+	PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+}
+
+// RetrievePayload returns an error, mock payloads are not built ahead of
+// time.
+func (b mockPayloadBuilder[_, ExecutionPayloadT]) RetrievePayload(
+	context.Context, math.Slot, common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return nil, ErrMockPayloadNotPrebuilt
+}
+
+// RequestPayloadSync builds a mock payload.
+func (b mockPayloadBuilder[BeaconStateT, ExecutionPayloadT]) RequestPayloadSync(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash gethprimitives.ExecutionHash,
+	finalEth1BlockHash gethprimitives.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return b.BuildMockPayload(
+		ctx, st, slot, timestamp,
+		parentBlockRoot, headEth1BlockHash, finalEth1BlockHash,
+	)
+}
+
+// RequestPayloadNow builds a mock payload.
+func (b mockPayloadBuilder[BeaconStateT, ExecutionPayloadT]) RequestPayloadNow(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash gethprimitives.ExecutionHash,
+	finalEth1BlockHash gethprimitives.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return b.BuildMockPayload(
+		ctx, st, slot, timestamp,
+		parentBlockRoot, headEth1BlockHash, finalEth1BlockHash,
+	)
+}
+
+// disabledRelay is a relay that is never used.
+type disabledRelay[
+	BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT any,
+] struct{}
+
+// Enabled returns false.
+func (disabledRelay[_, _, _]) Enabled() bool {
+	return false
+}
+
+// RegisterValidator does nothing.
+func (disabledRelay[_, _, _]) RegisterValidator(
+	context.Context, common.Domain,
+) error {
+	return nil
+}
+
+// RetrieveBid returns an error, the relay is disabled.
+func (disabledRelay[_, _, ExecutionPayloadHeaderT]) RetrieveBid(
+	context.Context,
+	math.Slot,
+	gethprimitives.ExecutionHash,
+	*math.U256,
+	common.Domain,
+) (ExecutionPayloadHeaderT, []eip4844.KZGCommitment, *math.U256, error) {
+	var header ExecutionPayloadHeaderT
+	return header, nil, nil, ErrRelayDisabled
+}
+
+// SubmitBlindedBlock returns an error, the relay is disabled.
+func (disabledRelay[
+	BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) SubmitBlindedBlock(
+	context.Context,
+	BeaconBlockT,
+	ExecutionPayloadHeaderT,
+	[]eip4844.KZGCommitment,
+	*math.U256,
+	common.Domain,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return nil, ErrRelayDisabled
+}
+
+// noopTelemetrySink is a telemetry sink that drops the metrics.
+type noopTelemetrySink struct{}
+
+// IncrementCounter does nothing.
+func (noopTelemetrySink) IncrementCounter(string, ...string) {}
+
+// SetGauge does nothing.
+func (noopTelemetrySink) SetGauge(string, int64, ...string) {}
+
+// MeasureSince does nothing.
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
@@ -56,6 +56,8 @@ type BeaconBlock[
 	) (BeaconBlockT, error)
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the proposer index of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
 	// SetStateRoot sets the state root of the beacon block.
@@ -64,6 +66,8 @@ type BeaconBlock[
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
 	GetBody() BeaconBlockBodyT
+	// SizeSSZ returns the size of the SSZ encoded beacon block.
+	SizeSSZ(fixed bool) uint32
 }
 
 // BeaconBlockBody represents a beacon block body interface.
@@ -76,6 +80,8 @@ type BeaconBlockBody[
 	SetRandaoReveal(crypto.BLSSignature)
 	// SetEth1Data sets the Eth1 data of the beacon block body.
 	SetEth1Data(Eth1DataT)
+	// GetDeposits returns the deposits of the beacon block body.
+	GetDeposits() []DepositT
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]DepositT)
 	// SetExecutionPayload sets the execution data of the beacon block body.
@@ -92,7 +98,9 @@ type BeaconBlockBody[
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[BeaconStateT, ExecutionPayloadHeaderT any] interface {
+	// Copy creates a copy of the beacon state.
+	Copy() BeaconStateT
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
@@ -100,6 +108,9 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
+	// SetLatestExecutionPayloadHeader sets the latest execution payload
+	// header.
+	SetLatestExecutionPayloadHeader(ExecutionPayloadHeaderT) error
 	// GetSlot returns the current slot of the beacon state.
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
@@ -155,6 +166,13 @@ type Eth1Data[T any] interface {
 	) T
 }
 
+// ExecutionPayload represents the execution payload interface.
+type ExecutionPayload[ExecutionPayloadT any] interface {
+	constraints.JSONMarshallable
+	// Empty returns an empty execution payload for the given fork version.
+	Empty(forkVersion uint32) ExecutionPayloadT
+}
+
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
 	// GetTimestamp returns the timestamp of the execution payload header.
@@ -163,6 +181,11 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() gethprimitives.ExecutionHash
 	// GetParentHash returns the parent hash of the execution payload header.
 	GetParentHash() gethprimitives.ExecutionHash
+	// GetPrevRandao returns the prev randao of the execution payload header.
+	GetPrevRandao() common.Bytes32
+	// GetWithdrawalsRoot returns the withdrawals root of the execution
+	// payload header.
+	GetWithdrawalsRoot() common.Root
 }
 
 // EventSubscription represents the event subscription interface.
@@ -174,6 +197,19 @@ type EventPublisher[T any] interface {
 	Publish(context.Context, T) error
 }
 
+// ExecutionClient is the interface to the execution client the validator
+// identifies itself to.
+type ExecutionClient interface {
+	// GetClientVersionV1 exchanges the client versions with the execution
+	// client, returning the ones of the execution client.
+	GetClientVersionV1(
+		ctx context.Context,
+	) ([]engineprimitives.ClientVersionV1, error)
+	// Reconnected returns a channel notified whenever the connection to the
+	// execution client is established or restored.
+	Reconnected() <-chan struct{}
+}
+
 // ForkData represents the fork data interface.
 type ForkData[T any] interface {
 	// New creates a new fork data with the given parameters.
@@ -181,6 +217,8 @@ type ForkData[T any] interface {
 		common.Version,
 		common.Root,
 	) T
+	// ComputeDomain computes the domain of the given domain type.
+	ComputeDomain(common.DomainType) common.Domain
 	// ComputeRandaoSigningRoot computes the Randao signing root.
 	ComputeRandaoSigningRoot(
 		common.DomainType,
@@ -188,6 +226,14 @@ type ForkData[T any] interface {
 	) common.Root
 }
 
+// OptimisticTracker is the interface for tracking beacon blocks that were
+// imported before their payloads were verified by the execution client.
+type OptimisticTracker interface {
+	// IsInvalid returns true if the block with the given root, or one of
+	// its ancestors, was rejected by the execution client.
+	IsInvalid(root common.Root) (bool, error)
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
@@ -208,6 +254,71 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		headEth1BlockHash gethprimitives.ExecutionHash,
 		finalEth1BlockHash gethprimitives.ExecutionHash,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// BuildMockPayload builds an empty payload for the given slot without
+	// the execution client, for simulated proposals.
+	BuildMockPayload(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		parentBlockRoot common.Root,
+		headEth1BlockHash gethprimitives.ExecutionHash,
+		finalEth1BlockHash gethprimitives.ExecutionHash,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// RequestPayloadNow requests a payload for the given slot and
+	// retrieves it right away, without waiting for the payload timeout.
+	RequestPayloadNow(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		parentBlockRoot common.Root,
+		headEth1BlockHash gethprimitives.ExecutionHash,
+		finalEth1BlockHash gethprimitives.ExecutionHash,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// Relay represents a relay to the external block builders.
+type Relay[
+	BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT any,
+] interface {
+	// Enabled returns true if payloads are requested from the relay.
+	Enabled() bool
+	// RegisterValidator registers the validator with the relay, the
+	// registration is signed over the given builder domain.
+	RegisterValidator(ctx context.Context, domain common.Domain) error
+	// RetrieveBid requests the best bid of the relay for the given slot and
+	// returns the header, blob commitments and value of its payload,
+	// provided it beats the given value of the local payload.
+	RetrieveBid(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash gethprimitives.ExecutionHash,
+		localValue *math.U256,
+		builderDomain common.Domain,
+	) (ExecutionPayloadHeaderT, []eip4844.KZGCommitment, *math.U256, error)
+	// SubmitBlindedBlock signs the given block, blinded with the given
+	// header of a bid, over the given proposer domain and submits it to the
+	// relay, which reveals the payload of the bid.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		blk BeaconBlockT,
+		header ExecutionPayloadHeaderT,
+		commitments []eip4844.KZGCommitment,
+		value *math.U256,
+		proposerDomain common.Domain,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// RandaoSigner is implemented by the signers which sign the randao reveals
+// with a dedicated request, such as the remote signer.
+type RandaoSigner interface {
+	// SignRandaoReveal signs the given signing root of the randao reveal of
+	// the given epoch.
+	SignRandaoReveal(
+		epoch math.Epoch,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
 }
 
 // SlotData represents the slot data interface.
@@ -218,12 +329,15 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	GetAttestationData() []AttestationDataT
 	// GetSlashingInfo returns the slashing info of the incoming slot.
 	GetSlashingInfo() []SlashingInfoT
+	// GetMaxBytes returns the maximum number of bytes the proposal for the
+	// incoming slot may occupy, zero meaning no limit.
+	GetMaxBytes() uint64
 }
 
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[BeaconStateT, ExecutionPayloadHeaderT],
 	ContextT,
 	ExecutionPayloadHeaderT any,
 ] interface {
@@ -241,7 +355,7 @@ type StateProcessor[
 
 // StorageBackend is the interface for the storage backend.
 type StorageBackend[
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[BeaconStateT, ExecutionPayloadHeaderT],
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadHeaderT any,
@@ -257,6 +371,9 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
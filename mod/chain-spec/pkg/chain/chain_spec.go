@@ -88,6 +88,10 @@ type Spec[
 	// DepositContractAddress returns the deposit contract address.
 	DepositContractAddress() ExecutionAddressT
 
+	// DepositContractDeployBlock returns the execution block number at which
+	// the deposit contract was deployed.
+	DepositContractDeployBlock() uint64
+
 	// MaxDepositsPerBlock returns the maximum number of deposit operations per
 	// block.
 	MaxDepositsPerBlock() uint64
@@ -183,6 +187,10 @@ type Spec[
 	// GetCometBFTConfigForSlot retrieves the CometBFT config for a specific
 	// slot.
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
+
+	// CometBFTConfigUpdateAtSlot returns the CometBFT config that comes into
+	// effect at the given slot, if the slot starts a scheduled epoch.
+	CometBFTConfigUpdateAtSlot(slot SlotT) (CometBFTConfigT, bool)
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -331,6 +339,14 @@ func (c chainSpec[
 	return c.Data.DepositContractAddress
 }
 
+// DepositContractDeployBlock returns the execution block number at which the
+// deposit contract was deployed.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DepositContractDeployBlock() uint64 {
+	return c.Data.DepositContractDeployBlock
+}
+
 // MaxDepositsPerBlock returns the maximum number of deposits per block.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -470,9 +486,35 @@ func (c chainSpec[
 }
 
 // GetCometBFTConfigForSlot returns the CometBFT configuration for the given
-// slot.
+// slot, which is the one of the latest scheduled epoch at or before the slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT {
+	var (
+		epoch  = c.SlotToEpoch(slot)
+		config = c.Data.CometValues
+		latest EpochT
+		found  bool
+	)
+	for forkEpoch, forkConfig := range c.Data.CometValuesSchedule {
+		if forkEpoch > epoch || (found && forkEpoch < latest) {
+			continue
+		}
+		latest, config, found = forkEpoch, forkConfig, true
+	}
+	return config
+}
+
+// CometBFTConfigUpdateAtSlot returns the CometBFT configuration that comes
+// into effect at the given slot. It returns false if the slot is not the
+// first slot of an epoch in the schedule.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
-]) GetCometBFTConfigForSlot(_ SlotT) CometBFTConfigT {
-	return c.Data.CometValues
+]) CometBFTConfigUpdateAtSlot(slot SlotT) (CometBFTConfigT, bool) {
+	var config CometBFTConfigT
+	if slot == 0 || uint64(slot)%c.SlotsPerEpoch() != 0 {
+		return config, false
+	}
+	config, found := c.Data.CometValuesSchedule[c.SlotToEpoch(slot)]
+	return config, found
 }
@@ -78,6 +78,9 @@ type SpecData[
 	//
 	// DepositContractAddress is the address of the deposit contract.
 	DepositContractAddress ExecutionAddressT `mapstructure:"deposit-contract-address"`
+	// DepositContractDeployBlock is the execution block number at which the
+	// deposit contract was deployed.
+	DepositContractDeployBlock uint64 `mapstructure:"deposit-contract-deploy-block"`
 	// MaxDepositsPerBlock specifies the maximum number of deposit operations
 	// allowed per block.
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
@@ -146,4 +149,8 @@ type SpecData[
 
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
+	// CometValuesSchedule maps fork epochs to the CometBFT config that comes
+	// into effect at the first slot of that epoch. CometValues is in effect
+	// until the first scheduled epoch.
+	CometValuesSchedule map[EpochT]CometBFTConfigT `mapstructure:"comet-bft-config-schedule"`
 }
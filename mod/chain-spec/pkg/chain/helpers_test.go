@@ -155,3 +155,46 @@ func TestWithinDAPeriod(t *testing.T) {
 		})
 	}
 }
+
+// TestGetCometBFTConfigForSlot tests that the CometBFT config follows the
+// schedule of the chain spec.
+func TestGetCometBFTConfigForSlot(t *testing.T) {
+	cometSpec := chain.NewChainSpec(
+		chain.SpecData[domainType, epoch, executionAddress, slot, int]{
+			SlotsPerEpoch: 32,
+			CometValues:   1,
+			CometValuesSchedule: map[epoch]int{
+				2: 2,
+				5: 3,
+			},
+		},
+	)
+
+	tests := []struct {
+		name           string
+		slot           slot
+		expected       int
+		expectedUpdate bool
+	}{
+		{name: "Genesis", slot: 0, expected: 1},
+		{name: "Before First Fork", slot: 63, expected: 1},
+		{name: "At First Fork", slot: 64, expected: 2, expectedUpdate: true},
+		{name: "Within First Fork", slot: 65, expected: 2},
+		{name: "Before Second Fork", slot: 159, expected: 2},
+		{name: "At Second Fork", slot: 160, expected: 3, expectedUpdate: true},
+		{name: "After Second Fork", slot: 1000, expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.expected, cometSpec.GetCometBFTConfigForSlot(tt.slot),
+			)
+			update, ok := cometSpec.CometBFTConfigUpdateAtSlot(tt.slot)
+			require.Equal(t, tt.expectedUpdate, ok)
+			if ok {
+				require.Equal(t, tt.expected, update)
+			}
+		})
+	}
+}
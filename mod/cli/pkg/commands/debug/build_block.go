@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// nodeAPIURL is the flag for the url of the node API of the node to
+	// build the block on.
+	nodeAPIURL = "node-api-url"
+	// proposerIndex is the flag for the index of the validator to build the
+	// block for.
+	proposerIndex = "proposer-index"
+	// mockPayload is the flag for building the block on a mock payload.
+	mockPayload = "mock-payload"
+
+	// defaultNodeAPIURL is the url of the node API at its default address.
+	defaultNodeAPIURL = "http://localhost:3500"
+	// simulateProposalPath is the path of the proposal simulation endpoint.
+	simulateProposalPath = "/bkit/v1/debug/simulate_proposal"
+)
+
+// NewBuildBlock creates a new command for building the block following the
+// head of a running node, without publishing it.
+func NewBuildBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-block",
+		Short: "Builds the next block on a running node without publishing it",
+		Long: `Asks a running node to build the block following its head as its
+validator would propose it, and prints the block, its sidecars, its state root
+and the time spent in every phase of building it. Nothing is gossiped. Use
+--proposer-index to build the block for a validator other than the expected
+proposer, and --mock-payload to build it without the execution client.`,
+		Args: cobra.NoArgs,
+		RunE: buildBlockCmd,
+	}
+
+	cmd.Flags().String(
+		nodeAPIURL, defaultNodeAPIURL, "url of the node API of the node",
+	)
+	cmd.Flags().String(
+		proposerIndex, "", "index of the validator to build the block for",
+	)
+	cmd.Flags().Bool(
+		mockPayload, false, "build the block on a mock execution payload",
+	)
+	return cmd
+}
+
+// buildBlockCmd requests the proposal simulation from the node and prints
+// its result.
+func buildBlockCmd(cmd *cobra.Command, _ []string) error {
+	endpoint, err := simulateProposalURL(cmd)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		cmd.Context(), http.MethodGet, endpoint, http.NoBody,
+	)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"%w: %s: %s", ErrNodeAPIRequest, resp.Status,
+			strings.TrimSpace(string(body)),
+		)
+	}
+
+	var out bytes.Buffer
+	if err = json.Indent(&out, body, "", "  "); err != nil {
+		return err
+	}
+	cmd.Println(out.String())
+	return nil
+}
+
+// simulateProposalURL returns the url of the proposal simulation endpoint
+// with the query parameters given by the flags.
+func simulateProposalURL(cmd *cobra.Command) (string, error) {
+	base, err := cmd.Flags().GetString(nodeAPIURL)
+	if err != nil {
+		return "", err
+	}
+	index, err := cmd.Flags().GetString(proposerIndex)
+	if err != nil {
+		return "", err
+	}
+	mock, err := cmd.Flags().GetBool(mockPayload)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(base, "/"))
+	if err != nil {
+		return "", err
+	}
+	endpoint.Path += simulateProposalPath
+
+	query := url.Values{}
+	if index != "" {
+		query.Set("proposer_index", index)
+	}
+	query.Set("mock_payload", strconv.FormatBool(mock))
+	endpoint.RawQuery = query.Encode()
+	return endpoint.String(), nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import "errors"
+
+var (
+	// ErrReplayMismatch is returned when a replayed exchange is answered
+	// differently than recorded.
+	ErrReplayMismatch = errors.New(
+		"replayed exchanges do not match the recording",
+	)
+
+	// ErrReplayStateMissing is returned when the execution client to replay
+	// against does not know the parent of the first recorded payload.
+	ErrReplayStateMissing = errors.New(
+		"execution client is not at the stored state of the recording",
+	)
+
+	// ErrUndecodableRecord is returned when the request of a record cannot
+	// be decoded.
+	ErrUndecodableRecord = errors.New("undecodable record")
+
+	// ErrNodeAPIRequest is returned when the node API answers a request
+	// with an error.
+	ErrNodeAPIRequest = errors.New("node API request failed")
+)
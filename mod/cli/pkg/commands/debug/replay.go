@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// rpcDialURL is the flag for the url of the execution client to replay
+	// the recording against.
+	rpcDialURL = "rpc-dial-url"
+	// jwtSecretPath is the flag for the path to the JWT secret of the
+	// execution client.
+	jwtSecretPath = "jwt-secret-path"
+)
+
+// The verdicts of the engine on a newPayload or forkchoiceUpdated exchange.
+const (
+	verdictValid   = "VALID"
+	verdictInvalid = "INVALID"
+	verdictSyncing = "SYNCING"
+	verdictError   = "ERROR"
+)
+
+// NewReplayEngine creates a new command for replaying a recording of the
+// Engine API traffic of a node.
+func NewReplayEngine(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-engine [recording]...",
+		Short: "Replays recorded Engine API traffic through the execution engine",
+		Long: `Feeds the newPayload and forkchoiceUpdated requests of the given
+recording files, in order, through the execution engine of the node and
+reports every exchange whose verdict differs from the recorded one. The
+execution client at --rpc-dial-url must be started from the state stored by
+the node before the recording, i.e. it must know the parent of the first
+recorded payload.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: replayEngineCmd(chainSpec),
+	}
+
+	cmd.Flags().String(
+		rpcDialURL, "", "url of the execution client to replay against",
+	)
+	cmd.Flags().String(
+		jwtSecretPath, "", "path to the JWT secret of the execution client",
+	)
+	if err := cmd.MarkFlagRequired(rpcDialURL); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// replayEngineCmd returns a function that replays the recordings.
+func replayEngineCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		records, err := recorder.Load(args...)
+		if err != nil {
+			return err
+		}
+
+		tracker := new(replayTracker)
+		ec, err := startEngineClient(cmd, chainSpec)
+		if err != nil {
+			return err
+		}
+		defer ec.Close()
+		ee := engine.New[
+			*components.ExecutionPayload,
+			*components.PayloadAttributes,
+			components.PayloadID,
+			*components.Withdrawal,
+		](
+			ec,
+			noop.NewLogger[any](),
+			components.ProvideStatusBroker(),
+			components.ProvideTelemetrySink(),
+			tracker,
+		)
+
+		if err = checkStoredState(cmd.Context(), ec, records); err != nil {
+			return err
+		}
+
+		var replayed, mismatches int
+		for i, record := range records {
+			if !isReplayed(record.Method) {
+				continue
+			}
+			replayed++
+
+			recorded := recordedVerdict(record)
+			replay, rerr := replayRecord(cmd.Context(), ee, tracker, record)
+			if errors.Is(rerr, ErrUndecodableRecord) {
+				return fmt.Errorf("record %d: %w", i, rerr)
+			}
+			if recorded != replay {
+				mismatches++
+			}
+			cmd.Printf(
+				"%d %s %s recorded=%s replayed=%s",
+				i, record.Time.Format("15:04:05.000"), record.Method,
+				recorded, replay,
+			)
+			if rerr != nil {
+				cmd.Printf(" err=%v", rerr)
+			}
+			cmd.Println()
+		}
+
+		cmd.Printf(
+			"replayed %d exchanges, %d mismatches\n", replayed, mismatches,
+		)
+		if mismatches > 0 {
+			return fmt.Errorf("%w: %d", ErrReplayMismatch, mismatches)
+		}
+		return nil
+	}
+}
+
+// startEngineClient starts an engine client connected to the execution
+// client to replay against. Every request is authenticated with a freshly
+// signed JWT, so that replays outlive the validity of a single token.
+func startEngineClient(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (*components.EngineClient, error) {
+	dialURL, err := cmd.Flags().GetString(rpcDialURL)
+	if err != nil {
+		return nil, err
+	}
+	secretPath, err := cmd.Flags().GetString(jwtSecretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := client.DefaultConfig()
+	cfg.RPCFallbackDialURLs = nil
+	cfg.JWTSecretPath = secretPath
+	if cfg.RPCDialURL, err = url.NewFromRaw(dialURL); err != nil {
+		return nil, err
+	}
+
+	var secret *jwt.Secret
+	if secretPath != "" {
+		if secret, err = components.LoadJWTFromFile(secretPath); err != nil {
+			return nil, err
+		}
+	}
+
+	ec := client.New[
+		*components.ExecutionPayload, *components.PayloadAttributes,
+	](
+		&cfg,
+		noop.NewLogger[any](),
+		secret,
+		components.ProvideTelemetrySink(),
+		new(big.Int).SetUint64(chainSpec.DepositEth1ChainID()),
+		engineprimitives.ClientVersionV1{},
+	)
+	if err = ec.Start(cmd.Context()); err != nil {
+		return nil, err
+	}
+	return ec, nil
+}
+
+// checkStoredState checks that the execution client knows the parent of the
+// first recorded payload, i.e. that it was started from the state stored by
+// the node before the recording.
+func checkStoredState(
+	ctx context.Context,
+	ec *components.EngineClient,
+	records []*recorder.Record,
+) error {
+	for _, record := range records {
+		if record.Method != ethclient.NewPayloadMethodV3 {
+			continue
+		}
+		req, err := decodeNewPayload(record.Params)
+		if err != nil {
+			return err
+		}
+		parentHash := req.ExecutionPayload.GetParentHash()
+		if _, err = ec.HeaderByHash(ctx, parentHash); err != nil {
+			return fmt.Errorf(
+				"%w: parent %s: %w", ErrReplayStateMissing, parentHash, err,
+			)
+		}
+		return nil
+	}
+	return nil
+}
+
+// isReplayed returns true if requests of the given method are replayed.
+func isReplayed(method string) bool {
+	return method == ethclient.NewPayloadMethodV3 ||
+		method == ethclient.ForkchoiceUpdatedMethodV3
+}
+
+// replayRecord feeds the request of the record through the execution engine
+// and returns the verdict of the engine.
+func replayRecord(
+	ctx context.Context,
+	ee *components.ExecutionEngine,
+	tracker *replayTracker,
+	record *recorder.Record,
+) (string, error) {
+	tracker.verdict = ""
+	if record.Method == ethclient.NewPayloadMethodV3 {
+		req, err := decodeNewPayload(record.Params)
+		if err != nil {
+			return "", err
+		}
+		return tracker.verdictOf(ee.VerifyAndNotifyNewPayload(ctx, req))
+	}
+
+	req, err := decodeForkchoiceUpdate(record.Params)
+	if err != nil {
+		return "", err
+	}
+	_, _, err = ee.NotifyForkchoiceUpdate(ctx, req)
+	return tracker.verdictOf(err)
+}
+
+// decodeNewPayload decodes the params of a recorded newPayload request.
+func decodeNewPayload(
+	rawParams json.RawMessage,
+) (*engineprimitives.NewPayloadRequest[
+	*components.ExecutionPayload, *components.Withdrawal,
+], error) {
+	var (
+		params          []json.RawMessage
+		payload         = new(components.ExecutionPayload)
+		versionedHashes []gethprimitives.ExecutionHash
+		parentRoot      common.Root
+	)
+	if err := json.Unmarshal(rawParams, &params); err != nil {
+		return nil, errors.Join(ErrUndecodableRecord, err)
+	}
+	if len(params) != 3 { //nolint:mnd // payload, hashes and root.
+		return nil, ErrUndecodableRecord
+	}
+	if err := errors.Join(
+		json.Unmarshal(params[0], payload),
+		json.Unmarshal(params[1], &versionedHashes),
+		json.Unmarshal(params[2], &parentRoot),
+	); err != nil {
+		return nil, errors.Join(ErrUndecodableRecord, err)
+	}
+	return engineprimitives.BuildNewPayloadRequest(
+		payload, versionedHashes, &parentRoot, false,
+	), nil
+}
+
+// payloadAttributes is the type of the payload attributes of the node.
+type payloadAttributes = components.PayloadAttributes
+
+// decodeForkchoiceUpdate decodes the params of a recorded forkchoiceUpdated
+// request.
+func decodeForkchoiceUpdate(
+	rawParams json.RawMessage,
+) (*engineprimitives.ForkchoiceUpdateRequest[*payloadAttributes], error) {
+	var (
+		params []json.RawMessage
+		state  = new(engineprimitives.ForkchoiceStateV1)
+		attrs  *payloadAttributes
+	)
+	if err := json.Unmarshal(rawParams, &params); err != nil {
+		return nil, errors.Join(ErrUndecodableRecord, err)
+	}
+	if len(params) == 0 {
+		return nil, ErrUndecodableRecord
+	}
+	if err := json.Unmarshal(params[0], state); err != nil {
+		return nil, errors.Join(ErrUndecodableRecord, err)
+	}
+	if len(params) > 1 {
+		if err := json.Unmarshal(params[1], &attrs); err != nil {
+			return nil, errors.Join(ErrUndecodableRecord, err)
+		}
+	}
+
+	// The version of the attributes is not part of their encoding.
+	if attrs != nil {
+		var err error
+		if attrs, err = attrs.New(
+			version.Deneb,
+			attrs.Timestamp.Unwrap(),
+			attrs.PrevRandao,
+			attrs.SuggestedFeeRecipient,
+			attrs.Withdrawals,
+			attrs.ParentBeaconBlockRoot,
+		); err != nil {
+			return nil, errors.Join(ErrUndecodableRecord, err)
+		}
+	}
+	return engineprimitives.BuildForkchoiceUpdateRequest(
+		state, attrs, version.Deneb,
+	), nil
+}
+
+// recordedVerdict returns the verdict of the recorded newPayload or
+// forkchoiceUpdated response.
+func recordedVerdict(record *recorder.Record) string {
+	if record.Error != nil {
+		return verdictError
+	}
+
+	status := new(engineprimitives.PayloadStatusV1)
+	if record.Method == ethclient.ForkchoiceUpdatedMethodV3 {
+		resp := &engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{},
+		}
+		if err := json.Unmarshal(record.Result, resp); err != nil {
+			return verdictError
+		}
+		status = &resp.PayloadStatus
+	} else if err := json.Unmarshal(record.Result, status); err != nil {
+		return verdictError
+	}
+
+	switch status.Status {
+	case engineprimitives.PayloadStatusValid:
+		return verdictValid
+	case engineprimitives.PayloadStatusInvalid:
+		return verdictInvalid
+	case engineprimitives.PayloadStatusAccepted,
+		engineprimitives.PayloadStatusSyncing:
+		return verdictSyncing
+	default:
+		return verdictError
+	}
+}
+
+// replayTracker is the optimistic tracker of the replaying engine, it
+// records the verdict of the engine on the replayed exchange.
+type replayTracker struct {
+	verdict string
+}
+
+// MarkPayloadOptimistic implements engine.OptimisticTracker.
+func (t *replayTracker) MarkPayloadOptimistic(gethprimitives.ExecutionHash) {
+	t.verdict = verdictSyncing
+}
+
+// MarkPayloadValid implements engine.OptimisticTracker.
+func (t *replayTracker) MarkPayloadValid(gethprimitives.ExecutionHash) error {
+	t.verdict = verdictValid
+	return nil
+}
+
+// MarkPayloadInvalid implements engine.OptimisticTracker.
+func (t *replayTracker) MarkPayloadInvalid(
+	gethprimitives.ExecutionHash, *gethprimitives.ExecutionHash,
+) error {
+	t.verdict = verdictInvalid
+	return nil
+}
+
+// verdictOf returns the verdict of the engine on the exchange that ended
+// with the given error, along with the error.
+func (t *replayTracker) verdictOf(err error) (string, error) {
+	switch {
+	case t.verdict != "":
+		return t.verdict, err
+	case err == nil, errors.IsAny(
+		err,
+		engineerrors.ErrAcceptedPayloadStatus,
+		engineerrors.ErrSyncingPayloadStatus,
+	):
+		return verdictSyncing, err
+	default:
+		return verdictError, err
+	}
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	mockengine "github.com/berachain/beacon-kit/mod/execution/pkg/mock-engine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesis = `{
+	"config": {
+		"chainId": 80087,
+		"homesteadBlock": 0,
+		"eip150Block": 0,
+		"eip155Block": 0,
+		"eip158Block": 0,
+		"byzantiumBlock": 0,
+		"constantinopleBlock": 0,
+		"petersburgBlock": 0,
+		"istanbulBlock": 0,
+		"berlinBlock": 0,
+		"londonBlock": 0,
+		"shanghaiTime": 0,
+		"cancunTime": 0,
+		"terminalTotalDifficulty": 0,
+		"terminalTotalDifficultyPassed": true
+	},
+	"gasLimit": "0x1c9c380",
+	"difficulty": "0x0",
+	"alloc": {}
+}`
+
+// newMockEngine starts a mock execution client whose genesis block has the
+// given timestamp.
+func newMockEngine(
+	t *testing.T,
+	secret *jwt.Secret,
+	genesisTime uint64,
+) *mockengine.Engine {
+	t.Helper()
+	genesis := new(gethprimitives.Genesis)
+	require.NoError(t, json.Unmarshal([]byte(testGenesis), genesis))
+	genesis.Timestamp = genesisTime
+
+	el, err := mockengine.New(mockengine.Config{
+		Address:   "127.0.0.1:0",
+		JWTSecret: secret,
+	}, genesis)
+	require.NoError(t, err)
+	require.NoError(t, el.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, el.Stop()) })
+	return el
+}
+
+// recordBlock builds and imports a block on the given execution client with
+// recording enabled and returns the path of the recording.
+func recordBlock(
+	t *testing.T,
+	el *mockengine.Engine,
+	secret *jwt.Secret,
+) string {
+	t.Helper()
+	recording := filepath.Join(t.TempDir(), "engine.jsonl")
+	cfg := client.DefaultConfig()
+	cfg.RecordPath = recording
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	var err error
+	cfg.RPCDialURL, err = url.NewFromRaw(el.URL())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ec := client.New[
+		*components.ExecutionPayload, *components.PayloadAttributes,
+	](
+		&cfg,
+		noop.NewLogger[any](),
+		secret,
+		components.ProvideTelemetrySink(),
+		new(big.Int).SetUint64(spec.DevnetEth1ChainID),
+		engineprimitives.ClientVersionV1{},
+	)
+	require.NoError(t, ec.Start(ctx))
+
+	genesis := el.Head()
+	beaconRoot := common.Root{0xbe}
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		version.Deneb,
+		genesis.Time()+1,
+		common.Bytes32{0x01},
+		gethprimitives.HexToAddress("0x02"),
+		[]*components.Withdrawal{},
+		beaconRoot,
+	)
+	require.NoError(t, err)
+	id, _, err := ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      genesis.Hash(),
+			SafeBlockHash:      genesis.Hash(),
+			FinalizedBlockHash: genesis.Hash(),
+		}, attrs, version.Deneb,
+	)
+	require.NoError(t, err)
+	envelope, err := ec.GetPayload(ctx, *id, version.Deneb)
+	require.NoError(t, err)
+	payload := envelope.GetExecutionPayload()
+	_, err = ec.NewPayload(
+		ctx, payload, []gethprimitives.ExecutionHash{}, &beaconRoot,
+	)
+	require.NoError(t, err)
+	_, _, err = ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      payload.GetBlockHash(),
+			SafeBlockHash:      payload.GetBlockHash(),
+			FinalizedBlockHash: genesis.Hash(),
+		}, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	return recording
+}
+
+// replay runs the replay-engine command against the given execution client.
+func replay(
+	t *testing.T,
+	el *mockengine.Engine,
+	secretPath string,
+	recording string,
+) error {
+	t.Helper()
+	cmd := debug.NewReplayEngine(spec.DevnetChainSpec())
+	cmd.SetArgs([]string{
+		"--rpc-dial-url", el.URL(),
+		"--jwt-secret-path", secretPath,
+		recording,
+	})
+	cmd.SetOut(io.Discard)
+	return cmd.ExecuteContext(context.Background())
+}
+
+func TestReplayEngine(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(secretPath, []byte(secret.Hex()), 0o600))
+
+	el := newMockEngine(t, secret, 0)
+	recording := recordBlock(t, el, secret)
+
+	// The execution client the recording was made against gives the same
+	// verdicts through the engine.
+	require.NoError(t, replay(t, el, secretPath, recording))
+
+	// An execution client rejecting the recorded payload is reported.
+	el.SetPayloadStatus(engineprimitives.PayloadStatusInvalid)
+	require.ErrorIs(
+		t, replay(t, el, secretPath, recording), debug.ErrReplayMismatch,
+	)
+
+	// An execution client that is not at the stored state of the recording
+	// is refused.
+	other := newMockEngine(t, secret, 1)
+	require.ErrorIs(
+		t, replay(t, other, secretPath, recording), debug.ErrReplayStateMissing,
+	)
+}
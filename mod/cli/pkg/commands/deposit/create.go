@@ -27,6 +27,7 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
@@ -282,7 +283,6 @@ func broadcastDepositTx[
 func getBLSSigner(
 	cmd *cobra.Command,
 ) (crypto.BLSSigner, error) {
-	var legacyKey components.LegacyKey
 	overrideFlag, err := cmd.Flags().GetBool(overrideNodeKey)
 	if err != nil {
 		return nil, err
@@ -298,16 +298,20 @@ func getBLSSigner(
 		if validatorPrivKey == "" {
 			return nil, ErrValidatorPrivateKeyRequired
 		}
+		var legacyKey components.LegacyKey
 		legacyKey, err = signer.LegacyKeyFromString(validatorPrivKey)
 		if err != nil {
 			return nil, err
 		}
+		return signer.NewLegacySigner(legacyKey)
 	}
 
-	return components.ProvideBlsSigner(
-		components.BlsSignerInput{
-			AppOpts: client.GetViperFromCmd(cmd),
-			PrivKey: legacyKey,
-		},
-	)
+	v := client.GetViperFromCmd(cmd)
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
+		return nil, err
+	}
+	// The private validator is only served to CometBFT by the node.
+	blsSigner, _, err := components.LoadBLSSigner(v, cfg)
+	return blsSigner, err
 }
@@ -23,6 +23,7 @@ package deposit
 import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -45,6 +46,7 @@ func Commands[ExecutionPayloadT constraints.EngineType[ExecutionPayloadT]](
 	cmd.AddCommand(
 		NewValidateDeposit(chainSpec),
 		NewCreateValidator[ExecutionPayloadT](chainSpec),
+		NewGenerateDeposits(chainSpec),
 	)
 
 	return cmd
@@ -61,21 +63,40 @@ func NewValidateDeposit(chainSpec common.ChainSpec) *cobra.Command {
 		deposit message includes the public key, withdrawal credentials,
 		and deposit amount. The args taken are in the order of the public key,
 		withdrawal credentials, deposit amount, signature, current version,
-		and genesis validator root.`,
-		Args: cobra.ExactArgs(6),
+		and genesis validator root. If the deposit-data-file flag is set, the
+		deposits of the given deposit_data.json file are validated instead
+		and the only argument is the genesis validator root.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(depositDataFile) {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(6)(cmd, args)
+		},
 		RunE: validateDepositMessage(chainSpec),
 	}
 
+	cmd.Flags().String(
+		depositDataFile, defaultDepositDataFile, depositDataFileMsg,
+	)
+
 	return cmd
 }
 
 // validateDepositMessage validates a deposit message for creating a new
 // validator.
 func validateDepositMessage(chainSpec common.ChainSpec) func(
-	_ *cobra.Command,
-	args []string,
+	*cobra.Command,
+	[]string,
 ) error {
-	return func(_ *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		path, err := cmd.Flags().GetString(depositDataFile)
+		if err != nil {
+			return err
+		}
+		if path != "" {
+			return validateDepositDataFile(chainSpec, path, args[0])
+		}
+
 		pubkey, err := parser.ConvertPubkey(args[0])
 		if err != nil {
 			return err
@@ -120,3 +141,31 @@ func validateDepositMessage(chainSpec common.ChainSpec) func(
 		)
 	}
 }
+
+// validateDepositDataFile validates the deposits of the given
+// deposit_data.json file.
+func validateDepositDataFile(
+	chainSpec common.ChainSpec,
+	path string,
+	genesisValidatorRootArg string,
+) error {
+	genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(
+		genesisValidatorRootArg,
+	)
+	if err != nil {
+		return err
+	}
+
+	deposits, err := ReadDepositData(path)
+	if err != nil {
+		return err
+	}
+	for i, deposit := range deposits {
+		if err = deposit.Verify(
+			genesisValidatorRoot, chainSpec.DomainTypeDeposit(),
+		); err != nil {
+			return errors.Wrapf(err, "deposit %d", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// DepositData is a signed deposit in the deposit_data.json format of the
+// staking-deposit-cli, its hex fields are encoded without prefix.
+type DepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+// NewDepositData creates the deposit data of the given signed deposit
+// message.
+func NewDepositData(
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+	forkVersion common.Version,
+) *DepositData {
+	messageRoot := depositMsg.HashTreeRoot()
+	dataRoot := (&depositData{
+		DepositMessage: *depositMsg,
+		Signature:      signature,
+	}).HashTreeRoot()
+	return &DepositData{
+		Pubkey:                hex.EncodeToString(depositMsg.Pubkey[:]),
+		WithdrawalCredentials: hex.EncodeToString(depositMsg.Credentials[:]),
+		Amount:                depositMsg.Amount.Unwrap(),
+		Signature:             hex.EncodeToString(signature[:]),
+		DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+		DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+		ForkVersion:           hex.EncodeToString(forkVersion[:]),
+	}
+}
+
+// Verify verifies the roots and the signature of the deposit data, for the
+// given genesis validator root.
+func (d *DepositData) Verify(
+	genesisValidatorRoot common.Root,
+	domainType common.DomainType,
+) error {
+	pubkey, err := parser.ConvertPubkey(prefixed(d.Pubkey))
+	if err != nil {
+		return err
+	}
+	credentials, err := parser.ConvertWithdrawalCredentials(
+		prefixed(d.WithdrawalCredentials),
+	)
+	if err != nil {
+		return err
+	}
+	signature, err := parser.ConvertSignature(prefixed(d.Signature))
+	if err != nil {
+		return err
+	}
+	forkVersion, err := parser.ConvertVersion(prefixed(d.ForkVersion))
+	if err != nil {
+		return err
+	}
+
+	depositMsg := &types.DepositMessage{
+		Pubkey:      pubkey,
+		Credentials: credentials,
+		Amount:      math.Gwei(d.Amount),
+	}
+	expected := NewDepositData(depositMsg, signature, forkVersion)
+	if !strings.EqualFold(
+		strings.TrimPrefix(d.DepositMessageRoot, "0x"),
+		expected.DepositMessageRoot,
+	) {
+		return errors.Wrap(ErrDepositDataRootMismatch, "deposit_message_root")
+	}
+	if !strings.EqualFold(
+		strings.TrimPrefix(d.DepositDataRoot, "0x"),
+		expected.DepositDataRoot,
+	) {
+		return errors.Wrap(ErrDepositDataRootMismatch, "deposit_data_root")
+	}
+
+	return depositMsg.VerifyCreateValidator(
+		types.NewForkData(forkVersion, genesisValidatorRoot),
+		signature,
+		domainType,
+		signer.BLSSigner{}.VerifySignature,
+	)
+}
+
+// ReadDepositData reads the deposit data entries of the given
+// deposit_data.json file.
+func ReadDepositData(path string) ([]*DepositData, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var deposits []*DepositData
+	if err = json.Unmarshal(bz, &deposits); err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+// depositData is the DepositData container of the Ethereum 2.0
+// specification, whose root is the deposit data root of the deposit
+// contract.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#depositdata
+//
+//nolint:lll
+type depositData struct {
+	types.DepositMessage
+	Signature crypto.BLSSignature
+}
+
+// SizeSSZ returns the size of the depositData object in SSZ encoding.
+func (*depositData) SizeSSZ() uint32 {
+	//nolint:mnd // 48 + 32 + 8 + 96 = 184.
+	return 184
+}
+
+// DefineSSZ defines the SSZ encoding for the depositData object.
+func (d *depositData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &d.Pubkey)
+	ssz.DefineStaticBytes(codec, &d.Credentials)
+	ssz.DefineUint64(codec, &d.Amount)
+	ssz.DefineStaticBytes(codec, &d.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the depositData object.
+func (d *depositData) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// prefixed returns the given hex string with a 0x prefix.
+func prefixed(s string) string {
+	return "0x" + strings.TrimPrefix(s, "0x")
+}
@@ -46,4 +46,14 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrInvalidValidatorRange is returned when the range of validators to
+	// generate is empty or overflows the key indices.
+	ErrInvalidValidatorRange = errors.New(
+		"invalid range of validators")
+
+	// ErrDepositDataRootMismatch is returned when a root of a deposit data
+	// entry does not match its content.
+	ErrDepositDataRootMismatch = errors.New(
+		"deposit data root mismatch")
 )
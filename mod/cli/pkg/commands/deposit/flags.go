@@ -35,6 +35,26 @@ const (
 
 	// rpcURL is the flag for the URL for the execution client RPC.
 	rpcURL = "rpc-url"
+
+	// mnemonicFile is the flag for the file holding the mnemonic to derive
+	// the validator keys from.
+	mnemonicFile = "mnemonic-file"
+
+	// numValidators is the flag for the number of validators to generate.
+	numValidators = "count"
+
+	// startIndex is the flag for the index of the first validator key.
+	startIndex = "start-index"
+
+	// outputDir is the flag for the directory of the generated files.
+	outputDir = "output-dir"
+
+	// keystorePasswordFile is the flag for the file holding the password of
+	// the generated keystores.
+	keystorePasswordFile = "keystore-password-file"
+
+	// depositDataFile is the flag for the deposit data file to validate.
+	depositDataFile = "deposit-data-file"
 )
 
 const (
@@ -64,6 +84,26 @@ const (
 
 	// defaultRPCURL is the default value for the rpcURL flag.
 	defaultRPCURL = "http://localhost:8545"
+
+	// defaultMnemonicFile is the default value for the mnemonicFile flag.
+	defaultMnemonicFile = ""
+
+	// defaultNumValidators is the default value for the numValidators flag.
+	defaultNumValidators = 1
+
+	// defaultStartIndex is the default value for the startIndex flag.
+	defaultStartIndex = 0
+
+	// defaultOutputDir is the default value for the outputDir flag.
+	defaultOutputDir = "validator_keys"
+
+	// defaultKeystorePasswordFile is the default value for the
+	// keystorePasswordFile flag.
+	defaultKeystorePasswordFile = ""
+
+	// defaultDepositDataFile is the default value for the depositDataFile
+	// flag.
+	defaultDepositDataFile = ""
 )
 
 const (
@@ -86,4 +126,28 @@ const (
 
 	// rpcURLMsg is the usage description for the rpcURL flag.
 	rpcURLMsg = "URL for the execution client RPC"
+
+	// mnemonicFileMsg is the usage description for the mnemonicFile flag.
+	mnemonicFileMsg = `file holding the mnemonic to derive the validator keys
+	from. Defaults to the BEACOND_MNEMONIC environment variable, a new
+	mnemonic is generated if neither is set.`
+
+	// numValidatorsMsg is the usage description for the numValidators flag.
+	numValidatorsMsg = "number of validators to generate"
+
+	// startIndexMsg is the usage description for the startIndex flag.
+	startIndexMsg = "index of the first validator key to derive"
+
+	// outputDirMsg is the usage description for the outputDir flag.
+	outputDirMsg = "directory to write the deposit data and keystores to"
+
+	// keystorePasswordFileMsg is the usage description for the
+	// keystorePasswordFile flag.
+	keystorePasswordFileMsg = `file holding the password of the keystores.
+	Defaults to the BEACOND_KEYSTORE_PASSWORD environment variable.`
+
+	// depositDataFileMsg is the usage description for the depositDataFile
+	// flag.
+	depositDataFileMsg = `deposit_data.json file to validate. If set, the
+	only argument is the genesis validator root.`
 )
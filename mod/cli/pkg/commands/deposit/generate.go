@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// mnemonicEnv is the environment variable the mnemonic is read from
+	// when no mnemonic file is given.
+	mnemonicEnv = "BEACOND_MNEMONIC"
+
+	// outputDirMode and depositDataFileMode are the file modes of the
+	// output directory and of the deposit data file.
+	outputDirMode       = 0o700
+	depositDataFileMode = 0o644
+)
+
+// NewGenerateDeposits creates a new command to generate the keys and the
+// deposit data of a batch of validators.
+func NewGenerateDeposits(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generates the keys and the deposit data of validators",
+		Long: `Generates the keys and the deposit data of validators. The keys
+		are derived from a BIP-39 mnemonic as per EIP-2333, at the EIP-2334
+		paths m/12381/3600/i/0/0. A deposit_data.json file in the format of
+		the staking-deposit-cli and an EIP-2335 keystore per validator are
+		written to the output directory. The arguments are expected in the
+		order of withdrawal credentials, deposit amount, current version,
+		and genesis validator root.`,
+		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
+		RunE: generateDepositsCmd(chainSpec),
+	}
+
+	cmd.Flags().String(mnemonicFile, defaultMnemonicFile, mnemonicFileMsg)
+	cmd.Flags().Uint32(numValidators, defaultNumValidators, numValidatorsMsg)
+	cmd.Flags().Uint32(startIndex, defaultStartIndex, startIndexMsg)
+	cmd.Flags().String(outputDir, defaultOutputDir, outputDirMsg)
+	cmd.Flags().String(
+		keystorePasswordFile, defaultKeystorePasswordFile,
+		keystorePasswordFileMsg,
+	)
+
+	return cmd
+}
+
+// generateDepositsCmd returns a command that derives the validator keys and
+// signs their deposits.
+func generateDepositsCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		credentials, err := parser.ConvertWithdrawalCredentials(args[0])
+		if err != nil {
+			return err
+		}
+		amount, err := parser.ConvertAmount(args[1])
+		if err != nil {
+			return err
+		}
+		currentVersion, err := parser.ConvertVersion(args[2])
+		if err != nil {
+			return err
+		}
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[3])
+		if err != nil {
+			return err
+		}
+
+		count, err := cmd.Flags().GetUint32(numValidators)
+		if err != nil {
+			return err
+		}
+		start, err := cmd.Flags().GetUint32(startIndex)
+		if err != nil {
+			return err
+		}
+		if count == 0 || start+count < start {
+			return ErrInvalidValidatorRange
+		}
+		dir, err := cmd.Flags().GetString(outputDir)
+		if err != nil {
+			return err
+		}
+
+		passwordFile, err := cmd.Flags().GetString(keystorePasswordFile)
+		if err != nil {
+			return err
+		}
+		password, err := signer.ReadKeystorePassword(passwordFile)
+		if err != nil {
+			return err
+		}
+
+		seed, err := getSeed(cmd)
+		if err != nil {
+			return err
+		}
+
+		if err = os.MkdirAll(dir, outputDirMode); err != nil {
+			return err
+		}
+
+		var (
+			forkData  = types.NewForkData(currentVersion, genesisValidatorRoot)
+			deposits  = make([]*DepositData, 0, count)
+			timestamp = time.Now().Unix()
+		)
+		for i := range count {
+			var depositData *DepositData
+			depositData, err = generateDeposit(
+				seed, start+i, password, dir, timestamp,
+				forkData, chainSpec.DomainTypeDeposit(), credentials, amount,
+			)
+			if err != nil {
+				return err
+			}
+			deposits = append(deposits, depositData)
+		}
+
+		bz, err := json.MarshalIndent(deposits, "", "  ")
+		if err != nil {
+			return err
+		}
+		depositDataPath := filepath.Join(
+			dir, fmt.Sprintf("deposit_data-%d.json", timestamp),
+		)
+		if err = os.WriteFile(
+			depositDataPath, bz, depositDataFileMode,
+		); err != nil {
+			return err
+		}
+
+		cmd.Printf(
+			"Generated %d deposits to %s\n", len(deposits), depositDataPath,
+		)
+		return nil
+	}
+}
+
+// generateDeposit derives the signing key of the validator with the given
+// index, writes its keystore to the given directory and returns its signed
+// deposit data.
+func generateDeposit(
+	seed []byte,
+	index uint32,
+	password string,
+	dir string,
+	timestamp int64,
+	forkData *types.ForkData,
+	domainType common.DomainType,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+) (*DepositData, error) {
+	path := signer.SigningKeyPath(index)
+	key, err := signer.DeriveKey(seed, path)
+	if err != nil {
+		return nil, err
+	}
+
+	ks, err := signer.EncryptKeystore(key, password)
+	if err != nil {
+		return nil, err
+	}
+	ks.Path = path
+	if err = ks.Save(filepath.Join(dir, fmt.Sprintf(
+		"keystore-%s-%d.json", strings.ReplaceAll(path, "/", "_"), timestamp,
+	))); err != nil {
+		return nil, err
+	}
+
+	blsSigner, err := signer.NewLegacySigner(key)
+	if err != nil {
+		return nil, err
+	}
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		forkData, domainType, blsSigner, credentials, amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewDepositData(depositMsg, signature, forkData.CurrentVersion), nil
+}
+
+// getSeed returns the seed of the mnemonic read from the mnemonic file or
+// from the mnemonicEnv environment variable. If neither is set, a new
+// mnemonic is generated and printed.
+func getSeed(cmd *cobra.Command) ([]byte, error) {
+	path, err := cmd.Flags().GetString(mnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var mnemonic string
+	switch envMnemonic, ok := os.LookupEnv(mnemonicEnv); {
+	case path != "":
+		var bz []byte
+		if bz, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+		mnemonic = string(bz)
+	case ok:
+		mnemonic = envMnemonic
+	default:
+		if mnemonic, err = signer.NewMnemonic(); err != nil {
+			return nil, err
+		}
+		cmd.Printf(
+			"Generated a new mnemonic, write it down and keep it safe, "+
+				"it is the only way to recover the validator keys:\n\n%s\n\n",
+			mnemonic,
+		)
+	}
+	return signer.MnemonicToSeed(mnemonic, "")
+}
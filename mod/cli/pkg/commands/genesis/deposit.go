@@ -25,9 +25,9 @@ import (
 	"os"
 	"path/filepath"
 
-	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
@@ -190,22 +190,13 @@ func writeDepositToFile(
 	return err
 }
 
-// getBLSSigner returns a BLS signer based on the override node key flag.
+// getBLSSigner returns the BLS signer of the validator key of the node.
 func getBLSSigner(v *viper.Viper) (crypto.BLSSigner, error) {
-	var blsSigner crypto.BLSSigner
-	if err := depinject.Inject(
-		depinject.Configs(
-			depinject.Supply(
-				v,
-			),
-			depinject.Provide(
-				components.ProvideBlsSigner,
-			),
-		),
-		&blsSigner,
-	); err != nil {
+	cfg, err := config.ReadConfigFromAppOpts(v)
+	if err != nil {
 		return nil, err
 	}
-
-	return blsSigner, nil
+	// The private validator is only served to CometBFT by the node.
+	blsSigner, _, err := components.LoadBLSSigner(v, cfg)
+	return blsSigner, err
 }
@@ -36,6 +36,10 @@ const (
 	FlagOutputPath        = "output-path"
 	FlagInputPath         = "input-path"
 	ConfigFolder          = "config"
+
+	// secretFilePerm is the permission of a rotated secret file that did
+	// not exist before.
+	secretFilePerm = 0o600
 )
 
 // Commands creates a new command for managing JWT secrets.
@@ -50,6 +54,7 @@ func Commands() *cobra.Command {
 
 	cmd.AddCommand(
 		NewGenerateJWTCommand(),
+		NewRotateJWTCommand(),
 		NewValidateJWTCommand(),
 	)
 
@@ -79,6 +84,31 @@ file name "jwt.hex" in the current directory.`,
 	return cmd
 }
 
+// NewRotateJWTCommand creates a new command for rotating a JWT secret.
+func NewRotateJWTCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Atomically replaces the JWT authentication secret",
+		Long: `This command generates a new JWT authentication secret and
+atomically replaces the secret file with it, so that a running node never reads
+a partially written secret. A running node picks up the new secret and keeps
+trying the previous one for the configured grace period. If no output file path
+is specified, it uses the default file name "jwt.hex" in the config directory.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Get the file path from the command flags.
+			outputPath, err := getFilePath(cmd, FlagOutputPath)
+			if err != nil {
+				return err
+			}
+
+			return rotateAuthSecretInFile(cmd, outputPath)
+		},
+	}
+	cmd.Flags().StringP(
+		FlagOutputPath, "o", "", "Optional output file path for the JWT secret")
+	return cmd
+}
+
 // NewValidateJWTCommand creates a new command for validating a JWT secret.
 func NewValidateJWTCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -166,6 +196,59 @@ func generateAuthSecretInFile(cmd *cobra.Command, fileName string) error {
 	return nil
 }
 
+// rotateAuthSecretInFile atomically replaces the JWT secret in the specified
+// file with a newly generated one, keeping the permissions of the file.
+func rotateAuthSecretInFile(cmd *cobra.Command, fileName string) error {
+	fs := afero.NewOsFs()
+	perm := os.FileMode(secretFilePerm)
+	info, err := fs.Stat(fileName)
+	switch {
+	case err == nil:
+		perm = info.Mode().Perm()
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	// Write the secret to a temporary file in the same directory and rename
+	// it over the secret file, which is atomic on POSIX file systems.
+	tmp, err := afero.TempFile(
+		fs, filepath.Dir(fileName), filepath.Base(fileName)+".tmp",
+	)
+	if err != nil {
+		return err
+	}
+	defer fs.Remove(tmp.Name()) //nolint:errcheck // no-op once renamed.
+
+	if _, err = tmp.WriteString(secret.Hex()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = fs.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	if err = fs.Rename(tmp.Name(), fileName); err != nil {
+		return err
+	}
+
+	cmd.Printf(
+		"Successfully rotated JSON-RPC authentication secret in: %s",
+		fileName,
+	)
+	return nil
+}
+
 func validateJWTSecret(cmd *cobra.Command, filePath string) error {
 	_, err := components.LoadJWTFromFile(filePath)
 	if err != nil {
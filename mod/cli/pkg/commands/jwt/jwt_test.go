@@ -119,3 +119,37 @@ func checkAuthFileIntegrity(tb testing.TB, fPath string) {
 	require.NoError(tb, err)
 	require.Len(tb, decoded, 32)
 }
+
+func Test_NewRotateJWTCommand(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, jwt.DefaultSecretFileName)
+
+	// Rotating creates the secret file if it does not exist.
+	cmd := jwt.NewRotateJWTCommand()
+	cmd.SetArgs([]string{"--output-path", path})
+	require.NoError(t, cmd.Execute())
+	checkAuthFileIntegrity(t, path)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Rotating an existing file replaces the secret and keeps the file
+	// permissions, without leaving temporary files behind.
+	require.NoError(t, os.Chmod(path, 0o640))
+	before, err := os.ReadFile(path)
+	require.NoError(t, err)
+	cmd = jwt.NewRotateJWTCommand()
+	cmd.SetArgs([]string{"--output-path", path})
+	require.NoError(t, cmd.Execute())
+	checkAuthFileIntegrity(t, path)
+
+	after, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotEqual(t, before, after)
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrKeyFileExists is returned when exporting a keystore would overwrite
+	// an existing key file.
+	ErrKeyFileExists = errors.New(
+		"key file already exists, use --overwrite to replace it",
+	)
+
+	// ErrKeystoreExists is returned when importing a key would overwrite an
+	// existing keystore.
+	ErrKeystoreExists = errors.New(
+		"keystore already exists, use --overwrite to replace it",
+	)
+
+	// ErrNotBLSKey is returned when the CometBFT key file does not hold a BLS
+	// key.
+	ErrNotBLSKey = errors.New("key file does not hold a bls12-381 key")
+)
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+const (
+	// keystorePath is the flag for the path of the keystore.
+	keystorePath = "keystore"
+
+	// passwordFile is the flag for the file holding the keystore password.
+	passwordFile = "password-file"
+
+	// keyFile is the flag for the path of the CometBFT key file.
+	keyFile = "key-file"
+
+	// overwrite is the flag for overwriting an existing file.
+	overwrite = "overwrite"
+)
+
+const (
+	// defaultKeystoreName is the name of the keystore in the config folder
+	// of the home directory when none is configured.
+	defaultKeystoreName = "keystore.json"
+
+	// configFolder is the folder of the configuration files in the home
+	// directory.
+	configFolder = "config"
+)
+
+const (
+	// keystorePathMsg is the usage description for the keystorePath flag.
+	keystorePathMsg = "path of the keystore, defaults to the configured " +
+		"validator keystore or config/keystore.json"
+
+	// passwordFileMsg is the usage description for the passwordFile flag.
+	passwordFileMsg = "file holding the keystore password, defaults to " +
+		"the configured password file or the BEACOND_KEYSTORE_PASSWORD variable"
+
+	// keyFileMsg is the usage description for the keyFile flag.
+	keyFileMsg = "path of the CometBFT key file, defaults to the " +
+		"configured priv_validator_key_file"
+
+	// overwriteMsg is the usage description for the overwrite flag.
+	overwriteMsg = "overwrite the destination file if it exists"
+)
@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// keyFilePerm is the permission of the exported key files.
+const keyFilePerm = 0o600
+
+// Commands creates a new command for managing the EIP-2335 keystores of the
+// validator key.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "keystore",
+		Short:                      "validator keystore subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCmd(),
+		NewExportCmd(),
+		NewListCmd(),
+	)
+
+	return cmd
+}
+
+// NewImportCmd creates a new command to encrypt the CometBFT key file into a
+// keystore.
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Encrypts the CometBFT key file into a keystore",
+		Long: `Encrypts the validator key of the CometBFT key file into an 
+		EIP-2335 keystore. Once imported, the key file can be removed and the 
+		keystore set as the validator keystore-path.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keyPath, err := getKeyFilePath(cmd)
+			if err != nil {
+				return err
+			}
+			ksPath, err := getKeystorePath(cmd)
+			if err != nil {
+				return err
+			}
+			if err = checkOverwrite(cmd, ksPath, ErrKeystoreExists); err != nil {
+				return err
+			}
+			password, err := getPassword(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := readKeyFile(keyPath)
+			if err != nil {
+				return err
+			}
+			keystore, err := signer.EncryptKeystore(key, password)
+			if err != nil {
+				return err
+			}
+			if err = keystore.Save(ksPath); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Successfully imported key %s into: %s\n", keystore.Pubkey, ksPath,
+			)
+			return nil
+		},
+	}
+
+	addKeystoreFlags(cmd)
+	return cmd
+}
+
+// NewExportCmd creates a new command to decrypt a keystore into the CometBFT
+// key file.
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Decrypts a keystore into the CometBFT key file",
+		Long: `Decrypts the validator key of an EIP-2335 keystore into the 
+		CometBFT key file.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keyPath, err := getKeyFilePath(cmd)
+			if err != nil {
+				return err
+			}
+			if err = checkOverwrite(cmd, keyPath, ErrKeyFileExists); err != nil {
+				return err
+			}
+			ksPath, err := getKeystorePath(cmd)
+			if err != nil {
+				return err
+			}
+			password, err := getPassword(cmd)
+			if err != nil {
+				return err
+			}
+
+			keystore, err := signer.LoadKeystore(ksPath)
+			if err != nil {
+				return err
+			}
+			key, err := keystore.Decrypt(password)
+			if err != nil {
+				return err
+			}
+			if err = writeKeyFile(keyPath, key); err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Successfully exported key %s to: %s\n", keystore.Pubkey, keyPath,
+			)
+			return nil
+		},
+	}
+
+	addKeystoreFlags(cmd)
+	return cmd
+}
+
+// NewListCmd creates a new command to list the keystores of a directory.
+func NewListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [dir]",
+		Short: "Lists the keystores of a directory",
+		Long: `Lists the EIP-2335 keystores of the given directory, which 
+		defaults to the directory of the configured validator keystore.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var dir string
+			if len(args) > 0 {
+				dir = args[0]
+			} else {
+				ksPath, err := getKeystorePath(cmd)
+				if err != nil {
+					return err
+				}
+				dir = filepath.Dir(ksPath)
+			}
+
+			paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+			if err != nil {
+				return err
+			}
+			for _, path := range paths {
+				// Skip the JSON files which are not keystores, such as the
+				// genesis file.
+				keystore, loadErr := signer.LoadKeystore(path)
+				if loadErr != nil {
+					continue
+				}
+				cmd.Printf(
+					"%s\t%s\t%s\n", keystore.Pubkey, keystore.UUID, path,
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// addKeystoreFlags adds the flags shared by the import and export commands.
+func addKeystoreFlags(cmd *cobra.Command) {
+	cmd.Flags().String(keystorePath, "", keystorePathMsg)
+	cmd.Flags().String(passwordFile, "", passwordFileMsg)
+	cmd.Flags().String(keyFile, "", keyFileMsg)
+	cmd.Flags().Bool(overwrite, false, overwriteMsg)
+}
+
+// getKeystorePath returns the keystore path of the flags, falling back to
+// the configured validator keystore and then to the default keystore of the
+// home directory.
+func getKeystorePath(cmd *cobra.Command) (string, error) {
+	if cmd.Flags().Lookup(keystorePath) != nil {
+		path, err := cmd.Flags().GetString(keystorePath)
+		if err != nil || path != "" {
+			return path, err
+		}
+	}
+
+	cfg, err := config.ReadConfigFromAppOpts(client.GetViperFromCmd(cmd))
+	if err != nil {
+		return "", err
+	}
+	if cfg.Validator.KeystorePath != "" {
+		return resolveHomePath(cmd, cfg.Validator.KeystorePath), nil
+	}
+	return resolveHomePath(
+		cmd, filepath.Join(configFolder, defaultKeystoreName),
+	), nil
+}
+
+// getPassword reads the keystore password from the password file of the
+// flags, falling back to the configured password file and then to the
+// password environment variable.
+func getPassword(cmd *cobra.Command) (string, error) {
+	path, err := cmd.Flags().GetString(passwordFile)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		var cfg *config.Config
+		cfg, err = config.ReadConfigFromAppOpts(client.GetViperFromCmd(cmd))
+		if err != nil {
+			return "", err
+		}
+		if cfg.Validator.KeystorePasswordFile != "" {
+			path = resolveHomePath(cmd, cfg.Validator.KeystorePasswordFile)
+		}
+	}
+	return signer.ReadKeystorePassword(path)
+}
+
+// getKeyFilePath returns the CometBFT key file path of the flags, falling
+// back to the configured priv_validator_key_file.
+func getKeyFilePath(cmd *cobra.Command) (string, error) {
+	path, err := cmd.Flags().GetString(keyFile)
+	if err != nil || path != "" {
+		return path, err
+	}
+	return server.GetServerContextFromCmd(cmd).Config.PrivValidatorKeyFile(),
+		nil
+}
+
+// resolveHomePath joins the given path with the home directory if it is not
+// absolute.
+func resolveHomePath(cmd *cobra.Command, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(client.GetClientContextFromCmd(cmd).HomeDir, path)
+}
+
+// checkOverwrite returns the given error if the file at the given path
+// exists and the overwrite flag is not set.
+func checkOverwrite(cmd *cobra.Command, path string, existsErr error) error {
+	force, err := cmd.Flags().GetBool(overwrite)
+	if err != nil || force {
+		return err
+	}
+	switch _, err = os.Stat(path); {
+	case err == nil:
+		return errors.Wrap(existsErr, path)
+	case os.IsNotExist(err):
+		return nil
+	default:
+		return err
+	}
+}
+
+// readKeyFile reads the BLS key of the CometBFT key file at the given path.
+func readKeyFile(path string) (signer.LegacyKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return signer.LegacyKey{}, err
+	}
+
+	var pvKey privval.FilePVKey
+	if err = cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return signer.LegacyKey{}, err
+	}
+	if pvKey.PrivKey == nil ||
+		len(pvKey.PrivKey.Bytes()) != constants.BLSSecretKeyLength {
+		return signer.LegacyKey{}, errors.Wrap(ErrNotBLSKey, path)
+	}
+	return signer.LegacyKey(pvKey.PrivKey.Bytes()), nil
+}
+
+// writeKeyFile writes the given BLS key into the CometBFT key file at the
+// given path.
+func writeKeyFile(path string, key signer.LegacyKey) error {
+	privKey, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return err
+	}
+
+	pv := privval.NewFilePV(privKey, path, "")
+	bz, err := cmtjson.MarshalIndent(pv.Key, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, keyFilePerm)
+}
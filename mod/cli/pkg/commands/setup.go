@@ -24,9 +24,11 @@ import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/client"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/keystore"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -68,12 +70,16 @@ func DefaultRootCommandSetup[
 		genutilcli.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `debug`
+		debug.Commands(chainSpec),
 		// `deposit`
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
 		// `keys`
 		keys.Commands(),
+		// `keystore`
+		keystore.Commands(),
 		// `prune`
 		pruning.Cmd(appCreator),
 		// `rollback`
@@ -138,8 +138,12 @@ func bindFlags(
 		}
 
 		if !f.Changed && v.IsSet(f.Name) {
-			val := v.Get(f.Name)
-			err = cmd.Flags().Set(f.Name, fmt.Sprintf("%v", val))
+			val := fmt.Sprintf("%v", v.Get(f.Name))
+			// Slice flags are parsed as comma separated values.
+			if f.Value.Type() == "stringSlice" {
+				val = strings.Join(v.GetStringSlice(f.Name), ",")
+			}
+			err = cmd.Flags().Set(f.Name, val)
 			if err != nil {
 				panic(err)
 			}
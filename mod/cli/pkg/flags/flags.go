@@ -36,19 +36,44 @@ const (
 	LocalBuilderEnabled      = builderRoot + "local-builder-enabled"
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
+	// Relay Config.
+	relayRoot        = beaconKitRoot + "relay."
+	RelayEnabled     = relayRoot + "enabled"
+	RelayURL         = relayRoot + "url"
+	RelayTimeout     = relayRoot + "timeout"
+	RelayBoostFactor = relayRoot + "boost-factor"
+	RelayGasLimit    = relayRoot + "gas-limit"
+
 	// Validator Config.
-	validatorRoot = beaconKitRoot + "validator."
-	Graffiti      = validatorRoot + "graffiti"
+	validatorRoot         = beaconKitRoot + "validator."
+	Graffiti              = validatorRoot + "graffiti"
+	GraffitiTemplate      = validatorRoot + "graffiti-template"
+	ProposalDeadline      = validatorRoot + "proposal-deadline"
+	AllowMinimalProposals = validatorRoot + "allow-minimal-proposals"
+	ProposerSettingsPath  = validatorRoot + "proposer-settings-path"
+	KeystorePath          = validatorRoot + "keystore-path"
+	KeystorePasswordFile  = validatorRoot + "keystore-password-file"
+	DoppelgangerBlocks    = validatorRoot + "doppelganger-blocks"
+
+	// Validator Monitor Config.
+	validatorMonitorRoot       = beaconKitRoot + "validator-monitor."
+	ValidatorMonitorValidators = validatorMonitorRoot + "validators"
 
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCFallbackDialURLs     = engineRoot + "rpc-fallback-dial-urls"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	JWTGracePeriod          = engineRoot + "jwt-grace-period"
+	JWTID                   = engineRoot + "jwt-id"
+	RecordPath              = engineRoot + "record-path"
+	RecordMaxFileSize       = engineRoot + "record-max-file-size"
+	RecordMaxFiles          = engineRoot + "record-max-files"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -68,12 +93,37 @@ const (
 		"pruner-enabled"
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
+	BlockStoreServiceBlinded                 = blockStoreServiceRoot + "blinded"
+	BlockStoreServiceReconstructionCacheSize = blockStoreServiceRoot +
+		"reconstruction-cache-size"
 
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+
+	// Remote Signer Config.
+	remoteSignerRoot        = beaconKitRoot + "remote-signer."
+	RemoteSignerEnabled     = remoteSignerRoot + "enabled"
+	RemoteSignerURL         = remoteSignerRoot + "url"
+	RemoteSignerPubkey      = remoteSignerRoot + "pubkey"
+	RemoteSignerTimeout     = remoteSignerRoot + "timeout"
+	RemoteSignerTLSCertPath = remoteSignerRoot + "tls-cert-path"
+	RemoteSignerTLSKeyPath  = remoteSignerRoot + "tls-key-path"
+	RemoteSignerTLSCAPath   = remoteSignerRoot + "tls-ca-path"
+
+	// Keymanager API Config.
+	keymanagerAPIRoot      = beaconKitRoot + "keymanager-api."
+	KeymanagerAPIEnabled   = keymanagerAPIRoot + "enabled"
+	KeymanagerAPIAddress   = keymanagerAPIRoot + "address"
+	KeymanagerAPILogging   = keymanagerAPIRoot + "logging"
+	KeymanagerAPITokenPath = keymanagerAPIRoot + "token-path"
+
+	// Upgrade Config.
+	upgradeRoot = beaconKitRoot + "upgrade."
+	UpgradeName = upgradeRoot + "name"
+	UpgradeSlot = upgradeRoot + "slot"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
@@ -84,9 +134,18 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.JWTSecretPath,
 		"path to the execution client secret",
 	)
+	startCmd.Flags().Duration(
+		JWTGracePeriod,
+		defaultCfg.Engine.JWTGracePeriod,
+		"jwt grace period",
+	)
+	startCmd.Flags().String(JWTID, defaultCfg.Engine.JWTID, "jwt id claim")
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		RPCFallbackDialURLs, []string{}, "rpc fallback dial urls",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -103,11 +162,79 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().String(
+		RecordPath, defaultCfg.Engine.RecordPath, "engine api record path",
+	)
+	startCmd.Flags().Uint64(
+		RecordMaxFileSize,
+		defaultCfg.Engine.RecordMaxFileSize,
+		"engine api record max file size",
+	)
+	startCmd.Flags().Uint64(
+		RecordMaxFiles,
+		defaultCfg.Engine.RecordMaxFiles,
+		"engine api record max files",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().Bool(
+		RelayEnabled, defaultCfg.Relay.Enabled, "relay enabled",
+	)
+	startCmd.Flags().String(RelayURL, defaultCfg.Relay.URL, "relay url")
+	startCmd.Flags().Duration(
+		RelayTimeout, defaultCfg.Relay.Timeout, "relay timeout",
+	)
+	startCmd.Flags().Uint64(
+		RelayBoostFactor,
+		defaultCfg.Relay.BoostFactor,
+		"relay builder boost factor",
+	)
+	startCmd.Flags().Uint64(
+		RelayGasLimit, defaultCfg.Relay.GasLimit, "relay gas limit",
+	)
+	startCmd.Flags().String(
+		GraffitiTemplate,
+		defaultCfg.Validator.GraffitiTemplate,
+		"graffiti template",
+	)
+	startCmd.Flags().Duration(
+		ProposalDeadline,
+		defaultCfg.Validator.ProposalDeadline,
+		"proposal deadline",
+	)
+	startCmd.Flags().Bool(
+		AllowMinimalProposals,
+		defaultCfg.Validator.AllowMinimalProposals,
+		"allow minimal proposals",
+	)
+	startCmd.Flags().String(
+		ProposerSettingsPath,
+		defaultCfg.Validator.ProposerSettingsPath,
+		"proposer settings path",
+	)
+	startCmd.Flags().String(
+		KeystorePath,
+		defaultCfg.Validator.KeystorePath,
+		"validator keystore path",
+	)
+	startCmd.Flags().String(
+		KeystorePasswordFile,
+		defaultCfg.Validator.KeystorePasswordFile,
+		"validator keystore password file",
+	)
+	startCmd.Flags().Uint64(
+		DoppelgangerBlocks,
+		defaultCfg.Validator.DoppelgangerBlocks,
+		"doppelganger protection blocks",
+	)
+	startCmd.Flags().StringSlice(
+		ValidatorMonitorValidators,
+		defaultCfg.ValidatorMonitor.Validators,
+		"pubkeys or indices of the validators to monitor",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -148,6 +275,16 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().Bool(
+		BlockStoreServiceBlinded,
+		defaultCfg.BlockStoreService.Blinded,
+		"block service stores blinded blocks",
+	)
+	startCmd.Flags().Int(
+		BlockStoreServiceReconstructionCacheSize,
+		defaultCfg.BlockStoreService.ReconstructionCacheSize,
+		"block service reconstruction cache size",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -163,4 +300,69 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		RemoteSignerEnabled,
+		defaultCfg.RemoteSigner.Enabled,
+		"remote signer enabled",
+	)
+	startCmd.Flags().String(
+		RemoteSignerURL,
+		defaultCfg.RemoteSigner.URL,
+		"remote signer url",
+	)
+	startCmd.Flags().String(
+		RemoteSignerPubkey,
+		defaultCfg.RemoteSigner.Pubkey,
+		"remote signer validator pubkey",
+	)
+	startCmd.Flags().Duration(
+		RemoteSignerTimeout,
+		defaultCfg.RemoteSigner.Timeout,
+		"remote signer timeout",
+	)
+	startCmd.Flags().String(
+		RemoteSignerTLSCertPath,
+		defaultCfg.RemoteSigner.TLSCertPath,
+		"remote signer tls client certificate path",
+	)
+	startCmd.Flags().String(
+		RemoteSignerTLSKeyPath,
+		defaultCfg.RemoteSigner.TLSKeyPath,
+		"remote signer tls client key path",
+	)
+	startCmd.Flags().String(
+		RemoteSignerTLSCAPath,
+		defaultCfg.RemoteSigner.TLSCAPath,
+		"remote signer tls ca path",
+	)
+	startCmd.Flags().Bool(
+		KeymanagerAPIEnabled,
+		defaultCfg.KeymanagerAPI.Enabled,
+		"keymanager api enabled",
+	)
+	startCmd.Flags().String(
+		KeymanagerAPIAddress,
+		defaultCfg.KeymanagerAPI.Address,
+		"keymanager api address",
+	)
+	startCmd.Flags().Bool(
+		KeymanagerAPILogging,
+		defaultCfg.KeymanagerAPI.Logging,
+		"keymanager api logging",
+	)
+	startCmd.Flags().String(
+		KeymanagerAPITokenPath,
+		defaultCfg.KeymanagerAPI.TokenPath,
+		"keymanager api token path",
+	)
+	startCmd.Flags().String(
+		UpgradeName,
+		defaultCfg.Upgrade.Name,
+		"upgrade plan name",
+	)
+	startCmd.Flags().Uint64(
+		UpgradeSlot,
+		defaultCfg.Upgrade.Slot,
+		"upgrade plan slot",
+	)
 }
@@ -22,15 +22,21 @@ package config
 
 import (
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
+	"github.com/berachain/beacon-kit/mod/beacon/monitor"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/node-api/keymanager"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/remotesigner"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/upgrade"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -46,10 +52,16 @@ func DefaultConfig() *Config {
 		Engine:            engineclient.DefaultConfig(),
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
+		Blobs:             blob.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
+		Relay:             relay.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
+		ValidatorMonitor:  monitor.DefaultConfig(),
+		RemoteSigner:      remotesigner.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		KeymanagerAPI:     keymanager.DefaultConfig(),
+		Upgrade:           upgrade.DefaultConfig(),
 	}
 }
 
@@ -61,14 +73,28 @@ type Config struct {
 	Logger log.Config `mapstructure:"logger"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// Blobs is the configuration for the assembly of the blob sidecars.
+	Blobs blob.Config `mapstructure:"blobs"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Relay is the configuration for the relay to the external block
+	// builders.
+	Relay relay.Config `mapstructure:"relay"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
+	// ValidatorMonitor is the configuration for the validator monitor.
+	ValidatorMonitor monitor.Config `mapstructure:"validator-monitor"`
+	// RemoteSigner is the configuration for the remote signer holding the
+	// key of the validator.
+	RemoteSigner remotesigner.Config `mapstructure:"remote-signer"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// KeymanagerAPI is the configuration for the keymanager API.
+	KeymanagerAPI keymanager.Config `mapstructure:"keymanager-api"`
+	// Upgrade is the configuration of the upgrade plan.
+	Upgrade upgrade.Config `mapstructure:"upgrade"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -98,9 +98,10 @@ func BaseSpec() chain.SpecData[
 		DepositContractAddress: gethprimitives.HexToAddress(
 			"0x4242424242424242424242424242424242424242",
 		),
-		DepositEth1ChainID:        uint64(80084),
-		Eth1FollowDistance:        1,
-		TargetSecondsPerEth1Block: 3,
+		DepositContractDeployBlock: 0,
+		DepositEth1ChainID:         uint64(80084),
+		Eth1FollowDistance:         1,
+		TargetSecondsPerEth1Block:  3,
 		// Fork-related values.
 		DenebPlusForkEpoch: 9999999999999998,
 		ElectraForkEpoch:   9999999999999999,
@@ -30,6 +30,10 @@ const TomlTemplate = `
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
+# Urls of fallback execution clients, used in order when the primary is
+# unhealthy. Forkchoice updates are sent to every healthy execution client.
+rpc-fallback-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
 # Number of retries before shutting down consensus client.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
@@ -39,12 +43,28 @@ rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
-# Interval for the JWT refresh.
+# Interval at which the JWT-secret file is checked for changes.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# How long the previous JWT-secret is still tried after the secret is rotated.
+jwt-grace-period = "{{ .BeaconKit.Engine.JWTGracePeriod }}"
+
+# Identifier sent as the "id" claim of the JWT tokens. Omitted if empty.
+jwt-id = "{{ .BeaconKit.Engine.JWTID }}"
+
+# Path of the file that every JSON-RPC exchange with the execution clients is
+# recorded to, for debugging. Recording is disabled if empty.
+record-path = "{{ .BeaconKit.Engine.RecordPath }}"
+
+# Size in bytes after which the recording file is rotated.
+record-max-file-size = "{{ .BeaconKit.Engine.RecordMaxFileSize }}"
+
+# Number of rotated recording files to keep.
+record-max-files = "{{ .BeaconKit.Engine.RecordMaxFiles }}"
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -64,6 +84,26 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.blobs]
+# Omit pooled blobs determines if the sidecars of the blobs held by the blob
+# pool of the execution client are left out of the proposals of this node, for
+# the validators to rebuild them from their own pool. Every sidecar is proposed
+# once a proposal of the height was rejected. The nodes syncing these blocks
+# later request the omitted sidecars from their peers.
+omit-pooled-blobs = {{ .BeaconKit.Blobs.OmitPooledBlobs }}
+
+# Time during which the blob pool is polled again for the blobs of a proposal
+# that are neither in the pool nor in the proposal, before it is rejected.
+pool-retry-timeout = "{{ .BeaconKit.Blobs.PoolRetryTimeout }}"
+
+# Node API urls of the beacon nodes the sidecars of a finalized block are
+# requested from, when they are neither in the blob pool nor in the block. The
+# peers must have their block store enabled.
+peer-urls = [{{ range $i, $url := .BeaconKit.Blobs.PeerURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Timeout of a request to a peer.
+peer-timeout = "{{ .BeaconKit.Blobs.PeerTimeout }}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -77,14 +117,104 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.relay]
+# Enabled determines if payloads are requested from the external block builders
+# through the relay.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# URL of the relay to the external block builders.
+url = "{{ .BeaconKit.Relay.URL }}"
+
+# Timeout of each request to the relay, the local payload is used if the relay
+# does not answer in time.
+timeout = "{{ .BeaconKit.Relay.Timeout }}"
+
+# Percentage applied to the value of a builder bid before comparing it to the
+# value of the local payload. 0 always selects the local payload.
+boost-factor = {{ .BeaconKit.Relay.BoostFactor }}
+
+# Gas limit registered with the relay. The gas limit of local payloads is the one
+# configured in the execution client.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 
+# Template the graffiti of the blocks is rendered from. {user} is replaced by the graffiti,
+# {cl}, {cl_commit}, {el} and {el_commit} by the codes and commits of the consensus and
+# execution clients. Commits are shortened, then dropped, to fit in 32 bytes. Leave empty
+# to use the graffiti as is.
+graffiti-template = "{{.BeaconKit.Validator.GraffitiTemplate}}"
+
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# ProposalDeadline is the time allowed to build a proposal. When building the block fails,
+# cheaper fallbacks are tried until the deadline is reached. Zero disables the deadline.
+proposal-deadline = "{{ .BeaconKit.Validator.ProposalDeadline }}"
+
+# AllowMinimalProposals allows proposing a block without deposits, attestations, slashings
+# or blobs when every other fallback failed. The block carries an empty payload, built without
+# the execution client, and keeps the execution head of its parent.
+allow-minimal-proposals = "{{ .BeaconKit.Validator.AllowMinimalProposals }}"
+
+# ProposerSettingsPath is the path of the file the fee recipient, graffiti and gas limit set
+# through the keymanager API are persisted to. They take precedence over the configured ones.
+proposer-settings-path = "{{ .BeaconKit.Validator.ProposerSettingsPath }}"
+
+# KeystorePath is the path of the EIP-2335 keystore holding the validator key. If set, the key
+# is decrypted from it instead of being read from the CometBFT key file, and priv_validator_laddr
+# must be set in the CometBFT configuration.
+keystore-path = "{{ .BeaconKit.Validator.KeystorePath }}"
+
+# KeystorePasswordFile is the path of the file holding the keystore password. If empty, the
+# password is read from the BEACOND_KEYSTORE_PASSWORD environment variable.
+keystore-password-file = "{{ .BeaconKit.Validator.KeystorePasswordFile }}"
+
+# DoppelgangerBlocks is the number of blocks observed after startup for proposals and votes of
+# the validator key signed by another node, during which the validator neither proposes nor votes.
+# If the key is seen active elsewhere, the validator refuses to sign until restarted. The votes are
+# only held back when the key is served over priv_validator_laddr, which must then be set. The node
+# refuses to start with it when the validator holds a third or more of the voting power, as holding
+# back its votes would halt the chain. Zero disables it.
+doppelganger-blocks = {{ .BeaconKit.Validator.DoppelgangerBlocks }}
+
+[beacon-kit.remote-signer]
+# Enabled determines if messages are signed by a Web3Signer-compatible remote
+# signer instead of the local private validator key. The CometBFT votes and
+# proposals are signed by it too, priv_validator_laddr must be set in the
+# CometBFT configuration.
+enabled = {{ .BeaconKit.RemoteSigner.Enabled }}
+
+# URL of the remote signer.
+url = "{{ .BeaconKit.RemoteSigner.URL }}"
+
+# Public key of the validator held by the remote signer, it may be left empty
+# if the remote signer holds a single key.
+pubkey = "{{ .BeaconKit.RemoteSigner.Pubkey }}"
+
+# Timeout of each request to the remote signer.
+timeout = "{{ .BeaconKit.RemoteSigner.Timeout }}"
+
+# Paths of the client certificate, and of its key, presented to the remote
+# signer.
+tls-cert-path = "{{ .BeaconKit.RemoteSigner.TLSCertPath }}"
+tls-key-path = "{{ .BeaconKit.RemoteSigner.TLSKeyPath }}"
+
+# Path of the certificate authority the certificate of the remote signer is
+# verified against, the system pool is used if empty.
+tls-ca-path = "{{ .BeaconKit.RemoteSigner.TLSCAPath }}"
+
+[beacon-kit.validator-monitor]
+# Validators are the validators to monitor, given as hex encoded pubkeys or as
+# validator indices. Their proposals, votes, balances, withdrawals and deposits
+# are reported as metrics and summarized in the logs every epoch. Missed
+# proposals are found from the proposer rotation served by the CometBFT RPC,
+# which requires rpc.laddr to be set.
+validators = [{{ range $i, $val := .BeaconKit.ValidatorMonitor.Validators }}{{ if $i }}, {{ end }}"{{ $val }}"{{ end }}]
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -95,6 +225,14 @@ pruner-enabled = "{{ .BeaconKit.BlockStoreService.PrunerEnabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+# Blinded determines if blocks are stored with only their execution payload
+# header, reconstructing the payload from the execution client on read.
+blinded = "{{ .BeaconKit.BlockStoreService.Blinded }}"
+
+# ReconstructionCacheSize is the number of payload bodies to keep in the
+# reconstruction cache.
+reconstruction-cache-size = "{{ .BeaconKit.BlockStoreService.ReconstructionCacheSize }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -104,4 +242,26 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.keymanager-api]
+# Enabled determines if the keymanager API is enabled.
+enabled = "{{ .BeaconKit.KeymanagerAPI.Enabled }}"
+
+# Address is the address to bind the keymanager API to.
+address = "{{ .BeaconKit.KeymanagerAPI.Address }}"
+
+# Logging determines if the keymanager API logging is enabled.
+logging = "{{ .BeaconKit.KeymanagerAPI.Logging }}"
+
+# TokenPath is the path of the file holding the bearer token of the keymanager API,
+# a random token is generated if the file does not exist.
+token-path = "{{ .BeaconKit.KeymanagerAPI.TokenPath }}"
+
+[beacon-kit.upgrade]
+# Name of the upgrade plan, leave empty if no upgrade is scheduled.
+name = "{{ .BeaconKit.Upgrade.Name }}"
+
+# Slot at which the upgrade comes into effect. Nodes running a binary without
+# a handler for the plan halt after committing the preceding slot.
+slot = "{{ .BeaconKit.Upgrade.Slot }}"
 `
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/karalabe/ssz"
+)
+
+// BlindedBeaconBlock is a BeaconBlock whose execution payload has been
+// replaced by its header. It shares the hash tree root of the BeaconBlock it
+// was built from.
+type BlindedBeaconBlock struct {
+	// Slot represents the position of the block in the chain.
+	Slot math.Slot `json:"slot"`
+	// ProposerIndex is the index of the validator who proposed the block.
+	ProposerIndex math.Slot `json:"proposer_index"`
+	// ParentRoot is the hash of the parent block
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the hash of the state at the block.
+	StateRoot common.Root `json:"state_root"`
+	// Body is the blinded body of the block.
+	Body *BlindedBeaconBlockBody `json:"body"`
+}
+
+// Empty creates an empty blinded beacon block.
+func (*BlindedBeaconBlock) Empty() *BlindedBeaconBlock {
+	return &BlindedBeaconBlock{}
+}
+
+// NewFromSSZ creates a new blinded beacon block from the given SSZ bytes.
+func (b *BlindedBeaconBlock) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BlindedBeaconBlock, error) {
+	var block = new(BlindedBeaconBlock)
+	switch forkVersion {
+	case version.Deneb:
+		block = &BlindedBeaconBlock{}
+	default:
+		return block, ErrForkVersionNotSupported
+	}
+
+	return block, block.UnmarshalSSZ(bz)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BlindedBeaconBlock object in SSZ encoding.
+func (b *BlindedBeaconBlock) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // todo fix.
+	var size = uint32(8 + 8 + 32 + 32 + 4)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Body)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the BlindedBeaconBlock object.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// MarshalSSZ marshals the BlindedBeaconBlock object to SSZ format.
+func (b *BlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the BlindedBeaconBlock object from SSZ format.
+func (b *BlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the Merkleization of the BlindedBeaconBlock object.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Blinding                                  */
+/* -------------------------------------------------------------------------- */
+
+// Blind returns the BlindedBeaconBlock of the BeaconBlock, in which the
+// execution payload is replaced by its header.
+func (b *BeaconBlock) Blind() (*BlindedBeaconBlock, error) {
+	body, err := b.GetBody().Blind()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlindedBeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body:          body,
+	}, nil
+}
+
+// Unblind rebuilds the BeaconBlock from the BlindedBeaconBlock using the
+// given execution payload body. The payload body must match the payload
+// header of the block.
+func (b *BlindedBeaconBlock) Unblind(
+	payloadBody *engineprimitives.ExecutionPayloadBodyV1,
+) (*BeaconBlock, error) {
+	body, err := b.GetBody().Unblind(payloadBody)
+	if err != nil {
+		return nil, errors.Wrapf(err, "slot %d", b.Slot)
+	}
+
+	return &BeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body:          body,
+	}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// IsNil checks if the blinded beacon block is nil.
+func (b *BlindedBeaconBlock) IsNil() bool {
+	return b == nil
+}
+
+// GetSlot retrieves the slot of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetSlot() math.Slot {
+	return b.Slot
+}
+
+// GetProposerIndex retrieves the proposer index of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetProposerIndex() math.ValidatorIndex {
+	return b.ProposerIndex
+}
+
+// GetParentBlockRoot retrieves the parent block root of the
+// BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetParentBlockRoot() common.Root {
+	return b.ParentRoot
+}
+
+// GetStateRoot retrieves the state root of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetStateRoot() common.Root {
+	return b.StateRoot
+}
+
+// Version identifies the version of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) Version() uint32 {
+	return version.Deneb
+}
+
+// GetBody retrieves the body of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetBody() *BlindedBeaconBlockBody {
+	return b.Body
+}
+
+// SetExecutionPayloadHeader sets the execution payload header of the body of
+// the BlindedBeaconBlock, such as the header of the payload of a builder.
+func (b *BlindedBeaconBlock) SetExecutionPayloadHeader(
+	header *ExecutionPayloadHeader,
+) {
+	b.Body.SetExecutionPayloadHeader(header)
+}
+
+// GetBodyRoot retrieves the hash tree root of the body of the
+// BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) GetBodyRoot() common.Root {
+	return b.Body.HashTreeRoot()
+}
+
+// GetExecutionNumber retrieves the execution number of the
+// BlindedBeaconBlock from the ExecutionPayloadHeader.
+func (b *BlindedBeaconBlock) GetExecutionNumber() math.U64 {
+	return b.Body.ExecutionPayloadHeader.Number
+}
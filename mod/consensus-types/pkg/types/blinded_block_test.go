@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// payloadBodyOf returns the execution payload body of the given block.
+func payloadBodyOf(
+	blk *types.BeaconBlock,
+) *engineprimitives.ExecutionPayloadBodyV1 {
+	payload := blk.GetBody().GetExecutionPayload()
+	body := &engineprimitives.ExecutionPayloadBodyV1{
+		Withdrawals: payload.GetWithdrawals(),
+	}
+	for _, tx := range payload.GetTransactions() {
+		body.Transactions = append(body.Transactions, bytes.Bytes(tx))
+	}
+	return body
+}
+
+func TestBlindedBeaconBlock_SharesRoot(t *testing.T) {
+	blk := generateValidBeaconBlock()
+
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), blinded.HashTreeRoot())
+	require.Equal(t, blk.GetExecutionNumber(), blinded.GetExecutionNumber())
+}
+
+func TestBlindedBeaconBlock_SSZRoundTrip(t *testing.T) {
+	blinded, err := generateValidBeaconBlock().Blind()
+	require.NoError(t, err)
+
+	bz, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded, err := new(types.BlindedBeaconBlock).NewFromSSZ(
+		bz, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, blinded.HashTreeRoot(), decoded.HashTreeRoot())
+}
+
+func TestBlindedBeaconBlock_Unblind(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+
+	unblinded, err := blinded.Unblind(payloadBodyOf(blk))
+	require.NoError(t, err)
+	require.Equal(t, blk.HashTreeRoot(), unblinded.HashTreeRoot())
+	require.Equal(t, blk.GetBody().GetExecutionPayload().GetTransactions(),
+		unblinded.GetBody().GetExecutionPayload().GetTransactions())
+}
+
+func TestBlindedBeaconBlock_UnblindMismatch(t *testing.T) {
+	blk := generateValidBeaconBlock()
+	blinded, err := blk.Blind()
+	require.NoError(t, err)
+
+	body := payloadBodyOf(blk)
+	body.Transactions = body.Transactions[1:]
+	_, err = blinded.Unblind(body)
+	require.ErrorIs(t, err, types.ErrPayloadBodyMismatch)
+
+	_, err = blinded.Unblind(nil)
+	require.ErrorIs(t, err, types.ErrNilPayloadBody)
+}
+
+func TestBlindedBeaconBlock_SetExecutionPayloadHeader(t *testing.T) {
+	// The block proposed with the payload of a builder shares the root of
+	// the local block blinded with the header of that payload.
+	local := generateValidBeaconBlock()
+	proposed := generateValidBeaconBlock()
+	proposed.GetBody().GetExecutionPayload().Transactions = [][]byte{
+		[]byte("builder tx"),
+	}
+	builderBlinded, err := proposed.Blind()
+	require.NoError(t, err)
+
+	blinded, err := local.Blind()
+	require.NoError(t, err)
+	require.NotEqual(t, proposed.HashTreeRoot(), blinded.HashTreeRoot())
+
+	blinded.SetExecutionPayloadHeader(
+		builderBlinded.GetBody().GetExecutionPayloadHeader(),
+	)
+	require.Equal(t, proposed.HashTreeRoot(), blinded.HashTreeRoot())
+	require.Equal(
+		t, proposed.GetBody().HashTreeRoot(), blinded.GetBodyRoot(),
+	)
+}
+
+func TestBlindedBeaconBlock_JSONFields(t *testing.T) {
+	blinded, err := generateValidBeaconBlock().Blind()
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(blinded)
+	require.NoError(t, err)
+	var fields struct {
+		Slot json.RawMessage            `json:"slot"`
+		Body map[string]json.RawMessage `json:"body"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.NotEmpty(t, fields.Slot)
+	for _, field := range []string{
+		"randao_reveal", "eth1_data", "graffiti", "deposits",
+		"execution_payload_header", "blob_kzg_commitments",
+	} {
+		require.Contains(t, fields.Body, field)
+	}
+}
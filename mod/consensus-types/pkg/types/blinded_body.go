@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/karalabe/ssz"
+)
+
+// BlindedBeaconBlockBody is a BeaconBlockBody whose execution payload has
+// been replaced by its header.
+//
+//nolint:lll // struct tags.
+type BlindedBeaconBlockBody struct {
+	// RandaoReveal is the reveal of the RANDAO.
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
+	// Eth1Data is the data from the Eth1 chain.
+	Eth1Data *Eth1Data `json:"eth1_data"`
+	// Graffiti is for a fun message or meme.
+	Graffiti common.Bytes32 `json:"graffiti"`
+	// Deposits is the list of deposits included in the body.
+	Deposits []*Deposit `json:"deposits"`
+	// ExecutionPayloadHeader is the header of the execution payload of the
+	// body.
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
+	size += ssz.SizeDynamicObject(b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlockBody.
+//
+//nolint:mnd // TODO: chainspec.
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+}
+
+// MarshalSSZ serializes the BlindedBeaconBlockBody to SSZ-encoded bytes.
+func (b *BlindedBeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ deserializes the BlindedBeaconBlockBody from SSZ-encoded
+// bytes.
+func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Blinding                                  */
+/* -------------------------------------------------------------------------- */
+
+// Blind returns the BlindedBeaconBlockBody of the BeaconBlockBody.
+//
+// NOTE: The header always commits to the SSZ root of the transactions, so
+// that the blinded body shares the hash tree root of the full body.
+func (b *BeaconBlockBody) Blind() (*BlindedBeaconBlockBody, error) {
+	payload := b.GetExecutionPayload()
+	header, err := payload.toHeader(payload.GetTransactions().HashTreeRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlindedBeaconBlockBody{
+		RandaoReveal:           b.RandaoReveal,
+		Eth1Data:               b.Eth1Data,
+		Graffiti:               common.Bytes32(b.Graffiti),
+		Deposits:               b.Deposits,
+		ExecutionPayloadHeader: header,
+		BlobKzgCommitments:     b.BlobKzgCommitments,
+	}, nil
+}
+
+// Unblind rebuilds the BeaconBlockBody from the BlindedBeaconBlockBody using
+// the given execution payload body.
+func (b *BlindedBeaconBlockBody) Unblind(
+	payloadBody *engineprimitives.ExecutionPayloadBodyV1,
+) (*BeaconBlockBody, error) {
+	if payloadBody == nil {
+		return nil, ErrNilPayloadBody
+	}
+
+	header := b.GetExecutionPayloadHeader()
+	payload := &ExecutionPayload{
+		ParentHash:    header.GetParentHash(),
+		FeeRecipient:  header.GetFeeRecipient(),
+		StateRoot:     header.GetStateRoot(),
+		ReceiptsRoot:  header.GetReceiptsRoot(),
+		LogsBloom:     header.GetLogsBloom(),
+		Random:        header.GetPrevRandao(),
+		Number:        header.GetNumber(),
+		GasLimit:      header.GetGasLimit(),
+		GasUsed:       header.GetGasUsed(),
+		Timestamp:     header.GetTimestamp(),
+		ExtraData:     header.GetExtraData(),
+		BaseFeePerGas: header.GetBaseFeePerGas(),
+		BlockHash:     header.GetBlockHash(),
+		Transactions:  payloadBody.GetTransactions(),
+		Withdrawals:   payloadBody.GetWithdrawals(),
+		BlobGasUsed:   header.GetBlobGasUsed(),
+		ExcessBlobGas: header.GetExcessBlobGas(),
+	}
+	if payload.Withdrawals == nil {
+		payload.Withdrawals = make([]*engineprimitives.Withdrawal, 0)
+	}
+
+	// The payload shares the hash tree root of its header only if the
+	// transactions and withdrawals match the ones committed to.
+	if payload.HashTreeRoot() != header.HashTreeRoot() {
+		return nil, ErrPayloadBodyMismatch
+	}
+
+	return &BeaconBlockBody{
+		RandaoReveal:       b.RandaoReveal,
+		Eth1Data:           b.Eth1Data,
+		Graffiti:           [32]byte(b.Graffiti),
+		Deposits:           b.Deposits,
+		ExecutionPayload:   payload,
+		BlobKzgCommitments: b.BlobKzgCommitments,
+	}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// IsNil checks if the BlindedBeaconBlockBody is nil.
+func (b *BlindedBeaconBlockBody) IsNil() bool {
+	return b == nil
+}
+
+// GetExecutionPayloadHeader returns the ExecutionPayloadHeader of the body.
+func (
+	b *BlindedBeaconBlockBody,
+) GetExecutionPayloadHeader() *ExecutionPayloadHeader {
+	return b.ExecutionPayloadHeader
+}
+
+// SetExecutionPayloadHeader sets the ExecutionPayloadHeader of the body.
+func (b *BlindedBeaconBlockBody) SetExecutionPayloadHeader(
+	header *ExecutionPayloadHeader,
+) {
+	b.ExecutionPayloadHeader = header
+}
+
+// GetRandaoReveal returns the RandaoReveal of the body.
+func (b *BlindedBeaconBlockBody) GetRandaoReveal() crypto.BLSSignature {
+	return b.RandaoReveal
+}
+
+// GetEth1Data returns the Eth1Data of the body.
+func (b *BlindedBeaconBlockBody) GetEth1Data() *Eth1Data {
+	return b.Eth1Data
+}
+
+// GetGraffiti returns the Graffiti of the body.
+func (b *BlindedBeaconBlockBody) GetGraffiti() common.Bytes32 {
+	return b.Graffiti
+}
+
+// GetDeposits returns the Deposits of the body.
+func (b *BlindedBeaconBlockBody) GetDeposits() []*Deposit {
+	return b.Deposits
+}
+
+// GetBlobKzgCommitments returns the BlobKzgCommitments of the body.
+func (
+	b *BlindedBeaconBlockBody,
+) GetBlobKzgCommitments() []eip4844.KZGCommitment {
+	return b.BlobKzgCommitments
+}
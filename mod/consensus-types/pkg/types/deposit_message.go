@@ -58,7 +58,9 @@ func CreateAndSignDepositMessage(
 		Amount:      amount,
 	}
 	signingRoot := ComputeSigningRoot(depositMessage, domain)
-	signature, err := signer.Sign(signingRoot[:])
+	signature, err := signDepositMessage(
+		signer, depositMessage, forkData.CurrentVersion, signingRoot,
+	)
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
 	}
@@ -66,6 +68,40 @@ func CreateAndSignDepositMessage(
 	return depositMessage, signature, nil
 }
 
+// DepositSigner is implemented by the signers which sign the deposit
+// messages with a dedicated request, such as the remote signer.
+type DepositSigner interface {
+	// SignDeposit signs the given signing root of the given deposit
+	// message.
+	SignDeposit(
+		pubkey crypto.BLSPubkey,
+		credentials common.Bytes32,
+		amount math.Gwei,
+		genesisForkVersion common.Version,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// signDepositMessage signs the given deposit message, with a dedicated
+// request if the signer supports it.
+func signDepositMessage(
+	signer crypto.BLSSigner,
+	depositMessage *DepositMessage,
+	forkVersion common.Version,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	if depositSigner, ok := signer.(DepositSigner); ok {
+		return depositSigner.SignDeposit(
+			depositMessage.Pubkey,
+			common.Bytes32(depositMessage.Credentials),
+			depositMessage.Amount,
+			forkVersion,
+			signingRoot,
+		)
+	}
+	return signer.Sign(signingRoot[:])
+}
+
 // New creates a new deposit message.
 func (dm *DepositMessage) New(
 	pubkey crypto.BLSPubkey,
@@ -38,4 +38,14 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrNilPayloadBody is an error for when the payload body used to
+	// unblind a block is nil.
+	ErrNilPayloadBody = errors.New("nil payload body")
+
+	// ErrPayloadBodyMismatch is an error for when the payload body used to
+	// unblind a block does not match the payload header of the block.
+	ErrPayloadBodyMismatch = errors.New(
+		"payload body does not match payload header",
+	)
 )
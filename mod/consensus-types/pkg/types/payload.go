@@ -552,13 +552,21 @@ func (p *ExecutionPayload) GetExcessBlobGas() math.U64 {
 	return p.ExcessBlobGas
 }
 
-// ToHeader converts the ExecutionPayload to an ExecutionPayloadHeader.
+// ToHeader converts the ExecutionPayload to an ExecutionPayloadHeader. The
+// block hash of the payload is first checked against the execution block
+// header assembled from its fields and its transactions and withdrawals tries.
 func (p *ExecutionPayload) ToHeader(
-	_ uint64,
 	eth1ChainID uint64,
+	parentBeaconBlockRoot *common.Root,
 ) (*ExecutionPayloadHeader, error) {
 	var txsRoot common.Root
 
+	if err := engineprimitives.VerifyBlockHash(
+		p, parentBeaconBlockRoot,
+	); err != nil {
+		return nil, err
+	}
+
 	// TODO: This is live on bArtio with a bug and needs to be hardforked
 	// off of. This is a temporary solution to avoid breaking changes.
 	if eth1ChainID == spec.TestnetEth1ChainID {
@@ -569,6 +577,14 @@ func (p *ExecutionPayload) ToHeader(
 		txsRoot = p.GetTransactions().HashTreeRoot()
 	}
 
+	return p.toHeader(txsRoot)
+}
+
+// toHeader converts the ExecutionPayload to an ExecutionPayloadHeader
+// committing to the given transactions root.
+func (p *ExecutionPayload) toHeader(
+	txsRoot common.Root,
+) (*ExecutionPayloadHeader, error) {
 	switch p.Version() {
 	case version.Deneb, version.DenebPlus:
 		return &ExecutionPayloadHeader{
@@ -201,16 +201,23 @@ func TestExecutionPayload_ToHeader(t *testing.T) {
 		ExtraData:     []byte{},
 		BaseFeePerGas: &math.U256{},
 		BlockHash:     gethprimitives.ExecutionHash{},
-		Transactions:  [][]byte{[]byte{0x01}},
+		Transactions:  [][]byte{},
 		Withdrawals:   []*engineprimitives.Withdrawal{},
 		BlobGasUsed:   math.U64(0),
 		ExcessBlobGas: math.U64(0),
 	}
+	parentBeaconBlockRoot := common.Root{0x01}
 
-	header, err := payload.ToHeader(
-		uint64(16), uint64(80087),
+	// The block hash of the payload is verified.
+	_, err := payload.ToHeader(uint64(80087), &parentBeaconBlockRoot)
+	require.ErrorIs(t, err, engineprimitives.ErrPayloadBlockHashMismatch)
+
+	payload.BlockHash, err = engineprimitives.ComputeBlockHash(
+		payload, &parentBeaconBlockRoot,
 	)
 	require.NoError(t, err)
+	header, err := payload.ToHeader(uint64(80087), &parentBeaconBlockRoot)
+	require.NoError(t, err)
 	require.NotNil(t, header)
 
 	require.Equal(t, payload.GetParentHash(), header.GetParentHash())
@@ -42,6 +42,10 @@ type ConsensusEngine[
 ] struct {
 	Middleware[AttestationDataT, SlashingInfoT, SlotDataT]
 	sb StorageBackendT
+	// doppelganger observes the committed blocks, it may be nil.
+	doppelganger Doppelganger
+	// monitor observes the committed blocks, it may be nil.
+	monitor ValidatorMonitor
 }
 
 // NewConsensusEngine returns a new consensus middleware.
@@ -55,6 +59,8 @@ func NewConsensusEngine[
 ](
 	m Middleware[AttestationDataT, SlashingInfoT, SlotDataT],
 	sb StorageBackendT,
+	doppelganger Doppelganger,
+	monitor ValidatorMonitor,
 ) *ConsensusEngine[
 	AttestationDataT,
 	BeaconStateT,
@@ -71,8 +77,10 @@ func NewConsensusEngine[
 		StorageBackendT,
 		ValidatorUpdateT,
 	]{
-		Middleware: m,
-		sb:         sb,
+		Middleware:   m,
+		sb:           sb,
+		doppelganger: doppelganger,
+		monitor:      monitor,
 	}
 }
 
@@ -129,15 +137,40 @@ func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) PreBlock(
 	ctx sdk.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) error {
+	if c.doppelganger != nil {
+		c.doppelganger.ObserveBlock(
+			req.Height,
+			req.Time,
+			req.ProposerAddress,
+			votersFromCommit(req.DecidedLastCommit),
+		)
+	}
+	if c.monitor != nil {
+		c.monitor.ObserveBlock(
+			req.Height,
+			req.ProposerAddress,
+			req.DecidedLastCommit.Round,
+			votersFromCommit(req.DecidedLastCommit),
+			absentFromCommit(req.DecidedLastCommit),
+		)
+	}
 	return c.Middleware.PreBlock(ctx, req)
 }
 
 func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) EndBlock(
 	ctx context.Context,
 ) ([]ValidatorUpdateT, error) {
-	updates, err := c.Middleware.EndBlock(ctx)
+	updates, events, err := c.Middleware.EndBlock(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	// Surface the beacon-chain events on the FinalizeBlock response, the
+	// SDK collects everything emitted on the event manager in EndBlock.
+	if sdkCtx, ok := sdk.TryUnwrapSDKContext(ctx); ok {
+		for _, event := range events {
+			sdkCtx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
 	return iter.MapErr(updates, convertValidatorUpdate[ValidatorUpdateT])
 }
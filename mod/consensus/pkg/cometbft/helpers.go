@@ -21,6 +21,7 @@
 package cometbft
 
 import (
+	"encoding/binary"
 	"sort"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
@@ -29,9 +30,15 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// proposalTxsOverhead is the upper bound of the bytes CometBFT spends framing
+// the block and sidecars txs of a proposal, a field tag and a length prefix
+// for each of them.
+const proposalTxsOverhead = 2 * (1 + binary.MaxVarintLen64)
+
 // convertValidatorUpdate abstracts the conversion of a
 // transition.ValidatorUpdate to an appmodulev2.ValidatorUpdate.
 // TODO: this is so hood, bktypes -> sdktypes -> generic is crazy
@@ -87,6 +94,7 @@ func (c *ConsensusEngine[
 		math.U64(req.Height),
 		attestationData,
 		slashingInfo,
+		proposalByteBudget(req.MaxTxBytes),
 	)
 	return t, nil
 }
@@ -155,3 +163,47 @@ func (c *ConsensusEngine[
 	}
 	return slashingInfo, nil
 }
+
+// votersFromCommit returns the CometBFT addresses of the validators whose
+// vote for the block is part of the given commit.
+func votersFromCommit(commit v1.CommitInfo) [][]byte {
+	voters := make([][]byte, 0, len(commit.Votes))
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag == cmttypes.BlockIDFlagCommit {
+			voters = append(voters, vote.Validator.Address)
+		}
+	}
+	return voters
+}
+
+// absentFromCommit returns the CometBFT addresses of the validators whose
+// vote for the block is missing from the given commit, either absent or for
+// nil.
+func absentFromCommit(commit v1.CommitInfo) [][]byte {
+	var absent [][]byte
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmttypes.BlockIDFlagCommit {
+			absent = append(absent, vote.Validator.Address)
+		}
+	}
+	return absent
+}
+
+// proposalByteBudget returns the number of bytes available to the beacon
+// block and blob sidecars given the maximum size of the proposal txs. The
+// framing CometBFT adds around each tx is accounted for, such that the
+// returned budget can be spent entirely on the encoded block and sidecars.
+func proposalByteBudget(maxTxBytes int64) uint64 {
+	// A non-positive limit means CometBFT does not bound the proposal.
+	if maxTxBytes <= 0 {
+		return 0
+	}
+	//#nosec:G701 // checked above.
+	budget := uint64(maxTxBytes)
+	if budget <= proposalTxsOverhead {
+		// Nothing fits, use the smallest budget that still bounds the
+		// proposal so that building fails instead of being unbounded.
+		return 1
+	}
+	return budget - proposalTxsOverhead
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// testCommit returns a commit with a vote for the block, a vote for nil and
+// an absent vote.
+func testCommit() v1.CommitInfo {
+	return v1.CommitInfo{
+		Votes: []v1.VoteInfo{
+			{
+				Validator:   v1.Validator{Address: []byte("commit")},
+				BlockIdFlag: cmttypes.BlockIDFlagCommit,
+			},
+			{
+				Validator:   v1.Validator{Address: []byte("nil")},
+				BlockIdFlag: cmttypes.BlockIDFlagNil,
+			},
+			{
+				Validator:   v1.Validator{Address: []byte("absent")},
+				BlockIdFlag: cmttypes.BlockIDFlagAbsent,
+			},
+		},
+	}
+}
+
+func TestVotersFromCommit(t *testing.T) {
+	require.Equal(
+		t, [][]byte{[]byte("commit")}, cometbft.VotersFromCommit(testCommit()),
+	)
+	require.Empty(t, cometbft.VotersFromCommit(v1.CommitInfo{}))
+}
+
+func TestAbsentFromCommit(t *testing.T) {
+	require.Equal(
+		t,
+		[][]byte{[]byte("nil"), []byte("absent")},
+		cometbft.AbsentFromCommit(testCommit()),
+	)
+	require.Empty(t, cometbft.AbsentFromCommit(v1.CommitInfo{}))
+}
@@ -22,10 +22,12 @@ package cometbft
 
 import (
 	"context"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -47,6 +49,36 @@ type BeaconState interface {
 	HashTreeRoot() common.Root
 }
 
+// Doppelganger is the interface for observing the committed blocks for the
+// proposals and votes of the validator key signed by another node.
+type Doppelganger interface {
+	// ObserveBlock observes the block committed at the given height and
+	// time, along with the CometBFT addresses of its proposer and of the
+	// validators which voted for the previous block.
+	ObserveBlock(
+		height int64,
+		blockTime time.Time,
+		proposer []byte,
+		voters [][]byte,
+	)
+}
+
+// ValidatorMonitor is the interface for observing the CometBFT proposals and
+// votes of the monitored validators.
+type ValidatorMonitor interface {
+	// ObserveBlock observes the block committed at the given height, along
+	// with the CometBFT address of its proposer, the round the previous
+	// block was committed at and the CometBFT addresses of the validators
+	// which voted or did not vote for it.
+	ObserveBlock(
+		height int64,
+		proposer []byte,
+		lastRound int32,
+		voters [][]byte,
+		absent [][]byte,
+	)
+}
+
 // Middleware is the interface for the CometBFT middleware.
 type Middleware[
 	AttestationDataT,
@@ -61,7 +93,9 @@ type Middleware[
 		ctx context.Context, req proto.Message,
 	) (proto.Message, error)
 	PreBlock(_ context.Context, req proto.Message) error
-	EndBlock(ctx context.Context) (transition.ValidatorUpdates, error)
+	EndBlock(
+		ctx context.Context,
+	) (transition.ValidatorUpdates, []cmtabci.Event, error)
 }
 
 // SlashingInfo is an interface for accessing the slashing info.
@@ -73,7 +107,7 @@ type SlashingInfo[SlashingInfoT any] interface {
 // SlotData is an interface for accessing the slot data.
 type SlotData[AttestationDataT, SlashingInfoT, SlotDataT any] interface {
 	// New creates a new slot data instance.
-	New(math.Slot, []AttestationDataT, []SlashingInfoT) SlotDataT
+	New(math.Slot, []AttestationDataT, []SlashingInfoT, uint64) SlotDataT
 }
 
 // StorageBackend defines an interface for accessing various storage components
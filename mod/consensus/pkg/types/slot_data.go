@@ -30,6 +30,9 @@ type SlotData[AttestationDataT, SlashingInfoT any] struct {
 	AttestationData []AttestationDataT
 	// SlashingInfo is the slashing info of the incoming slot.
 	SlashingInfo []SlashingInfoT
+	// MaxBytes is the maximum number of bytes the proposal for the incoming
+	// slot may occupy. A value of zero means the proposal is unbounded.
+	MaxBytes uint64
 }
 
 // New creates a new SlotData instance.
@@ -37,11 +40,13 @@ func (b *SlotData[AttestationDataT, SlashingInfoT]) New(
 	slot math.Slot,
 	attestationData []AttestationDataT,
 	slashingInfo []SlashingInfoT,
+	maxBytes uint64,
 ) *SlotData[AttestationDataT, SlashingInfoT] {
 	b = &SlotData[AttestationDataT, SlashingInfoT]{
 		Slot:            slot,
 		AttestationData: attestationData,
 		SlashingInfo:    slashingInfo,
+		MaxBytes:        maxBytes,
 	}
 	return b
 }
@@ -67,6 +72,11 @@ func (b *SlotData[
 	return b.SlashingInfo
 }
 
+// GetMaxBytes retrieves the byte budget of the proposal for the SlotData.
+func (b *SlotData[AttestationDataT, SlashingInfoT]) GetMaxBytes() uint64 {
+	return b.MaxBytes
+}
+
 // SetAttestationData sets the attestation data of the SlotData.
 func (b *SlotData[AttestationDataT, SlashingInfoT]) SetAttestationData(
 	attestationData []AttestationDataT,
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import "time"
+
+const (
+	// defaultPoolRetryTimeout is the default time during which the blob pool
+	// is polled again for the blobs missing from a proposal.
+	defaultPoolRetryTimeout = 500 * time.Millisecond
+	// defaultPeerTimeout is the default timeout of a request to a peer.
+	defaultPeerTimeout = 2 * time.Second
+)
+
+// Config is the configuration for the assembly of the blob sidecars.
+type Config struct {
+	// OmitPooledBlobs determines if the proposer leaves the sidecars of the
+	// blobs held by the blob pool of its execution client out of its
+	// proposals, for the validators to rebuild them from their own pool.
+	OmitPooledBlobs bool `mapstructure:"omit-pooled-blobs"`
+	// PoolRetryTimeout is the time during which the blob pool is polled
+	// again for the blobs of a proposal that are neither in the pool nor in
+	// the proposal, before the proposal is rejected.
+	PoolRetryTimeout time.Duration `mapstructure:"pool-retry-timeout"`
+	// PeerURLs are the node API urls of the beacon nodes the sidecars of a
+	// finalized block are requested from, when they are neither in the blob
+	// pool nor in the block.
+	PeerURLs []string `mapstructure:"peer-urls"`
+	// PeerTimeout is the timeout of a request to a peer.
+	PeerTimeout time.Duration `mapstructure:"peer-timeout"`
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		OmitPooledBlobs:  false,
+		PoolRetryTimeout: defaultPoolRetryTimeout,
+		PeerURLs:         []string{},
+		PeerTimeout:      defaultPeerTimeout,
+	}
+}
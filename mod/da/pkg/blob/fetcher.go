@@ -0,0 +1,382 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"golang.org/x/sync/errgroup"
+)
+
+// poolRetryInterval is the interval at which the blob pool is polled again
+// for the missing blobs of a proposal.
+const poolRetryInterval = 100 * time.Millisecond
+
+// Fetcher assembles the blob sidecars of a block from the blob pool of the
+// execution client. Blob transactions are gossiped between execution clients
+// ahead of their inclusion, so most blobs of a proposal can be rebuilt
+// locally instead of being shipped with the proposal. The blobs missing from
+// the pool are taken from the block, or from the peers once the block is
+// finalized.
+type Fetcher[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+] struct {
+	// cfg is the configuration of the fetcher.
+	cfg *Config
+	// logger is used to log information and errors.
+	logger log.Logger[any]
+	// factory builds the inclusion proofs of the sidecars.
+	factory *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]
+	// pool is the blob pool of the execution client.
+	pool BlobPool
+	// client is the HTTP client the peers are requested with.
+	client *http.Client
+	// metrics is used to collect and report fetcher metrics.
+	metrics *fetcherMetrics
+}
+
+// NewFetcher creates a new blob fetcher.
+func NewFetcher[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
+	cfg *Config,
+	logger log.Logger[any],
+	factory *SidecarFactory[BeaconBlockT, BeaconBlockBodyT],
+	pool BlobPool,
+	telemetrySink TelemetrySink,
+) *Fetcher[BeaconBlockT, BeaconBlockBodyT] {
+	return &Fetcher[BeaconBlockT, BeaconBlockBodyT]{
+		cfg:     cfg,
+		logger:  logger,
+		factory: factory,
+		pool:    pool,
+		client:  &http.Client{},
+		metrics: newFetcherMetrics(telemetrySink),
+	}
+}
+
+// OmitPooledSidecars returns the given sidecars of a proposal without the
+// ones whose blob is held by the blob pool of the execution client, as the
+// validators are expected to find these blobs in their own pool. Every
+// sidecar is returned if omitting them is disabled or the pool cannot be
+// queried.
+func (f *Fetcher[_, _]) OmitPooledSidecars(
+	ctx context.Context,
+	sidecars *types.BlobSidecars,
+) *types.BlobSidecars {
+	if !f.cfg.OmitPooledBlobs || sidecars.IsNil() || sidecars.Len() == 0 {
+		return sidecars
+	}
+
+	hashes := make([]gethprimitives.ExecutionHash, sidecars.Len())
+	for i, sidecar := range sidecars.Sidecars {
+		hashes[i] = sidecar.KzgCommitment.ToVersionedHash()
+	}
+	blobs, err := f.getPooledBlobs(ctx, hashes)
+	if err != nil {
+		f.logger.Warn(
+			"Failed to query the blob pool, proposing every sidecar",
+			"error", err,
+		)
+		return sidecars
+	}
+
+	kept := make([]*types.BlobSidecar, 0, sidecars.Len())
+	for i, sidecar := range sidecars.Sidecars {
+		if !isPooled(blobs[i]) {
+			kept = append(kept, sidecar)
+		}
+	}
+	f.metrics.markOmittedSidecars(sidecars.Len() - len(kept))
+	return &types.BlobSidecars{Sidecars: kept}
+}
+
+// FetchSidecars returns the sidecars of the given proposal. The sidecars of
+// the blobs found in the blob pool are built locally, the sidecars of the
+// remaining blobs are taken from the given proposed sidecars. The pool is
+// polled again for a short while for the blobs still missing, as their
+// transactions may not have reached the execution client yet. As the blob
+// pool only holds blobs whose KZG proofs have been checked by the execution
+// client, only the sidecars taken from the proposal still need to be
+// verified, and they are returned separately.
+func (f *Fetcher[BeaconBlockT, _]) FetchSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+	proposed *types.BlobSidecars,
+) (*types.BlobSidecars, *types.BlobSidecars, error) {
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+	if len(commitments) == 0 {
+		return proposed, proposed, nil
+	}
+
+	startTime := time.Now()
+	defer f.metrics.measureFetchSidecarsDuration(
+		startTime, math.U64(len(commitments)),
+	)
+
+	a := newAssembly(blk.GetHeader(), commitments)
+	if err := f.fetchFromPool(ctx, blk, a); err != nil {
+		return nil, nil, err
+	}
+	a.numFromProposal = a.take(proposed)
+
+	deadline := time.Now().Add(f.cfg.PoolRetryTimeout)
+	for len(a.missing()) > 0 && time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(poolRetryInterval):
+		}
+		if err := f.fetchFromPool(ctx, blk, a); err != nil {
+			return nil, nil, err
+		}
+	}
+	return f.result(a)
+}
+
+// RecoverSidecars returns the sidecars of the given finalized block, which
+// was not verified as a proposal by this node. The sidecars of the blobs
+// found in the blob pool are built locally, the sidecars of the remaining
+// blobs are taken from the sidecars of the block, then from the peers. Only
+// the sidecars built locally are known to be valid, the others are returned
+// separately to be verified.
+func (f *Fetcher[BeaconBlockT, _]) RecoverSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+	included *types.BlobSidecars,
+) (*types.BlobSidecars, *types.BlobSidecars, error) {
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+	if len(commitments) == 0 {
+		empty := &types.BlobSidecars{Sidecars: []*types.BlobSidecar{}}
+		return empty, empty, nil
+	}
+
+	startTime := time.Now()
+	defer f.metrics.measureFetchSidecarsDuration(
+		startTime, math.U64(len(commitments)),
+	)
+
+	a := newAssembly(blk.GetHeader(), commitments)
+	if err := f.fetchFromPool(ctx, blk, a); err != nil {
+		return nil, nil, err
+	}
+	a.numFromProposal = a.take(included)
+	for _, url := range f.cfg.PeerURLs {
+		if len(a.missing()) == 0 {
+			break
+		}
+		sidecars, err := f.fetchFromPeer(ctx, url, a.header.GetSlot())
+		if err != nil {
+			f.logger.Warn(
+				"Failed to fetch blob sidecars from peer",
+				"url", url,
+				"error", err,
+			)
+			continue
+		}
+		a.numFromPeers += a.take(sidecars)
+	}
+	return f.result(a)
+}
+
+// fetchFromPool builds the sidecars of the missing blobs of the assembly that
+// are held by the blob pool. Failing to query the pool is not an error, the
+// blobs are left missing.
+func (f *Fetcher[BeaconBlockT, _]) fetchFromPool(
+	ctx context.Context,
+	blk BeaconBlockT,
+	a *assembly,
+) error {
+	missing := a.missing()
+	hashes := make([]gethprimitives.ExecutionHash, len(missing))
+	for i, index := range missing {
+		hashes[i] = a.commitments[index].ToVersionedHash()
+	}
+	blobs, err := f.getPooledBlobs(ctx, hashes)
+	if err != nil {
+		f.logger.Debug(
+			"Failed to fetch blobs from the execution client",
+			"error", err,
+		)
+		return nil
+	}
+
+	var (
+		body = blk.GetBody()
+		g    errgroup.Group
+	)
+	for i, index := range missing {
+		blob := blobs[i]
+		if !isPooled(blob) {
+			continue
+		}
+		a.numFromPool++
+		g.Go(func() error {
+			inclusionProof, err := f.factory.BuildKZGInclusionProof(
+				body, math.U64(index),
+			)
+			if err != nil {
+				return err
+			}
+			a.sidecars[index] = types.BuildBlobSidecar(
+				math.U64(index), a.header,
+				blob.GetBlob(),
+				a.commitments[index],
+				blob.GetProof(),
+				inclusionProof,
+			)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// getPooledBlobs returns the blobs of the given versioned hashes held by the
+// blob pool, with a nil entry for every blob missing from the pool.
+func (f *Fetcher[_, _]) getPooledBlobs(
+	ctx context.Context,
+	hashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof], error) {
+	blobs, err := f.pool.GetBlobs(ctx, hashes)
+	if err != nil {
+		return nil, err
+	} else if len(blobs) != len(hashes) {
+		return nil, errors.Newf(
+			"expected %d blobs, got %d", len(hashes), len(blobs),
+		)
+	}
+	return blobs, nil
+}
+
+// result returns the sidecars of the assembly and the ones that still need
+// to be verified, or an error if some blobs are missing.
+func (f *Fetcher[_, _]) result(
+	a *assembly,
+) (*types.BlobSidecars, *types.BlobSidecars, error) {
+	f.metrics.markFetchedSidecars(
+		a.numFromPool, a.numFromProposal, a.numFromPeers,
+	)
+	f.logger.Info(
+		"Fetched blob sidecars",
+		"num_blobs", len(a.commitments),
+		"from_pool", a.numFromPool,
+		"from_proposal", a.numFromProposal,
+		"from_peers", a.numFromPeers,
+	)
+	if missing := a.missing(); len(missing) > 0 {
+		return nil, nil, errors.Wrapf(
+			ErrMissingBlobs, "%d of %d blobs of slot %d",
+			len(missing), len(a.commitments), a.header.GetSlot(),
+		)
+	}
+	return &types.BlobSidecars{Sidecars: a.sidecars},
+		&types.BlobSidecars{Sidecars: a.unverified}, nil
+}
+
+// isPooled returns true if the given entry of the blob pool holds a blob.
+func isPooled(
+	blob *engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof],
+) bool {
+	return blob != nil && blob.GetBlob() != nil
+}
+
+// assembly holds the sidecars of a block while they are gathered from the
+// blob pool, the proposal and the peers.
+type assembly struct {
+	// header is the header of the block.
+	header *ctypes.BeaconBlockHeader
+	// headerRoot is the root of the header of the block.
+	headerRoot common.Root
+	// commitments are the KZG commitments of the blobs of the block.
+	commitments eip4844.KZGCommitments[common.ExecutionHash]
+	// sidecars holds the sidecar of every blob, nil while it is missing.
+	sidecars []*types.BlobSidecar
+	// unverified are the sidecars that were not built locally.
+	unverified []*types.BlobSidecar
+	// numFromPool is the number of sidecars built from the blob pool.
+	numFromPool int
+	// numFromProposal is the number of sidecars taken from the proposal.
+	numFromProposal int
+	// numFromPeers is the number of sidecars taken from the peers.
+	numFromPeers int
+}
+
+// newAssembly creates the assembly of the sidecars of the block with the
+// given header and commitments.
+func newAssembly(
+	header *ctypes.BeaconBlockHeader,
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) *assembly {
+	return &assembly{
+		header:      header,
+		headerRoot:  header.HashTreeRoot(),
+		commitments: commitments,
+		sidecars:    make([]*types.BlobSidecar, len(commitments)),
+		unverified:  make([]*types.BlobSidecar, 0),
+	}
+}
+
+// missing returns the indices of the blobs without a sidecar.
+func (a *assembly) missing() []uint64 {
+	missing := make([]uint64, 0)
+	for i, sidecar := range a.sidecars {
+		if sidecar == nil {
+			missing = append(missing, uint64(i))
+		}
+	}
+	return missing
+}
+
+// take fills the missing sidecars with the given ones that belong to the
+// block, and returns the number of sidecars taken.
+func (a *assembly) take(sidecars *types.BlobSidecars) int {
+	if sidecars.IsNil() {
+		return 0
+	}
+	var taken int
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar == nil ||
+			sidecar.Index >= uint64(len(a.sidecars)) ||
+			a.sidecars[sidecar.Index] != nil ||
+			sidecar.KzgCommitment != a.commitments[sidecar.Index] ||
+			sidecar.BeaconBlockHeader == nil ||
+			sidecar.BeaconBlockHeader.HashTreeRoot() != a.headerRoot {
+			continue
+		}
+		a.sidecars[sidecar.Index] = sidecar
+		a.unverified = append(a.unverified, sidecar)
+		taken++
+	}
+	return taken
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// fetcherMetrics is a struct that contains metrics for the fetcher.
+type fetcherMetrics struct {
+	// TelemetrySink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newFetcherMetrics creates a new fetcherMetrics.
+func newFetcherMetrics(
+	sink TelemetrySink,
+) *fetcherMetrics {
+	return &fetcherMetrics{
+		sink: sink,
+	}
+}
+
+// measureFetchSidecarsDuration measures the duration of the fetch sidecars.
+func (fm *fetcherMetrics) measureFetchSidecarsDuration(
+	startTime time.Time, numSidecars math.U64,
+) {
+	fm.sink.MeasureSince(
+		"beacon_kit.da.blob.fetcher.fetch_sidecars_duration",
+		startTime,
+		"num_sidecars",
+		numSidecars.Base10(),
+	)
+}
+
+// markFetchedSidecars records the number of sidecars built from the blob
+// pool, the number taken from the proposal and the number taken from the
+// peers.
+func (fm *fetcherMetrics) markFetchedSidecars(
+	fromPool, fromProposal, fromPeers int,
+) {
+	fm.sink.SetGauge(
+		"beacon_kit.da.blob.fetcher.sidecars_from_pool",
+		int64(fromPool),
+	)
+	fm.sink.SetGauge(
+		"beacon_kit.da.blob.fetcher.sidecars_from_proposal",
+		int64(fromProposal),
+	)
+	fm.sink.SetGauge(
+		"beacon_kit.da.blob.fetcher.sidecars_from_peers",
+		int64(fromPeers),
+	)
+}
+
+// markOmittedSidecars records the number of sidecars left out of a proposal
+// because their blobs are held by the blob pool.
+func (fm *fetcherMetrics) markOmittedSidecars(omitted int) {
+	fm.sink.SetGauge(
+		"beacon_kit.da.blob.fetcher.sidecars_omitted",
+		int64(omitted),
+	)
+}
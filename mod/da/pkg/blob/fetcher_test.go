@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type blobAndProof = engineprimitives.BlobAndProofV1[
+	eip4844.Blob, eip4844.KZGProof,
+]
+
+// mockPool is a blob pool holding the blobs of the given versioned hashes.
+type mockPool map[gethprimitives.ExecutionHash]*blobAndProof
+
+func (p mockPool) GetBlobs(
+	_ context.Context,
+	versionedHashes []gethprimitives.ExecutionHash,
+) ([]*blobAndProof, error) {
+	blobs := make([]*blobAndProof, len(versionedHashes))
+	for i, hash := range versionedHashes {
+		blobs[i] = p[hash]
+	}
+	return blobs, nil
+}
+
+// mockSink is a telemetry sink that discards every metric.
+type mockSink struct{}
+
+func (mockSink) MeasureSince(string, time.Time, ...string) {}
+
+func (mockSink) SetGauge(string, int64, ...string) {}
+
+// newTestProposal returns a block with three blobs, along with the factory of
+// its sidecars and the sidecars of the proposal carrying every blob.
+func newTestProposal(t *testing.T) (
+	*ctypes.BeaconBlock,
+	*blob.SidecarFactory[*ctypes.BeaconBlock, *ctypes.BeaconBlockBody],
+	*types.BlobSidecars,
+) {
+	t.Helper()
+	commitments := eip4844.KZGCommitments[gethprimitives.ExecutionHash]{
+		{1}, {2}, {3},
+	}
+	blk := &ctypes.BeaconBlock{
+		Slot: 10,
+		Body: &ctypes.BeaconBlockBody{
+			ExecutionPayload: &ctypes.ExecutionPayload{
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data:           &ctypes.Eth1Data{},
+			BlobKzgCommitments: commitments,
+		},
+	}
+	factory := blob.NewSidecarFactory[
+		*ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+	](&MockSpec{}, ctypes.KZGPositionDeneb, mockSink{})
+	proposed, err := factory.BuildSidecars(
+		blk,
+		&engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{
+			Commitments: commitments,
+			Proofs:      make([]eip4844.KZGProof, len(commitments)),
+			Blobs: []*eip4844.Blob{
+				new(eip4844.Blob), new(eip4844.Blob), new(eip4844.Blob),
+			},
+		},
+	)
+	require.NoError(t, err)
+	return blk, factory, proposed
+}
+
+func TestFetchSidecars(t *testing.T) {
+	blk, factory, proposed := newTestProposal(t)
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+
+	// The proposal carries every blob, but only the second blob is missing
+	// from the blob pool.
+	pooled := &blobAndProof{Blob: &eip4844.Blob{9}, Proof: eip4844.KZGProof{9}}
+	pool := mockPool{
+		commitments[0].ToVersionedHash(): pooled,
+		commitments[2].ToVersionedHash(): pooled,
+	}
+
+	fetcher := blob.NewFetcher(
+		&blob.Config{}, noop.NewLogger[any](), factory, pool, mockSink{},
+	)
+	sidecars, unverified, err := fetcher.FetchSidecars(
+		context.Background(), blk, proposed,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, sidecars.Len())
+	require.Equal(t, 1, unverified.Len())
+	require.Equal(t, proposed.Sidecars[1], unverified.Sidecars[0])
+	require.Equal(t, proposed.Sidecars[1], sidecars.Sidecars[1])
+	for _, i := range []int{0, 2} {
+		require.Equal(t, uint64(i), sidecars.Sidecars[i].Index)
+		require.Equal(t, *pooled.Blob, sidecars.Sidecars[i].Blob)
+		require.Equal(t, pooled.Proof, sidecars.Sidecars[i].KzgProof)
+	}
+	require.NoError(t, sidecars.ValidateBlockRoots())
+	require.NoError(t, sidecars.VerifyInclusionProofs(
+		ctypes.KZGMerkleIndexDeneb*(&MockSpec{}).MaxBlobCommitmentsPerBlock(),
+	))
+
+	// A proposed sidecar of another block is not used as a fallback, the
+	// proposal is missing a blob.
+	other := *proposed.Sidecars[1]
+	other.BeaconBlockHeader = &ctypes.BeaconBlockHeader{Slot: 11}
+	_, _, err = fetcher.FetchSidecars(
+		context.Background(), blk,
+		&types.BlobSidecars{Sidecars: []*types.BlobSidecar{&other}},
+	)
+	require.ErrorIs(t, err, blob.ErrMissingBlobs)
+}
+
+func TestFetchSidecarsWithoutPooledSidecars(t *testing.T) {
+	blk, factory, proposed := newTestProposal(t)
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+	pooled := &blobAndProof{Blob: &eip4844.Blob{9}, Proof: eip4844.KZGProof{9}}
+	pool := mockPool{
+		commitments[0].ToVersionedHash(): pooled,
+		commitments[2].ToVersionedHash(): pooled,
+	}
+	cfg := &blob.Config{OmitPooledBlobs: true}
+	fetcher := blob.NewFetcher(
+		cfg, noop.NewLogger[any](), factory, pool, mockSink{},
+	)
+
+	// The proposer leaves the sidecars of the pooled blobs out.
+	omitted := fetcher.OmitPooledSidecars(context.Background(), proposed)
+	require.Equal(t, []*types.BlobSidecar{proposed.Sidecars[1]}, omitted.Sidecars)
+
+	// A validator with the same blob pool rebuilds them.
+	sidecars, unverified, err := fetcher.FetchSidecars(
+		context.Background(), blk, omitted,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, sidecars.Len())
+	require.Equal(t, omitted.Sidecars, unverified.Sidecars)
+
+	// A validator missing a pooled blob rejects the proposal.
+	delete(pool, commitments[2].ToVersionedHash())
+	_, _, err = fetcher.FetchSidecars(context.Background(), blk, omitted)
+	require.ErrorIs(t, err, blob.ErrMissingBlobs)
+
+	// Every sidecar is proposed when omitting them is disabled.
+	cfg.OmitPooledBlobs = false
+	require.Equal(
+		t, proposed, fetcher.OmitPooledSidecars(context.Background(), proposed),
+	)
+}
+
+func TestRecoverSidecars(t *testing.T) {
+	blk, factory, proposed := newTestProposal(t)
+	commitments := blk.GetBody().GetBlobKzgCommitments()
+	pool := mockPool{
+		commitments[0].ToVersionedHash(): &blobAndProof{
+			Blob: &eip4844.Blob{9}, Proof: eip4844.KZGProof{9},
+		},
+	}
+
+	// The first peer fails, the second one serves every sidecar.
+	failing := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+	))
+	defer failing.Close()
+	var requested string
+	serving := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requested = r.URL.Path
+			require.NoError(t, json.NewEncoder(w).Encode(
+				map[string]any{"data": proposed},
+			))
+		},
+	))
+	defer serving.Close()
+
+	fetcher := blob.NewFetcher(
+		&blob.Config{
+			PeerURLs:    []string{failing.URL, serving.URL},
+			PeerTimeout: time.Second,
+		},
+		noop.NewLogger[any](), factory, pool, mockSink{},
+	)
+
+	// The finalized block only carries the second sidecar, the third one is
+	// taken from the peer.
+	sidecars, unverified, err := fetcher.RecoverSidecars(
+		context.Background(), blk,
+		&types.BlobSidecars{Sidecars: proposed.Sidecars[1:2]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "/eth/v1/beacon/blob_sidecars/10", requested)
+	require.Equal(t, 3, sidecars.Len())
+	require.Equal(t, proposed.Sidecars[1:], unverified.Sidecars)
+	require.Equal(t, eip4844.Blob{9}, sidecars.Sidecars[0].Blob)
+
+	// Without any peer, the third blob is missing.
+	fetcher = blob.NewFetcher(
+		&blob.Config{}, noop.NewLogger[any](), factory, pool, mockSink{},
+	)
+	_, _, err = fetcher.RecoverSidecars(
+		context.Background(), blk,
+		&types.BlobSidecars{Sidecars: proposed.Sidecars[1:2]},
+	)
+	require.ErrorIs(t, err, blob.ErrMissingBlobs)
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
@@ -43,6 +44,16 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 	Persist(math.Slot, BlobSidecarsT) error
 }
 
+// BlobPool is the pool of blob transactions of the execution client.
+type BlobPool interface {
+	// GetBlobs returns the blobs and KZG proofs of the given versioned
+	// hashes, with a nil entry for every blob missing from the pool.
+	GetBlobs(
+		ctx context.Context,
+		versionedHashes []gethprimitives.ExecutionHash,
+	) ([]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof], error)
+}
+
 type BeaconBlock[BeaconBlockBodyT any] interface {
 	GetBody() BeaconBlockBodyT
 	GetHeader() *types.BeaconBlockHeader
@@ -64,4 +75,7 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
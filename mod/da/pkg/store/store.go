@@ -71,6 +71,39 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// GetBlobSidecars returns the sidecars stored for the given slot, in the
+// order of the commitments in the given body. Commitments without a stored
+// sidecar are skipped.
+func (s *Store[BeaconBlockBodyT]) GetBlobSidecars(
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) (*types.BlobSidecars, error) {
+	commitments := body.GetBlobKzgCommitments()
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(commitments)),
+	}
+	for _, commitment := range commitments {
+		ok, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		bz, err := s.IndexDB.Get(slot.Unwrap(), commitment[:])
+		if err != nil {
+			return nil, err
+		}
+
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
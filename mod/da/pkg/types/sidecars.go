@@ -22,6 +22,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/karalabe/ssz"
 	"github.com/sourcegraph/conc/iter"
@@ -85,6 +87,19 @@ func (bs *BlobSidecars) Len() int {
 	return len(bs.Sidecars)
 }
 
+// MarshalJSON marshals the sidecars as a list of sidecars.
+func (bs *BlobSidecars) MarshalJSON() ([]byte, error) {
+	if bs.Sidecars == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(bs.Sidecars)
+}
+
+// UnmarshalJSON unmarshals the sidecars from a list of sidecars.
+func (bs *BlobSidecars) UnmarshalJSON(input []byte) error {
+	return json.Unmarshal(input, &bs.Sidecars)
+}
+
 // DefineSSZ defines the SSZ encoding for the BlobSidecars object.
 func (bs *BlobSidecars) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &bs.Sidecars, 6)
@@ -21,6 +21,7 @@
 package types_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -154,3 +155,35 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestSidecarsJSONMarshalling(t *testing.T) {
+	sidecars := &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{
+			types.BuildBlobSidecar(
+				math.U64(3),
+				&ctypes.BeaconBlockHeader{
+					Slot:          12,
+					ProposerIndex: 4,
+					StateRoot:     common.Root{1},
+				},
+				&eip4844.Blob{5},
+				eip4844.KZGCommitment{6},
+				eip4844.KZGProof{7},
+				[]common.Root{{8}, {9}},
+			),
+		},
+	}
+
+	bz, err := json.Marshal(sidecars)
+	require.NoError(t, err)
+	require.Equal(t, byte('['), bz[0])
+
+	decoded := new(types.BlobSidecars)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, sidecars, decoded)
+
+	// Sidecars without any sidecar are marshalled as an empty list.
+	bz, err = json.Marshal(&types.BlobSidecars{})
+	require.NoError(t, err)
+	require.JSONEq(t, `[]`, string(bz))
+}
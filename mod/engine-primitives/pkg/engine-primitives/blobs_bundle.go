@@ -48,3 +48,24 @@ func (b *BlobsBundleV1[C, P, B]) GetProofs() []P {
 func (b *BlobsBundleV1[C, P, B]) GetBlobs() []*B {
 	return b.Blobs
 }
+
+// BlobAndProofV1 represents a blob held in the blob pool of the execution
+// client along with its KZG proof, as returned by engine_getBlobsV1.
+type BlobAndProofV1[
+	B ~[131072]byte, P ~[48]byte,
+] struct {
+	// Blob is the blob data.
+	Blob *B `json:"blob"`
+	// Proof is the KZG proof of the blob.
+	Proof P `json:"proof"`
+}
+
+// GetBlob returns the blob data.
+func (b *BlobAndProofV1[B, P]) GetBlob() *B {
+	return b.Blob
+}
+
+// GetProof returns the KZG proof of the blob.
+func (b *BlobAndProofV1[B, P]) GetProof() P {
+	return b.Proof
+}
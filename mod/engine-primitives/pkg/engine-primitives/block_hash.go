@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlockHashPayload is the set of execution payload fields that make up the
+// execution block header.
+type BlockHashPayload[WithdrawalT BlockHashWithdrawal] interface {
+	GetPrevRandao() common.Bytes32
+	GetBlockHash() gethprimitives.ExecutionHash
+	GetParentHash() gethprimitives.ExecutionHash
+	GetNumber() math.U64
+	GetGasLimit() math.U64
+	GetGasUsed() math.U64
+	GetTimestamp() math.U64
+	GetExtraData() []byte
+	GetBaseFeePerGas() *math.U256
+	GetFeeRecipient() gethprimitives.ExecutionAddress
+	GetStateRoot() common.Bytes32
+	GetReceiptsRoot() common.Bytes32
+	GetLogsBloom() bytes.B256
+	GetBlobGasUsed() math.U64
+	GetExcessBlobGas() math.U64
+	GetWithdrawals() []WithdrawalT
+	GetTransactions() Transactions
+}
+
+// BlockHashWithdrawal is the set of withdrawal fields that make up the
+// withdrawals trie of the execution block.
+type BlockHashWithdrawal interface {
+	GetIndex() math.U64
+	GetAmount() math.U64
+	GetAddress() gethprimitives.ExecutionAddress
+	GetValidatorIndex() math.U64
+}
+
+// VerifyBlockHash checks that the block hash of the execution payload matches
+// the hash of the execution block header assembled from its fields. This
+// runs entirely locally and requires no call to the execution client.
+// As per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/v1.4.0-beta.2/specs/deneb/beacon-chain.md#is_valid_block_hash
+//
+//nolint:lll
+func VerifyBlockHash[
+	ExecutionPayloadT BlockHashPayload[WithdrawalT],
+	WithdrawalT BlockHashWithdrawal,
+](
+	payload ExecutionPayloadT,
+	parentBeaconBlockRoot *common.Root,
+) error {
+	txs, err := decodeTransactions(payload.GetTransactions())
+	if err != nil {
+		return err
+	}
+	return verifyBlockHash(payload, txs, parentBeaconBlockRoot)
+}
+
+// ComputeBlockHash computes the hash of the execution block header assembled
+// from the fields of the execution payload.
+func ComputeBlockHash[
+	ExecutionPayloadT BlockHashPayload[WithdrawalT],
+	WithdrawalT BlockHashWithdrawal,
+](
+	payload ExecutionPayloadT,
+	parentBeaconBlockRoot *common.Root,
+) (gethprimitives.ExecutionHash, error) {
+	txs, err := decodeTransactions(payload.GetTransactions())
+	if err != nil {
+		return gethprimitives.ExecutionHash{}, err
+	}
+	return computeBlockHash(payload, txs, parentBeaconBlockRoot), nil
+}
+
+// withdrawalsTrieRoot computes the root of the withdrawals trie of the
+// execution block containing the given withdrawals.
+func withdrawalsTrieRoot[WithdrawalT BlockHashWithdrawal](
+	withdrawals []WithdrawalT,
+) gethprimitives.ExecutionHash {
+	return gethprimitives.DeriveSha(
+		gethprimitives.Withdrawals(toGethWithdrawals(withdrawals)),
+		gethprimitives.NewStackTrie(nil),
+	)
+}
+
+// verifyBlockHash checks the block hash of the execution payload against
+// the given decoded transactions.
+func verifyBlockHash[
+	ExecutionPayloadT BlockHashPayload[WithdrawalT],
+	WithdrawalT BlockHashWithdrawal,
+](
+	payload ExecutionPayloadT,
+	txs []*gethprimitives.Transaction,
+	parentBeaconBlockRoot *common.Root,
+) error {
+	if hash := computeBlockHash(
+		payload, txs, parentBeaconBlockRoot,
+	); hash != payload.GetBlockHash() {
+		return errors.Wrapf(ErrPayloadBlockHashMismatch,
+			"%x, got %x",
+			payload.GetBlockHash(), hash,
+		)
+	}
+	return nil
+}
+
+// computeBlockHash assembles the execution block header from the payload and
+// the given decoded transactions and returns its hash.
+func computeBlockHash[
+	ExecutionPayloadT BlockHashPayload[WithdrawalT],
+	WithdrawalT BlockHashWithdrawal,
+](
+	payload ExecutionPayloadT,
+	txs []*gethprimitives.Transaction,
+	parentBeaconBlockRoot *common.Root,
+) gethprimitives.ExecutionHash {
+	var withdrawalsHash *gethprimitives.ExecutionHash
+	if payload.GetWithdrawals() != nil {
+		h := withdrawalsTrieRoot(payload.GetWithdrawals())
+		withdrawalsHash = &h
+	}
+
+	return (&gethprimitives.Header{
+		ParentHash:  payload.GetParentHash(),
+		UncleHash:   gethprimitives.EmptyUncleHash,
+		Coinbase:    payload.GetFeeRecipient(),
+		Root:        gethprimitives.ExecutionHash(payload.GetStateRoot()),
+		TxHash:      transactionsTrieRoot(txs),
+		ReceiptHash: gethprimitives.ExecutionHash(payload.GetReceiptsRoot()),
+		Bloom:       gethprimitives.LogsBloom(payload.GetLogsBloom()),
+		Difficulty:  big.NewInt(0),
+		Number: new(big.Int).SetUint64(
+			payload.GetNumber().Unwrap(),
+		),
+		GasLimit:        payload.GetGasLimit().Unwrap(),
+		GasUsed:         payload.GetGasUsed().Unwrap(),
+		Time:            payload.GetTimestamp().Unwrap(),
+		BaseFee:         payload.GetBaseFeePerGas().ToBig(),
+		Extra:           payload.GetExtraData(),
+		MixDigest:       gethprimitives.ExecutionHash(payload.GetPrevRandao()),
+		WithdrawalsHash: withdrawalsHash,
+		ExcessBlobGas:   payload.GetExcessBlobGas().UnwrapPtr(),
+		BlobGasUsed:     payload.GetBlobGasUsed().UnwrapPtr(),
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(
+			parentBeaconBlockRoot,
+		),
+	}).Hash()
+}
+
+// transactionsTrieRoot computes the root of the transactions trie of the
+// given decoded transactions.
+func transactionsTrieRoot(
+	txs []*gethprimitives.Transaction,
+) gethprimitives.ExecutionHash {
+	return gethprimitives.DeriveSha(
+		gethprimitives.Transactions(txs),
+		gethprimitives.NewStackTrie(nil),
+	)
+}
+
+// decodeTransactions decodes the given encoded transactions.
+func decodeTransactions(
+	txs Transactions,
+) ([]*gethprimitives.Transaction, error) {
+	decoded := make([]*gethprimitives.Transaction, len(txs))
+	for i, encTx := range txs {
+		var tx gethprimitives.Transaction
+		if err := tx.UnmarshalBinary(encTx); err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction %d", i)
+		}
+		decoded[i] = &tx
+	}
+	return decoded, nil
+}
+
+// toGethWithdrawals converts the given withdrawals to geth withdrawals.
+func toGethWithdrawals[WithdrawalT BlockHashWithdrawal](
+	withdrawals []WithdrawalT,
+) []*gethprimitives.Withdrawal {
+	gethWithdrawals := make([]*gethprimitives.Withdrawal, len(withdrawals))
+	for i, wd := range withdrawals {
+		gethWithdrawals[i] = &gethprimitives.Withdrawal{
+			Index:     wd.GetIndex().Unwrap(),
+			Amount:    wd.GetAmount().Unwrap(),
+			Address:   wd.GetAddress(),
+			Validator: wd.GetValidatorIndex().Unwrap(),
+		}
+	}
+	return gethWithdrawals
+}
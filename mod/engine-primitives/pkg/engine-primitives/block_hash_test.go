@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// executableDataPayload exposes geth executable data as an execution payload.
+type executableDataPayload struct {
+	*gethprimitives.ExecutableData
+}
+
+func (p executableDataPayload) GetPrevRandao() common.Bytes32 {
+	return common.Bytes32(p.Random)
+}
+
+func (p executableDataPayload) GetBlockHash() gethprimitives.ExecutionHash {
+	return p.BlockHash
+}
+
+func (p executableDataPayload) GetParentHash() gethprimitives.ExecutionHash {
+	return p.ParentHash
+}
+
+func (p executableDataPayload) GetNumber() math.U64 {
+	return math.U64(p.Number)
+}
+
+func (p executableDataPayload) GetGasLimit() math.U64 {
+	return math.U64(p.GasLimit)
+}
+
+func (p executableDataPayload) GetGasUsed() math.U64 {
+	return math.U64(p.GasUsed)
+}
+
+func (p executableDataPayload) GetTimestamp() math.U64 {
+	return math.U64(p.Timestamp)
+}
+
+func (p executableDataPayload) GetExtraData() []byte {
+	return p.ExtraData
+}
+
+func (p executableDataPayload) GetBaseFeePerGas() *math.U256 {
+	return math.NewU256FromBigInt(p.BaseFeePerGas)
+}
+
+func (p executableDataPayload) GetFeeRecipient() common.ExecutionAddress {
+	return p.FeeRecipient
+}
+
+func (p executableDataPayload) GetStateRoot() common.Bytes32 {
+	return common.Bytes32(p.StateRoot)
+}
+
+func (p executableDataPayload) GetReceiptsRoot() common.Bytes32 {
+	return common.Bytes32(p.ReceiptsRoot)
+}
+
+func (p executableDataPayload) GetLogsBloom() bytes.B256 {
+	return bytes.B256(p.LogsBloom)
+}
+
+func (p executableDataPayload) GetBlobGasUsed() math.U64 {
+	return math.U64(*p.BlobGasUsed)
+}
+
+func (p executableDataPayload) GetExcessBlobGas() math.U64 {
+	return math.U64(*p.ExcessBlobGas)
+}
+
+func (p executableDataPayload) GetWithdrawals() []*engineprimitives.Withdrawal {
+	withdrawals := make([]*engineprimitives.Withdrawal, len(p.Withdrawals))
+	for i, w := range p.Withdrawals {
+		withdrawals[i] = &engineprimitives.Withdrawal{
+			Index:     math.U64(w.Index),
+			Validator: math.ValidatorIndex(w.Validator),
+			Address:   w.Address,
+			Amount:    math.Gwei(w.Amount),
+		}
+	}
+	return withdrawals
+}
+
+func (p executableDataPayload) GetTransactions() engineprimitives.Transactions {
+	return p.Transactions
+}
+
+func generateExecutableData(t *testing.T) *gethprimitives.ExecutableData {
+	t.Helper()
+	tx, err := coretypes.NewTx(&coretypes.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(7),
+		Gas:      21000,
+		Value:    big.NewInt(100),
+	}).MarshalBinary()
+	require.NoError(t, err)
+
+	blobGasUsed, excessBlobGas := uint64(0), uint64(0)
+	return &gethprimitives.ExecutableData{
+		ParentHash:    gethprimitives.HexToHash("0x01"),
+		FeeRecipient:  gethprimitives.HexToAddress("0x02"),
+		StateRoot:     gethprimitives.HexToHash("0x03"),
+		ReceiptsRoot:  gethprimitives.HexToHash("0x04"),
+		LogsBloom:     make([]byte, 256),
+		Random:        gethprimitives.HexToHash("0x05"),
+		Number:        10,
+		GasLimit:      30_000_000,
+		GasUsed:       21000,
+		Timestamp:     1234,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: big.NewInt(7),
+		Transactions:  [][]byte{tx},
+		Withdrawals: []*gethprimitives.Withdrawal{
+			{
+				Index:     1,
+				Validator: 2,
+				Address:   gethprimitives.HexToAddress("0x06"),
+				Amount:    3,
+			},
+		},
+		BlobGasUsed:   &blobGasUsed,
+		ExcessBlobGas: &excessBlobGas,
+	}
+}
+
+func TestVerifyBlockHash(t *testing.T) {
+	data := generateExecutableData(t)
+	beaconRoot := common.Root{0x07}
+
+	hash, err := engineprimitives.ComputeBlockHash(
+		executableDataPayload{data}, &beaconRoot,
+	)
+	require.NoError(t, err)
+	data.BlockHash = hash
+
+	// The execution client agrees with the locally computed block hash.
+	block, err := gethprimitives.ExecutableDataToBlock(
+		*data, nil, (*gethprimitives.ExecutionHash)(&beaconRoot),
+	)
+	require.NoError(t, err)
+	require.Equal(t, hash, block.Hash())
+	require.NoError(t, engineprimitives.VerifyBlockHash(
+		executableDataPayload{data}, &beaconRoot,
+	))
+
+	// The tries match the ones of the execution block.
+	txsRoot, err := engineprimitives.TransactionsTrieRoot(data.Transactions)
+	require.NoError(t, err)
+	require.Equal(t, block.TxHash(), txsRoot)
+	require.Equal(t, *block.Header().WithdrawalsHash,
+		engineprimitives.WithdrawalsTrieRoot(
+			executableDataPayload{data}.GetWithdrawals(),
+		),
+	)
+
+	// Any tampered field is detected.
+	data.GasUsed++
+	require.ErrorIs(t, engineprimitives.VerifyBlockHash(
+		executableDataPayload{data}, &beaconRoot,
+	), engineprimitives.ErrPayloadBlockHashMismatch)
+}
+
+func TestVerifyBlockHashInvalidTransaction(t *testing.T) {
+	data := generateExecutableData(t)
+	data.Transactions = [][]byte{{0xff}}
+	_, err := engineprimitives.TransactionsTrieRoot(data.Transactions)
+	require.Error(t, err)
+	require.Error(t, engineprimitives.VerifyBlockHash(
+		executableDataPayload{data}, &common.Root{},
+	))
+}
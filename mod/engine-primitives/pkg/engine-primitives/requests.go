@@ -21,8 +21,6 @@
 package engineprimitives
 
 import (
-	"math/big"
-
 	"github.com/berachain/beacon-kit/mod/errors"
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
@@ -116,34 +114,24 @@ func BuildNewPayloadRequest[
 	}
 }
 
-// HasValidVersionedAndBlockHashes checks if the version and block hashes are
-// valid.
+// HasValidVersionedHashes checks if the versioned hashes are valid. The block
+// hash is verified by VerifyBlockHash when the payload is converted to its
+// header, before the request is built.
 // As per the Ethereum 2.0 specification:
-// https://github.com/ethereum/consensus-specs/blob/v1.4.0-beta.2/specs/deneb/beacon-chain.md#is_valid_block_hash
 // https://github.com/ethereum/consensus-specs/blob/v1.4.0-beta.2/specs/deneb/beacon-chain.md#is_valid_versioned_hashes
 //
 //nolint:lll
-func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalT]) HasValidVersionedAndBlockHashes() error {
-	var (
-		gethWithdrawals []*gethprimitives.Withdrawal
-		withdrawalsHash *gethprimitives.ExecutionHash
-		blobHashes      = make([]gethprimitives.ExecutionHash, 0)
-		payload         = n.ExecutionPayload
-		txs             = make(
-			[]*gethprimitives.Transaction,
-			len(payload.GetTransactions()),
-		)
-	)
+func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalT]) HasValidVersionedHashes() error {
+	blobHashes := make([]gethprimitives.ExecutionHash, 0)
 
 	// Extracts and validates the blob hashes from the transactions in the
 	// execution payload.
-	for i, encTx := range payload.GetTransactions() {
-		var tx gethprimitives.Transaction
-		if err := tx.UnmarshalBinary(encTx); err != nil {
-			return errors.Wrapf(err, "invalid transaction %d", i)
-		}
+	txs, err := decodeTransactions(n.ExecutionPayload.GetTransactions())
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
 		blobHashes = append(blobHashes, tx.BlobHashes()...)
-		txs[i] = &tx
 	}
 
 	// Check if the number of blob hashes matches the number of versioned
@@ -169,59 +157,6 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalT]) HasValidVersionedAnd
 			)
 		}
 	}
-
-	// Construct the withdrawals and withdrawals hash.
-	if payload.GetWithdrawals() != nil {
-		gethWithdrawals = make(
-			[]*gethprimitives.Withdrawal,
-			len(payload.GetWithdrawals()),
-		)
-		for i, wd := range payload.GetWithdrawals() {
-			gethWithdrawals[i] = &gethprimitives.Withdrawal{
-				Index:     wd.GetIndex().Unwrap(),
-				Amount:    wd.GetAmount().Unwrap(),
-				Address:   wd.GetAddress(),
-				Validator: wd.GetValidatorIndex().Unwrap(),
-			}
-		}
-		h := gethprimitives.DeriveSha(
-			gethprimitives.Withdrawals(gethWithdrawals),
-			gethprimitives.NewStackTrie(nil),
-		)
-		withdrawalsHash = &h
-	}
-
-	// Verify that the payload is telling the truth about it's block hash.
-	if block := gethprimitives.NewBlockWithHeader(
-		&gethprimitives.Header{
-			ParentHash:       payload.GetParentHash(),
-			UncleHash:        gethprimitives.EmptyUncleHash,
-			Coinbase:         payload.GetFeeRecipient(),
-			Root:             gethprimitives.ExecutionHash(payload.GetStateRoot()),
-			TxHash:           gethprimitives.DeriveSha(gethprimitives.Transactions(txs), gethprimitives.NewStackTrie(nil)),
-			ReceiptHash:      gethprimitives.ExecutionHash(payload.GetReceiptsRoot()),
-			Bloom:            gethprimitives.LogsBloom(payload.GetLogsBloom()),
-			Difficulty:       big.NewInt(0),
-			Number:           new(big.Int).SetUint64(payload.GetNumber().Unwrap()),
-			GasLimit:         payload.GetGasLimit().Unwrap(),
-			GasUsed:          payload.GetGasUsed().Unwrap(),
-			Time:             payload.GetTimestamp().Unwrap(),
-			BaseFee:          payload.GetBaseFeePerGas().ToBig(),
-			Extra:            payload.GetExtraData(),
-			MixDigest:        gethprimitives.ExecutionHash(payload.GetPrevRandao()),
-			WithdrawalsHash:  withdrawalsHash,
-			ExcessBlobGas:    payload.GetExcessBlobGas().UnwrapPtr(),
-			BlobGasUsed:      payload.GetBlobGasUsed().UnwrapPtr(),
-			ParentBeaconRoot: (*gethprimitives.ExecutionHash)(n.ParentBeaconBlockRoot),
-		},
-	).WithBody(gethprimitives.Body{
-		Transactions: txs, Uncles: nil, Withdrawals: gethWithdrawals,
-	}); block.Hash() != payload.GetBlockHash() {
-		return errors.Wrapf(ErrPayloadBlockHashMismatch,
-			"%x, got %x",
-			payload.GetBlockHash(), block.Hash(),
-		)
-	}
 	return nil
 }
 
@@ -161,7 +161,7 @@ func TestBuildGetPayloadRequest(t *testing.T) {
 	require.Equal(t, forkVersion, request.ForkVersion)
 }
 
-func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
+func TestHasValidVersionedHashes(t *testing.T) {
 	executionPayload := MockExecutionPayload{}
 	versionedHashes := []gethprimitives.ExecutionHash{}
 	parentBeaconBlockRoot := common.Root{}
@@ -174,11 +174,17 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		optimistic,
 	)
 
-	err := request.HasValidVersionedAndBlockHashes()
+	require.NoError(t, request.HasValidVersionedHashes())
+}
+
+func TestVerifyBlockHashPayloadError(t *testing.T) {
+	err := engineprimitives.VerifyBlockHash(
+		MockExecutionPayload{}, &common.Root{},
+	)
 	require.ErrorIs(t, err, engineprimitives.ErrPayloadBlockHashMismatch)
 }
 
-func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
+func TestHasValidVersionedHashesMismatchedHashes(t *testing.T) {
 	executionPayload := MockExecutionPayload{}
 	versionedHashes := []gethprimitives.ExecutionHash{
 		gethprimitives.ExecutionHash{},
@@ -193,6 +199,6 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		optimistic,
 	)
 
-	err := request.HasValidVersionedAndBlockHashes()
+	err := request.HasValidVersionedHashes()
 	require.ErrorIs(t, err, engineprimitives.ErrMismatchedNumVersionedHashes)
 }
@@ -21,12 +21,161 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
+	"sync"
 	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
-// jwtRefreshLoop refreshes the JWT token for the execution client.
+// jwtAuth holds the JWT secrets used to authenticate with the execution
+// clients. When the secret is rotated the replaced secrets are still tried for
+// a grace period, so that the execution clients can pick up the new secret
+// without both processes restarting at the same moment.
+type jwtAuth struct {
+	// claims are the optional claims attached to every token.
+	claims jwt.Claims
+	// gracePeriod is how long a replaced secret is tried after a rotation.
+	gracePeriod time.Duration
+
+	mu sync.RWMutex
+	// current is the secret tokens are signed with first.
+	current *jwt.Secret
+	// retired are the secrets replaced by rotations, oldest first.
+	retired []retiredSecret
+}
+
+// retiredSecret is a secret replaced by a rotation.
+type retiredSecret struct {
+	secret    *jwt.Secret
+	retiredAt time.Time
+}
+
+// newJWTAuth creates a new jwtAuth that signs tokens with the given secret.
+func newJWTAuth(
+	secret *jwt.Secret,
+	claims jwt.Claims,
+	gracePeriod time.Duration,
+) *jwtAuth {
+	return &jwtAuth{
+		claims:      claims,
+		gracePeriod: gracePeriod,
+		current:     secret,
+	}
+}
+
+// rotate makes the given secret the current one and starts the grace period
+// of the replaced secret. It returns false if the secret is unchanged.
+func (a *jwtAuth) rotate(secret *jwt.Secret) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if *secret == *a.current {
+		return false
+	}
+	a.retired = append(a.retired, retiredSecret{
+		secret:    a.current,
+		retiredAt: time.Now(),
+	})
+	a.current = secret
+	return true
+}
+
+// secrets returns the current secret, followed by the retired secrets that
+// are within their grace period, most recently retired first.
+func (a *jwtAuth) secrets() []*jwt.Secret {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for len(a.retired) > 0 &&
+		time.Since(a.retired[0].retiredAt) > a.gracePeriod {
+		a.retired = a.retired[1:]
+	}
+
+	secrets := make([]*jwt.Secret, 0, len(a.retired)+1)
+	secrets = append(secrets, a.current)
+	for i := len(a.retired) - 1; i >= 0; i-- {
+		secrets = append(secrets, a.retired[i].secret)
+	}
+	return secrets
+}
+
+// authTransport is an http.RoundTripper that attaches a freshly signed JWT to
+// every request. Requests rejected with 401 are retried with the secrets
+// that are within the grace period of a rotation, including a rotation that
+// happened while the request was in flight.
+type authTransport struct {
+	auth *jwtAuth
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		tried = make(map[jwt.Secret]struct{})
+		resp  *http.Response
+		body  []byte
+		err   error
+	)
+	// The body is buffered since the request may have to be sent again.
+	if req.Body != nil {
+		body, err = io.ReadAll(req.Body)
+		if cerr := req.Body.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		// The secrets are read again before every attempt, so that the
+		// secret a rotation installed during the previous attempt is tried.
+		secret := t.untried(tried)
+		if secret == nil {
+			return resp, nil
+		}
+		if resp != nil {
+			//#nosec:G104 // the response is discarded.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tried[*secret] = struct{}{}
+
+		var token string
+		if token, err = jwt.BuildSignedJWTWithClaims(
+			secret, t.auth.claims,
+		); err != nil {
+			return nil, err
+		}
+
+		attempt := req.Clone(req.Context())
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		attempt.Header.Set("Authorization", "Bearer "+token)
+
+		if resp, err = t.next.RoundTrip(attempt); err != nil ||
+			resp.StatusCode != http.StatusUnauthorized {
+			return resp, err
+		}
+	}
+}
+
+// untried returns the first secret to sign requests with that is not in the
+// given set, or nil if all of them were tried.
+func (t *authTransport) untried(tried map[jwt.Secret]struct{}) *jwt.Secret {
+	for _, secret := range t.auth.secrets() {
+		if _, ok := tried[*secret]; !ok {
+			return secret
+		}
+	}
+	return nil
+}
+
+// jwtRefreshLoop watches the JWT secret file and rotates the secret used to
+// authenticate with the execution clients when the file changes.
 func (s *EngineClient[
 	_, _,
 ]) jwtRefreshLoop(
@@ -34,38 +183,35 @@ func (s *EngineClient[
 ) {
 	s.logger.Info("Starting JWT refresh loop 🔄")
 	ticker := time.NewTicker(s.cfg.RPCJWTRefreshInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
-			ticker.Stop()
 			return
 		case <-ticker.C:
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
-				s.logger.Error(
-					"Failed to refresh engine auth token",
-					"err",
-					err,
-				)
-			}
+			s.reloadJWTSecret()
 		}
 	}
 }
 
-// buildJWTHeader builds an http.Header that has the JWT token
-// attached for authorization.
+// reloadJWTSecret reads the JWT secret file and rotates the secret if it has
+// changed.
 func (s *EngineClient[
 	_, _,
-]) buildJWTHeader() (http.Header, error) {
-	header := make(http.Header)
-
-	// Build the JWT token.
-	token, err := buildSignedJWT(s.jwtSecret)
+]) reloadJWTSecret() {
+	secret, err := jwt.NewFromFile(s.cfg.JWTSecretPath)
 	if err != nil {
-		s.logger.Error("Failed to build JWT token", "err", err)
-		return header, err
+		s.logger.Error(
+			"Failed to reload JWT secret",
+			"path", s.cfg.JWTSecretPath,
+			"err", err,
+		)
+		return
+	}
+	if s.auth.rotate(secret) {
+		s.logger.Info(
+			"Rotated JWT secret, previous secret remains valid",
+			"grace_period", s.cfg.JWTGracePeriod.String(),
+		)
 	}
-
-	// Add the JWT token to the headers.
-	header.Set("Authorization", "Bearer "+token)
-	return header, nil
 }
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// elAuth authenticates the requests to a stub execution client with a
+// single secret, as execution clients do.
+type elAuth struct {
+	mu     sync.Mutex
+	secret *jwt.Secret
+	// rejected is the number of requests rejected with 401.
+	rejected int
+	// gate, if set, blocks the next request before it is authenticated
+	// until it is closed.
+	gate chan struct{}
+	// arrived is notified when a request is blocked by the gate.
+	arrived chan struct{}
+}
+
+// handler wraps the handler of a stub execution client with authentication.
+func (a *elAuth) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.mu.Lock()
+		gate := a.gate
+		a.gate = nil
+		a.mu.Unlock()
+		if gate != nil {
+			a.arrived <- struct{}{}
+			<-gate
+		}
+
+		a.mu.Lock()
+		secret := a.secret
+		a.mu.Unlock()
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, err := gjwt.Parse(
+			token,
+			func(*gjwt.Token) (any, error) { return secret[:], nil },
+			gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+		); err != nil {
+			a.mu.Lock()
+			a.rejected++
+			a.mu.Unlock()
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// setSecret rotates the secret the requests are authenticated with.
+func (a *elAuth) setSecret(secret *jwt.Secret) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.secret = secret
+}
+
+// hold blocks the next request until the returned function is called.
+func (a *elAuth) hold() func() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	gate := make(chan struct{})
+	a.gate = gate
+	return func() { close(gate) }
+}
+
+// rejections returns the number of requests rejected with 401.
+func (a *elAuth) rejections() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.rejected
+}
+
+// newSecret creates a random secret.
+func newSecret(t *testing.T) *jwt.Secret {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	return secret
+}
+
+// writeSecret writes the secret to the JWT secret file at the given path.
+func writeSecret(t *testing.T, path string, secret *jwt.Secret) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(secret.Hex()), 0o600))
+}
+
+// newAuthClient starts an engine client authenticating with the secret in
+// the returned file to a stub execution client authenticating with the
+// same secret.
+func newAuthClient(
+	t *testing.T,
+	gracePeriod time.Duration,
+) (*testEngineClient, *elAuth, string) {
+	t.Helper()
+	secret := newSecret(t)
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	writeSecret(t, path, secret)
+
+	auth := &elAuth{secret: secret, arrived: make(chan struct{})}
+	el := newStubEL(t, auth.handler)
+	el.set("eth_blockNumber", "0x1")
+	c := newTestClient(t, secret, func(cfg *client.Config) {
+		cfg.JWTSecretPath = path
+		cfg.JWTGracePeriod = gracePeriod
+		cfg.RPCJWTRefreshInterval = time.Hour
+	}, el)
+	return c, auth, path
+}
+
+func TestJWTRotationGracePeriod(t *testing.T) {
+	ctx := context.Background()
+	c, auth, path := newAuthClient(t, 100*time.Millisecond)
+
+	// The execution client has not picked up the new secret yet, the
+	// previous one is tried again.
+	next := newSecret(t)
+	writeSecret(t, path, next)
+	c.ReloadJWTSecret()
+	_, err := c.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, auth.rejections())
+
+	// Once the grace period is over the previous secret is no longer used.
+	require.Eventually(t, func() bool {
+		_, err = c.BlockNumber(ctx)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	// The execution client picked up the new secret.
+	auth.setSecret(next)
+	rejected := auth.rejections()
+	_, err = c.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rejected, auth.rejections())
+}
+
+func TestJWTRotationDuringRequest(t *testing.T) {
+	ctx := context.Background()
+	c, auth, path := newAuthClient(t, time.Minute)
+
+	// The request is signed with the current secret, both clients rotate
+	// to the new secret before it is authenticated.
+	release := auth.hold()
+	errs := make(chan error, 1)
+	go func() {
+		_, err := c.BlockNumber(ctx)
+		errs <- err
+	}()
+	<-auth.arrived
+
+	next := newSecret(t)
+	writeSecret(t, path, next)
+	c.ReloadJWTSecret()
+	auth.setSecret(next)
+	release()
+
+	// The rejected request is sent again signed with the new secret.
+	require.NoError(t, <-errs)
+	require.Equal(t, 1, auth.rejections())
+}
+
+func TestJWTUnchangedSecretIsNotRotated(t *testing.T) {
+	ctx := context.Background()
+	c, auth, _ := newAuthClient(t, time.Minute)
+
+	c.ReloadJWTSecret()
+	_, err := c.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Zero(t, auth.rejections())
+}
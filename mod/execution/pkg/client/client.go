@@ -27,9 +27,11 @@ import (
 	"strings"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/cache"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -48,8 +50,9 @@ type EngineClient[
 	cfg *Config
 	// logger is the logger for the engine client.
 	logger log.Logger[any]
-	// jwtSecret is the JWT secret for the execution client.
-	jwtSecret *jwt.Secret
+	// auth authenticates the requests to the execution clients, nil if no
+	// JWT secret is set.
+	auth *jwtAuth
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
@@ -59,6 +62,18 @@ type EngineClient[
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
+	// endpoints are the execution clients, with the primary first.
+	endpoints []*endpoint[ExecutionPayloadT]
+	// payloadIDs maps payload IDs to the payload IDs of every endpoint.
+	payloadIDs *payloadIDCache
+	// recorder records the exchanges with the execution clients, nil if
+	// recording is disabled.
+	recorder *recorder.Recorder
+	// clientVersion is the client version of this consensus client.
+	clientVersion engineprimitives.ClientVersionV1
+	// reconnected is notified whenever the connection to an execution
+	// client is established or restored.
+	reconnected chan struct{}
 }
 
 // New creates a new engine client EngineClient.
@@ -73,18 +88,31 @@ func New[
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	clientVersion engineprimitives.ClientVersionV1,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	var auth *jwtAuth
+	if jwtSecret != nil {
+		auth = newJWTAuth(
+			jwtSecret,
+			jwt.Claims{ID: cfg.JWTID, ClientVersion: clientVersion.Version},
+			cfg.JWTGracePeriod,
+		)
+	}
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
-		cfg:          cfg,
-		logger:       logger,
-		jwtSecret:    jwtSecret,
-		Eth1Client:   new(ethclient.Eth1Client[ExecutionPayloadT]),
-		capabilities: make(map[string]struct{}),
-		engineCache:  cache.NewEngineCacheWithDefaultConfig(),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+		cfg:           cfg,
+		logger:        logger,
+		auth:          auth,
+		Eth1Client:    new(ethclient.Eth1Client[ExecutionPayloadT]),
+		capabilities:  make(map[string]struct{}),
+		engineCache:   cache.NewEngineCacheWithDefaultConfig(),
+		eth1ChainID:   eth1ChainID,
+		metrics:       newClientMetrics(telemetrySink, logger),
+		endpoints:     newEndpoints[ExecutionPayloadT](cfg),
+		payloadIDs:    newPayloadIDCache(),
+		clientVersion: clientVersion,
+		reconnected:   make(chan struct{}, 1),
 	}
 }
 
@@ -95,16 +123,30 @@ func (s *EngineClient[
 	return "engine-client"
 }
 
-// Start the engine client.
+// Reconnected returns a channel that is notified whenever the connection to
+// an execution client is established, or restored after the execution client
+// failed to answer. Notifications are coalesced until they are received.
+func (s *EngineClient[
+	_, _,
+]) Reconnected() <-chan struct{} {
+	return s.reconnected
+}
+
+// Start the engine client. It blocks until the primary execution client is
+// reachable, the fallback execution clients are connected in the background.
 func (s *EngineClient[
 	_, _,
 ]) Start(
 	ctx context.Context,
 ) error {
-	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
+	if err := s.startRecorder(); err != nil {
+		return err
+	}
+
+	if s.usesHTTP() {
 		// If we are dialing with HTTP(S), start the JWT refresh loop.
 		defer func() {
-			if s.jwtSecret == nil {
+			if s.auth == nil {
 				s.logger.Warn(
 					"JWT secret not provided for http(s) connection" +
 						" - please verify your configuration settings",
@@ -115,14 +157,19 @@ func (s *EngineClient[
 		}()
 	}
 
+	primary := s.endpoints[0]
 	s.logger.Info(
 		"Initializing connection to the execution client...",
-		"dial_url", s.cfg.RPCDialURL.String(),
+		"dial_url", primary.url.String(),
 	)
 
+	if len(s.endpoints) > 1 {
+		go s.fallbackConnectionLoop(ctx)
+	}
+
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
-	if err := s.initializeConnection(ctx); err == nil {
+	if err := s.initializeConnection(ctx, primary); err == nil {
 		return nil
 	}
 
@@ -136,9 +183,9 @@ func (s *EngineClient[
 		case <-ticker.C:
 			s.logger.Info(
 				"Waiting for execution client to start... 🍺🕔",
-				"dial_url", s.cfg.RPCDialURL,
+				"dial_url", primary.url,
 			)
-			if err := s.initializeConnection(ctx); err != nil {
+			if err := s.initializeConnection(ctx, primary); err != nil {
 				continue
 			}
 			return nil
@@ -146,16 +193,54 @@ func (s *EngineClient[
 	}
 }
 
+// fallbackConnectionLoop initializes the connections to the fallback
+// execution clients, retrying until all of them are connected.
+func (s *EngineClient[
+	_, _,
+]) fallbackConnectionLoop(
+	ctx context.Context,
+) {
+	ticker := time.NewTicker(s.cfg.RPCStartupCheckInterval)
+	defer ticker.Stop()
+	for {
+		pending := 0
+		for _, ep := range s.endpoints[1:] {
+			if ep.isInitialized() {
+				continue
+			}
+			if err := s.initializeConnection(ctx, ep); err != nil {
+				s.logger.Debug(
+					"Waiting for fallback execution client",
+					"endpoint", ep.name,
+					"dial_url", ep.url,
+					"err", err,
+				)
+				pending++
+			}
+		}
+		if pending == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// setupConnection dials the execution client and
+// initializeConnection dials the execution client and
 // ensures the chain ID is correct.
 func (s *EngineClient[
-	_, _,
+	ExecutionPayloadT, _,
 ]) initializeConnection(
 	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
 ) error {
 	var (
 		err     error
@@ -163,18 +248,18 @@ func (s *EngineClient[
 	)
 
 	defer func() {
-		if err != nil {
-			s.Client.Close()
+		if client := ep.getClient(); err != nil && client != nil {
+			client.Close()
 		}
 	}()
 
 	// Dial the execution client.
-	if err = s.dialExecutionRPCClient(ctx); err != nil {
+	if err = s.dialExecutionRPCClient(ctx, ep); err != nil {
 		return err
 	}
 
 	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	chainID, err = ep.getClient().ChainID(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
@@ -196,8 +281,10 @@ func (s *EngineClient[
 	// Log the chain ID.
 	s.logger.Info(
 		"Connected to execution client 🔌",
+		"endpoint",
+		ep.name,
 		"dial_url",
-		s.cfg.RPCDialURL.String(),
+		ep.url.String(),
 		"chain_id",
 		chainID.Uint64(),
 		"required_chain_id",
@@ -205,13 +292,25 @@ func (s *EngineClient[
 	)
 
 	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
+	if _, err = s.exchangeCapabilities(ctx, ep); err != nil {
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+	ep.setInitialized()
+	s.notifyReconnected()
 	return nil
 }
 
+// notifyReconnected notifies the reconnected channel without blocking.
+func (s *EngineClient[
+	_, _,
+]) notifyReconnected() {
+	select {
+	case s.reconnected <- struct{}{}:
+	default:
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Dialing                                  */
 /* -------------------------------------------------------------------------- */
@@ -221,6 +320,7 @@ func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) dialExecutionRPCClient(
 	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
 ) error {
 	var (
 		client *rpc.Client
@@ -229,40 +329,98 @@ func (s *EngineClient[
 
 	// Dial the execution client based on the URL scheme.
 	switch {
-	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
-		// Build an http.Header with the JWT token attached.
-		if s.jwtSecret != nil {
-			var header http.Header
-			if header, err = s.buildJWTHeader(); err != nil {
-				return err
-			}
-			if client, err = rpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), rpc.WithHeaders(header),
-			); err != nil {
-				return err
-			}
-		} else {
-			if client, err = rpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
-				return err
-			}
+	case ep.url.IsHTTP(), ep.url.IsHTTPS():
+		var (
+			opts      []rpc.ClientOption
+			transport = http.DefaultTransport
+		)
+		// Record the exchanges with the endpoint if enabled.
+		if s.recorder != nil {
+			transport = s.recorder.Transport(ep.name, transport)
+		}
+		// Attach a JWT token to every request.
+		if s.auth != nil {
+			transport = &authTransport{auth: s.auth, next: transport}
+		}
+		if transport != http.DefaultTransport {
+			opts = append(opts, rpc.WithHTTPClient(
+				&http.Client{Transport: transport},
+			))
+		}
+		if client, err = rpc.DialOptions(
+			ctx, ep.url.String(), opts...,
+		); err != nil {
+			return err
 		}
-	case s.cfg.RPCDialURL.IsIPC():
+	case ep.url.IsIPC():
 		if client, err = rpc.DialIPC(
-			ctx, s.cfg.RPCDialURL.Path); err != nil {
+			ctx, ep.url.Path); err != nil {
 			s.logger.Error("failed to dial IPC", "err", err)
 			return err
 		}
 	default:
 		return errors.Newf(
 			"no known transport for URL scheme %q",
-			s.cfg.RPCDialURL.Scheme,
+			ep.url.Scheme,
 		)
 	}
 
 	// Refresh the execution client with the new client.
-	s.Eth1Client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
-		client,
+	eth1Client, err := ethclient.NewFromRPCClient[ExecutionPayloadT](client)
+	if err != nil {
+		return err
+	}
+	ep.setClient(eth1Client)
+
+	// The eth namespace is always served by the primary endpoint.
+	if ep == s.endpoints[0] {
+		s.Eth1Client = eth1Client
+	}
+	return nil
+}
+
+// startRecorder opens the recording file if recording of the exchanges with
+// the execution clients is enabled.
+func (s *EngineClient[
+	_, _,
+]) startRecorder() error {
+	if s.cfg.RecordPath == "" {
+		return nil
+	}
+
+	var err error
+	if s.recorder, err = recorder.New(
+		s.logger,
+		s.cfg.RecordPath,
+		s.cfg.RecordMaxFileSize,
+		s.cfg.RecordMaxFiles,
+	); err != nil {
+		return err
+	}
+
+	s.logger.Info(
+		"Recording execution client exchanges 📼",
+		"path", s.cfg.RecordPath,
 	)
-	return err
+	for _, ep := range s.endpoints {
+		if !(ep.url.IsHTTP() || ep.url.IsHTTPS()) {
+			s.logger.Warn(
+				"Only HTTP(S) exchanges are recorded",
+				"endpoint", ep.name,
+			)
+		}
+	}
+	return nil
+}
+
+// usesHTTP returns true if any of the endpoints is dialed over HTTP(S).
+func (s *EngineClient[
+	_, _,
+]) usesHTTP() bool {
+	for _, ep := range s.endpoints {
+		if ep.url.IsHTTP() || ep.url.IsHTTPS() {
+			return true
+		}
+	}
+	return false
 }
@@ -32,6 +32,9 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultJWTGracePeriod          = 5 * time.Minute
+	defaultRecordMaxFileSize       = 64 << 20
+	defaultRecordMaxFiles          = 5
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -42,11 +45,17 @@ func DefaultConfig() Config {
 	dialURL, _ := url.NewFromRaw(defaultDialURL)
 	return Config{
 		RPCDialURL:              dialURL,
+		RPCFallbackDialURLs:     []*url.ConnectionURL{},
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		JWTGracePeriod:          defaultJWTGracePeriod,
+		JWTID:                   "",
+		RecordPath:              "",
+		RecordMaxFileSize:       defaultRecordMaxFileSize,
+		RecordMaxFiles:          defaultRecordMaxFiles,
 	}
 }
 
@@ -56,6 +65,9 @@ func DefaultConfig() Config {
 type Config struct {
 	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// RPCFallbackDialURLs are the urls of additional execution clients that
+	// are used, in order, when the primary endpoint is unhealthy.
+	RPCFallbackDialURLs []*url.ConnectionURL `mapstructure:"rpc-fallback-dial-urls"`
 	// RPCRetries is the number of retries before shutting down consensus
 	// client.
 	RPCRetries uint64 `mapstructure:"rpc-retries"`
@@ -63,8 +75,23 @@ type Config struct {
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
-	// JWTRefreshInterval is the Interval for the JWT refresh.
+	// RPCJWTRefreshInterval is the interval at which the JWT secret file is
+	// checked for changes.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// JWTGracePeriod is how long the previous JWT secret is still tried
+	// after the secret is rotated.
+	JWTGracePeriod time.Duration `mapstructure:"jwt-grace-period"`
+	// JWTID is sent as the "id" claim of the JWT tokens so that execution
+	// clients can identify the node. The claim is omitted if empty.
+	JWTID string `mapstructure:"jwt-id"`
+	// RecordPath is the path of the file that every JSON-RPC exchange with
+	// the execution clients is recorded to. Recording is disabled if empty.
+	RecordPath string `mapstructure:"record-path"`
+	// RecordMaxFileSize is the size in bytes after which the recording file
+	// is rotated.
+	RecordMaxFileSize uint64 `mapstructure:"record-max-file-size"`
+	// RecordMaxFiles is the number of rotated recording files to keep.
+	RecordMaxFiles uint64 `mapstructure:"record-max-files"`
 }
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+const (
+	// maxConsecutiveFailures is the number of consecutive failed requests
+	// after which an endpoint is considered unhealthy.
+	maxConsecutiveFailures = 3
+	// unhealthyCooldown is how long an unhealthy endpoint is skipped before
+	// it is given another chance.
+	unhealthyCooldown = 30 * time.Second
+	// latencyDecay is the weight given to the latest sample in the latency
+	// moving average.
+	latencyDecay = 0.2
+)
+
+// endpoint is a single execution client that the engine client talks to.
+type endpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	// name identifies the endpoint in logs and metrics.
+	name string
+	// url is the dial url of the endpoint.
+	url *url.ConnectionURL
+
+	mu sync.RWMutex
+	// client is the connection to the endpoint, nil until it is dialed.
+	client *ethclient.Eth1Client[ExecutionPayloadT]
+	// initialized is true once the chain ID and capabilities of the
+	// endpoint have been verified.
+	initialized bool
+	// latency is the exponential moving average of the request latency.
+	latency time.Duration
+	// consecutiveFailures is the number of failed requests since the last
+	// successful one.
+	consecutiveFailures uint64
+	// lastFailure is the time of the most recent failed request.
+	lastFailure time.Time
+}
+
+// newEndpoints creates the endpoints for the given configuration, with the
+// primary endpoint first, followed by the fallbacks in configured order.
+func newEndpoints[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](cfg *Config) []*endpoint[ExecutionPayloadT] {
+	endpoints := make(
+		[]*endpoint[ExecutionPayloadT], 0, 1+len(cfg.RPCFallbackDialURLs),
+	)
+	endpoints = append(endpoints, &endpoint[ExecutionPayloadT]{
+		name: "primary",
+		url:  cfg.RPCDialURL,
+	})
+	for i, u := range cfg.RPCFallbackDialURLs {
+		endpoints = append(endpoints, &endpoint[ExecutionPayloadT]{
+			name: "fallback-" + strconv.Itoa(i+1),
+			url:  u,
+		})
+	}
+	return endpoints
+}
+
+// getClient returns the current connection to the endpoint.
+//
+//nolint:lll // generic receiver and result.
+func (e *endpoint[ExecutionPayloadT]) getClient() *ethclient.Eth1Client[ExecutionPayloadT] {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.client
+}
+
+// setClient replaces the connection to the endpoint.
+func (e *endpoint[ExecutionPayloadT]) setClient(
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.client = client
+}
+
+// isInitialized returns true if the endpoint is ready to serve requests.
+func (e *endpoint[_]) isInitialized() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.initialized && e.client != nil
+}
+
+// setInitialized marks the endpoint as ready to serve requests.
+func (e *endpoint[_]) setInitialized() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.initialized = true
+}
+
+// recordSuccess records a request that was answered by the endpoint. It
+// returns true if the endpoint recovered, as it was considered unhealthy.
+func (e *endpoint[_]) recordSuccess(latency time.Duration) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	recovered := e.consecutiveFailures >= maxConsecutiveFailures
+	e.consecutiveFailures = 0
+	if e.latency == 0 {
+		e.latency = latency
+		return recovered
+	}
+	e.latency = time.Duration(
+		latencyDecay*float64(latency) + (1-latencyDecay)*float64(e.latency),
+	)
+	return recovered
+}
+
+// recordFailure records a request that the endpoint failed to answer.
+func (e *endpoint[_]) recordFailure() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.consecutiveFailures++
+	e.lastFailure = time.Now()
+}
+
+// isHealthy returns true if the endpoint should be used for requests. An
+// unhealthy endpoint becomes eligible again once its cooldown has passed.
+func (e *endpoint[_]) isHealthy() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.consecutiveFailures < maxConsecutiveFailures ||
+		time.Since(e.lastFailure) > unhealthyCooldown
+}
+
+// getLatency returns the moving average of the request latency.
+func (e *endpoint[_]) getLatency() time.Duration {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.latency
+}
@@ -30,6 +30,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -37,7 +39,8 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadVX method via JSON-RPC.
+// NewPayload calls the engine_newPayloadVX method via JSON-RPC. The call
+// fails over to the next endpoint if the current one does not answer.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) NewPayload(
@@ -47,20 +50,25 @@ func (s *EngineClient[
 	parentBeaconBlockRoot *common.Root,
 ) (*gethprimitives.ExecutionHash, error) {
 	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		startTime = time.Now()
+		result    *engineprimitives.PayloadStatusV1
 	)
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
 
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Eth1Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
-	)
-	if err != nil {
+	err := s.callWithFailover(ctx, "new_payload", func(
+		cctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = ep.getClient().NewPayload(
+			cctx, payload, versionedHashes, parentBeaconBlockRoot,
+		)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
 		}
+		return err
+	})
+	if err != nil {
 		return nil, s.handleRPCError(err)
 	} else if result == nil {
 		return nil, engineerrors.ErrNilPayloadStatus
@@ -83,21 +91,36 @@ func (s *EngineClient[
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
 
+// primaryVerdictTimeout is how long a valid answer of a fallback to a
+// forkchoice update waits for the verdict of the healthy primary.
+const primaryVerdictTimeout = 250 * time.Millisecond
+
+// forkchoiceResult is the answer of a single endpoint to a forkchoice
+// update.
+type forkchoiceResult struct {
+	// index is the position of the endpoint in failover order.
+	index    int
+	response *engineprimitives.ForkchoiceResponseV1
+	err      error
+}
+
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
+// The update is sent to every healthy endpoint so that standby execution
+// clients follow the chain. The verdict of the primary prevails while it is
+// healthy, a fallback considering the head valid being waited on for at most
+// primaryVerdictTimeout such that a hung primary does not hold up the
+// caller. If no endpoint considers the head valid, the answer of the first
+// endpoint in failover order that did answer is returned.
 func (s *EngineClient[
-	_, PayloadAttributesT,
+	ExecutionPayloadT, PayloadAttributesT,
 ]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs PayloadAttributesT,
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *gethprimitives.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
@@ -109,32 +132,165 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Eth1Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
+	// Fan the update out to every healthy endpoint. The updates outlive the
+	// caller, so that slow standby endpoints still follow the chain once an
+	// answer has been returned.
+	var (
+		endpoints = s.healthyEndpoints()
+		results   = make(chan forkchoiceResult, len(endpoints))
+		group     = newPayloadIDGroup()
+		uctx      = context.WithoutCancel(ctx)
 	)
+	for i, ep := range endpoints {
+		go func(i int, ep *endpoint[ExecutionPayloadT]) {
+			res := forkchoiceResult{index: i}
+			res.err = s.callEndpoint(uctx, ep, func(
+				cctx context.Context, ep *endpoint[ExecutionPayloadT],
+			) error {
+				var err error
+				res.response, err = ep.getClient().ForkchoiceUpdated(
+					cctx, state, attrs, forkVersion,
+				)
+				if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
+					s.metrics.incrementForkchoiceUpdateTimeout()
+				}
+				return err
+			})
+			if res.err == nil && res.response != nil &&
+				res.response.PayloadID != nil {
+				group.set(ep.name, *res.response.PayloadID)
+			}
+			results <- res
+		}(i, ep)
+	}
 
-	if err != nil {
-		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
-			s.metrics.incrementForkchoiceUpdateTimeout()
+	result, err := s.awaitForkchoiceResult(ctx, endpoints, results)
+	if result == nil {
+		if errors.IsAny(
+			err, ErrNoHealthyEndpoint, ErrForkchoiceDisagreement,
+			engineerrors.ErrNilForkchoiceResponse,
+		) {
+			return nil, nil, err
 		}
 		return nil, nil, s.handleRPCError(err)
-	} else if result == nil {
-		return nil, nil, engineerrors.ErrNilForkchoiceResponse
 	}
 
 	latestValidHash, err := processPayloadStatusResult((&result.PayloadStatus))
 	if err != nil {
 		return nil, latestValidHash, err
 	}
+	if result.PayloadID != nil {
+		s.payloadIDs.add(*result.PayloadID, group)
+	}
 	return result.PayloadID, latestValidHash, nil
 }
 
+// awaitForkchoiceResult returns the answer to a forkchoice update that
+// considers the head valid. While the primary is healthy, a valid answer of
+// a fallback is only returned once the primary failed to answer, did not
+// answer within primaryVerdictTimeout or is still syncing, and the primary
+// rejecting the head fails the update. Otherwise, once every endpoint
+// answered, it returns the answer of the first endpoint in failover order
+// that answered, or the last error if none did.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) awaitForkchoiceResult(
+	ctx context.Context,
+	endpoints []*endpoint[ExecutionPayloadT],
+	results <-chan forkchoiceResult,
+) (*engineprimitives.ForkchoiceResponseV1, error) {
+	var (
+		valid         *engineprimitives.ForkchoiceResponseV1
+		fallback      *engineprimitives.ForkchoiceResponseV1
+		fallbackIndex = len(endpoints)
+		err           = ErrNoHealthyEndpoint
+		awaitPrimary  = s.isPrimary(endpoints)
+		verdict       <-chan time.Time
+	)
+	for range endpoints {
+		var res forkchoiceResult
+		select {
+		case res = <-results:
+		case <-verdict:
+			s.logger.Warn(
+				"Primary execution client did not answer forkchoice update "+
+					"in time, following fallback",
+				"timeout", primaryVerdictTimeout,
+			)
+			return valid, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if res.err == nil && res.response == nil {
+			res.err = engineerrors.ErrNilForkchoiceResponse
+		}
+		if res.err != nil {
+			err = res.err
+			s.logger.Warn(
+				"Execution client failed forkchoice update",
+				"endpoint", endpoints[res.index].name,
+				"err", res.err,
+			)
+		}
+
+		if awaitPrimary && res.index == 0 {
+			awaitPrimary = false
+			if res.err == nil && isRejected(res.response.PayloadStatus) {
+				if valid != nil {
+					return nil, errors.Wrapf(
+						ErrForkchoiceDisagreement, "primary status %s",
+						res.response.PayloadStatus.Status,
+					)
+				}
+				return res.response, nil
+			}
+			if valid != nil && (res.err != nil || res.response.PayloadStatus.
+				Status != engineprimitives.PayloadStatusValid) {
+				return valid, nil
+			}
+		}
+
+		switch {
+		case res.err != nil:
+		case res.response.PayloadStatus.Status !=
+			engineprimitives.PayloadStatusValid:
+			if res.index < fallbackIndex {
+				fallback, fallbackIndex = res.response, res.index
+			}
+		case !awaitPrimary:
+			return res.response, nil
+		case valid == nil:
+			valid, verdict = res.response, time.After(primaryVerdictTimeout)
+		}
+	}
+	if valid != nil {
+		return valid, nil
+	}
+	return fallback, err
+}
+
+// isRejected returns true if the payload status rejects the head, as
+// opposed to accepting it or not having validated it yet.
+func isRejected(status engineprimitives.PayloadStatusV1) bool {
+	switch status.Status {
+	case engineprimitives.PayloadStatusValid,
+		engineprimitives.PayloadStatusSyncing,
+		engineprimitives.PayloadStatusAccepted:
+		return false
+	default:
+		return true
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                 GetPayload                                 */
 /* -------------------------------------------------------------------------- */
 
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC. It returns
-// the execution data as well as the blobs bundle.
+// the execution data as well as the blobs bundle. The call fails over to the
+// next endpoint that is building the payload if the current one does not
+// answer.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) GetPayload(
@@ -143,19 +299,30 @@ func (s *EngineClient[
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		startTime = time.Now()
+		result    engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
 	)
 	defer s.metrics.measureGetPayloadDuration(startTime)
-	defer cancel()
 
 	// Call and check for errors.
-	result, err := s.Eth1Client.GetPayload(cctx, payloadID, forkVersion)
-	switch {
-	case err != nil:
+	err := s.callWithFailover(ctx, "get_payload", func(
+		cctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) error {
+		id, ok := s.payloadIDs.resolve(payloadID, ep.name)
+		if !ok {
+			return ErrPayloadNotBuilding
+		}
+		var err error
+		result, err = ep.getClient().GetPayload(cctx, id, forkVersion)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
 		}
+		return err
+	})
+	switch {
+	case errors.Is(err, ErrPayloadNotBuilding):
+		return result, err
+	case err != nil:
 		return result, s.handleRPCError(err)
 	case result == nil:
 		return result, engineerrors.ErrNilExecutionPayloadEnvelope
@@ -167,14 +334,156 @@ func (s *EngineClient[
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByHash calls the engine_getPayloadBodiesByHashV1 method via
+// JSON-RPC. The bodies are returned in the order of the given hashes, with a
+// nil body for every block unknown to the execution client.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) GetPayloadBodiesByHash(
+	ctx context.Context,
+	hashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	var result []*engineprimitives.ExecutionPayloadBodyV1
+	if err := s.callWithFailover(ctx, "get_payload_bodies_by_hash", func(
+		cctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = ep.getClient().GetPayloadBodiesByHashV1(cctx, hashes)
+		return err
+	}); err != nil {
+		return nil, s.handleRPCError(err)
+	}
+
+	if len(result) != len(hashes) {
+		return nil, errors.Wrapf(
+			ErrUnexpectedPayloadBodiesLength,
+			"expected %d, got %d", len(hashes), len(result),
+		)
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRange calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC. The bodies of the count blocks starting at the given block
+// number are returned, with a nil body for every block unknown to the
+// execution client. Fewer bodies are returned if the range goes past the
+// head of the execution chain.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start math.U64,
+	count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	var result []*engineprimitives.ExecutionPayloadBodyV1
+	if err := s.callWithFailover(ctx, "get_payload_bodies_by_range", func(
+		cctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = ep.getClient().GetPayloadBodiesByRangeV1(
+			cctx, start, count,
+		)
+		return err
+	}); err != nil {
+		return nil, s.handleRPCError(err)
+	}
+
+	if uint64(len(result)) > count.Unwrap() {
+		return nil, errors.Wrapf(
+			ErrUnexpectedPayloadBodiesLength,
+			"expected at most %d, got %d", count, len(result),
+		)
+	}
+	return result, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  GetBlobs                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetBlobs calls the engine_getBlobsV1 method via JSON-RPC. The blobs are
+// returned in the order of the given versioned hashes, with a nil blob for
+// every blob missing from the blob pool of the execution client.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) GetBlobs(
+	ctx context.Context,
+	versionedHashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof], error) {
+	var (
+		startTime = time.Now()
+		result    []*engineprimitives.BlobAndProofV1[
+			eip4844.Blob, eip4844.KZGProof,
+		]
+	)
+	defer s.metrics.measureGetBlobsDuration(startTime)
+
+	if err := s.callWithFailover(ctx, "get_blobs", func(
+		cctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = ep.getClient().GetBlobsV1(cctx, versionedHashes)
+		return err
+	}); err != nil {
+		return nil, s.handleRPCError(err)
+	}
+
+	if len(result) != len(versionedHashes) {
+		return nil, errors.Wrapf(
+			ErrUnexpectedBlobsLength,
+			"expected %d, got %d", len(versionedHashes), len(result),
+		)
+	}
+	return result, nil
+}
+
+// GetClientVersionV1 calls the engine_getClientVersionV1 method via JSON-RPC,
+// returning the client versions of the execution client serving the
+// requests.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) GetClientVersionV1(
+	ctx context.Context,
+) ([]engineprimitives.ClientVersionV1, error) {
+	var result []engineprimitives.ClientVersionV1
+	if err := s.callWithFailover(ctx, "get_client_version", func(
+		cctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = ep.getClient().GetClientVersionV1(
+			cctx, s.clientVersion,
+		)
+		return err
+	}); err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	return result, nil
+}
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// JSON-RPC on the primary endpoint.
 func (s *EngineClient[
 	_, _,
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
+	return s.exchangeCapabilities(ctx, s.endpoints[0])
+}
+
+// exchangeCapabilities calls the engine_exchangeCapabilities method via
+// JSON-RPC on the given endpoint. Only the capabilities of the primary
+// endpoint are recorded.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) exchangeCapabilities(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+) ([]string, error) {
+	result, err := ep.getClient().ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -182,17 +491,26 @@ func (s *EngineClient[
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
-		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		s.logger.Info(
+			"Exchanged capability",
+			"capability", capability,
+			"endpoint", ep.name,
+		)
+		capabilities[capability] = struct{}{}
+	}
+	if ep == s.endpoints[0] {
+		s.capabilities = capabilities
 	}
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if _, exists := capabilities[capability]; !exists {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
 				"unsupported_capability", capability,
+				"endpoint", ep.name,
 			)
 		}
 	}
@@ -54,6 +54,30 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrNoHealthyEndpoint is returned when none of the execution clients
+	// is healthy.
+	ErrNoHealthyEndpoint = errors.New("no healthy execution client")
+
+	// ErrForkchoiceDisagreement is returned when the primary execution
+	// client rejects a head that a fallback considers valid.
+	ErrForkchoiceDisagreement = errors.New(
+		"primary execution client rejected head that a fallback accepted",
+	)
+
+	// ErrPayloadNotBuilding is returned when none of the execution clients
+	// is building the requested payload.
+	ErrPayloadNotBuilding = errors.New("payload is not being built")
+
+	// ErrUnexpectedPayloadBodiesLength is returned when the execution client
+	// returns a different number of payload bodies than requested.
+	ErrUnexpectedPayloadBodiesLength = errors.New(
+		"unexpected number of payload bodies",
+	)
+
+	// ErrUnexpectedBlobsLength is returned when the execution client returns
+	// a different number of blobs than requested.
+	ErrUnexpectedBlobsLength = errors.New("unexpected number of blobs")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -59,6 +59,23 @@ func (s *EngineClient[
 	return header, nil
 }
 
+// CanonicalHeaderByNumber retrieves the canonical block header by its number
+// from the execution client, bypassing and refreshing the header cache so
+// that the result reflects reorgs.
+func (s *EngineClient[
+	_, _,
+]) CanonicalHeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*gethprimitives.Header, error) {
+	header, err := s.Client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	s.engineCache.AddHeader(header)
+	return header, nil
+}
+
 // HeaderByHash retrieves the block header by its hash.
 func (s *EngineClient[
 	_, _,
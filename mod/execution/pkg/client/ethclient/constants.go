@@ -27,6 +27,9 @@ func BeaconKitSupportedCapabilities() []string {
 		NewPayloadMethodV3,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		GetPayloadBodiesByHashMethodV1,
+		GetPayloadBodiesByRangeMethodV1,
+		GetBlobsMethodV1,
 		GetClientVersionV1,
 	}
 }
@@ -39,6 +42,15 @@ const (
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// GetPayloadBodiesByHashMethodV1 for retrieving payload bodies by their
+	// block hashes.
+	GetPayloadBodiesByHashMethodV1 = "engine_getPayloadBodiesByHashV1"
+	// GetPayloadBodiesByRangeMethodV1 for retrieving payload bodies by a
+	// range of block numbers.
+	GetPayloadBodiesByRangeMethodV1 = "engine_getPayloadBodiesByRangeV1"
+	// GetBlobsMethodV1 for retrieving blobs from the blob pool by their
+	// versioned hashes.
+	GetBlobsMethodV1 = "engine_getBlobsV1"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
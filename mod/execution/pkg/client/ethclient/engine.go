@@ -27,6 +27,7 @@ import (
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -161,6 +162,65 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByHashV1 calls the engine_getPayloadBodiesByHashV1 method
+// via JSON-RPC. The bodies are returned in the order of the given hashes, a
+// nil body is returned for every unknown block.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadBodiesByHashV1(
+	ctx context.Context,
+	hashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.Client.Client().CallContext(
+		ctx, &result, GetPayloadBodiesByHashMethodV1, hashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRangeV1 calls the engine_getPayloadBodiesByRangeV1
+// method via JSON-RPC. The bodies of the count blocks starting at the given
+// block number are returned, a nil body is returned for every unknown block.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadBodiesByRangeV1(
+	ctx context.Context,
+	start math.U64,
+	count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.Client.Client().CallContext(
+		ctx, &result, GetPayloadBodiesByRangeMethodV1, start, count,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  GetBlobs                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetBlobsV1 calls the engine_getBlobsV1 method via JSON-RPC. The blobs are
+// returned in the order of the given versioned hashes, a nil blob is
+// returned for every blob missing from the blob pool.
+func (s *Eth1Client[ExecutionPayloadT]) GetBlobsV1(
+	ctx context.Context,
+	versionedHashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof], error) {
+	result := make(
+		[]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof], 0,
+	)
+	if err := s.Client.Client().CallContext(
+		ctx, &result, GetBlobsMethodV1, versionedHashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
@@ -180,13 +240,15 @@ func (s *Eth1Client[ExecutionPayloadT]) ExchangeCapabilities(
 	return result, nil
 }
 
-// GetClientVersionV1 calls the engine_getClientVersionV1 method via JSON-RPC.
+// GetClientVersionV1 calls the engine_getClientVersionV1 method via JSON-RPC,
+// identifying the consensus client with the given client version.
 func (s *Eth1Client[ExecutionPayloadT]) GetClientVersionV1(
 	ctx context.Context,
+	clientVersion engineprimitives.ClientVersionV1,
 ) ([]engineprimitives.ClientVersionV1, error) {
 	result := make([]engineprimitives.ClientVersionV1, 0)
 	if err := s.Client.Client().CallContext(
-		ctx, &result, GetClientVersionV1, nil,
+		ctx, &result, GetClientVersionV1, clientVersion,
 	); err != nil {
 		return nil, err
 	}
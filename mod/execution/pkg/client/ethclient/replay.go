@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ethclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+// replayURL is the placeholder url of the replay backend, requests never
+// leave the process.
+const replayURL = "http://replay.invalid"
+
+// NewFromRecording creates a new Ethereum 1 client that answers every call
+// with the responses of the given recording instead of an execution client.
+func NewFromRecording[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](
+	ctx context.Context,
+	records []*recorder.Record,
+) (*Eth1Client[ExecutionPayloadT], error) {
+	rpcClient, err := rpc.DialOptions(
+		ctx, replayURL, rpc.WithHTTPClient(&http.Client{
+			Transport: recorder.NewReplayer(records),
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromRPCClient[ExecutionPayloadT](rpcClient)
+}
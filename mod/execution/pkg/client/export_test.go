@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import "time"
+
+// InitializedEndpoints returns the number of endpoints ready to serve
+// requests.
+func (s *EngineClient[_, _]) InitializedEndpoints() int {
+	n := 0
+	for _, ep := range s.endpoints {
+		if ep.isInitialized() {
+			n++
+		}
+	}
+	return n
+}
+
+// OrderedEndpointNames returns the names of the endpoints in the order in
+// which they are tried.
+func (s *EngineClient[_, _]) OrderedEndpointNames() []string {
+	endpoints := s.orderedEndpoints()
+	names := make([]string, 0, len(endpoints))
+	for _, ep := range endpoints {
+		names = append(names, ep.name)
+	}
+	return names
+}
+
+// RecordEndpointSuccess records a request answered by the named endpoint.
+func (s *EngineClient[_, _]) RecordEndpointSuccess(
+	name string,
+	latency time.Duration,
+) bool {
+	for _, ep := range s.endpoints {
+		if ep.name == name {
+			return ep.recordSuccess(latency)
+		}
+	}
+	return false
+}
+
+// RecordEndpointFailure records a request the named endpoint failed to
+// answer.
+func (s *EngineClient[_, _]) RecordEndpointFailure(name string) {
+	for _, ep := range s.endpoints {
+		if ep.name == name {
+			ep.recordFailure()
+		}
+	}
+}
+
+// EndpointLatency returns the moving average of the request latency of the
+// named endpoint.
+func (s *EngineClient[_, _]) EndpointLatency(name string) time.Duration {
+	for _, ep := range s.endpoints {
+		if ep.name == name {
+			return ep.getLatency()
+		}
+	}
+	return 0
+}
+
+// ReloadJWTSecret reloads the JWT secret file as the refresh loop does.
+func (s *EngineClient[_, _]) ReloadJWTSecret() {
+	s.reloadJWTSecret()
+}
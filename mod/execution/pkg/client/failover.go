@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+)
+
+// payloadIDCacheSize is the number of forkchoice updates for which the
+// payload IDs of every endpoint are remembered.
+const payloadIDCacheSize = 64
+
+// orderedEndpoints returns the initialized endpoints in the order in which
+// they should be tried. Healthy endpoints come first, with the primary
+// leading while it is healthy and the fallbacks ranked by latency. Unhealthy
+// endpoints are only used as a last resort.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) orderedEndpoints() []*endpoint[ExecutionPayloadT] {
+	healthy, unhealthy := s.rankEndpoints()
+	return append(healthy, unhealthy...)
+}
+
+// healthyEndpoints returns the initialized endpoints that are healthy, in
+// the order in which they should be tried.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) healthyEndpoints() []*endpoint[ExecutionPayloadT] {
+	healthy, _ := s.rankEndpoints()
+	return healthy
+}
+
+// rankEndpoints splits the initialized endpoints into the healthy and the
+// unhealthy ones. The healthy endpoints are led by the primary if it is
+// among them, followed by the fallbacks ranked by latency.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) rankEndpoints() (
+	[]*endpoint[ExecutionPayloadT], []*endpoint[ExecutionPayloadT],
+) {
+	var healthy, unhealthy []*endpoint[ExecutionPayloadT]
+	for _, ep := range s.endpoints {
+		switch {
+		case !ep.isInitialized():
+			continue
+		case ep.isHealthy():
+			healthy = append(healthy, ep)
+		default:
+			unhealthy = append(unhealthy, ep)
+		}
+	}
+
+	fallbacks := healthy
+	if s.isPrimary(healthy) {
+		fallbacks = healthy[1:]
+	}
+	slices.SortStableFunc(fallbacks, func(a, b *endpoint[ExecutionPayloadT]) int {
+		return cmp.Compare(a.getLatency(), b.getLatency())
+	})
+	return healthy, unhealthy
+}
+
+// isPrimary returns true if the given endpoints are led by the primary.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) isPrimary(endpoints []*endpoint[ExecutionPayloadT]) bool {
+	return len(endpoints) > 0 && endpoints[0] == s.endpoints[0]
+}
+
+// callWithFailover calls the given function against the endpoints in order,
+// moving on to the next endpoint whenever the current one fails to answer.
+// Errors returned by an endpoint that did answer are returned as is.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) callWithFailover(
+	ctx context.Context,
+	method string,
+	call func(context.Context, *endpoint[ExecutionPayloadT]) error,
+) error {
+	err := ErrNotStarted
+	for _, ep := range s.orderedEndpoints() {
+		err = s.callEndpoint(ctx, ep, call)
+		switch {
+		case err == nil || ctx.Err() != nil:
+			return err
+		case errors.Is(err, ErrPayloadNotBuilding):
+			continue
+		case !isEndpointFailure(err):
+			return err
+		}
+		s.logger.Warn(
+			"Execution client request failed, trying next endpoint",
+			"method", method,
+			"endpoint", ep.name,
+			"err", err,
+		)
+		s.metrics.incrementFailover(method, ep.name)
+	}
+	return err
+}
+
+// callEndpoint calls the given function against a single endpoint and
+// records the outcome in the health of the endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) callEndpoint(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+	call func(context.Context, *endpoint[ExecutionPayloadT]) error,
+) error {
+	startTime := time.Now()
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	err := call(cctx, ep)
+	switch {
+	case errors.Is(err, ErrPayloadNotBuilding):
+		return err
+	case err != nil && isEndpointFailure(err):
+		ep.recordFailure()
+		s.metrics.incrementEndpointFailure(ep.name)
+		return err
+	}
+
+	if ep.recordSuccess(time.Since(startTime)) {
+		s.logger.Info(
+			"Execution client answering again 🔌",
+			"endpoint", ep.name,
+		)
+		s.notifyReconnected()
+	}
+	return err
+}
+
+// isEndpointFailure returns true if the error indicates that the endpoint
+// did not answer, as opposed to answering with a JSON-RPC error.
+func isEndpointFailure(err error) bool {
+	if errors.Is(err, engineerrors.ErrEngineAPITimeout) ||
+		http.IsTimeoutError(err) {
+		return true
+	}
+	var rpcErr jsonrpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// payloadIDGroup holds the payload IDs that each endpoint returned for the
+// same forkchoice update.
+type payloadIDGroup struct {
+	mu  sync.Mutex
+	ids map[string]engineprimitives.PayloadID
+}
+
+// newPayloadIDGroup creates a new, empty payloadIDGroup.
+func newPayloadIDGroup() *payloadIDGroup {
+	return &payloadIDGroup{
+		ids: make(map[string]engineprimitives.PayloadID),
+	}
+}
+
+// set records the payload ID returned by the given endpoint.
+func (g *payloadIDGroup) set(name string, id engineprimitives.PayloadID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.ids[name] = id
+}
+
+// get returns the payload ID returned by the given endpoint.
+func (g *payloadIDGroup) get(name string) (engineprimitives.PayloadID, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	id, ok := g.ids[name]
+	return id, ok
+}
+
+// payloadIDCache maps the payload ID handed out to callers to the payload
+// IDs of every endpoint, so that payloads can be retrieved from a fallback
+// endpoint.
+type payloadIDCache struct {
+	mu     sync.Mutex
+	groups map[engineprimitives.PayloadID]*payloadIDGroup
+	order  []engineprimitives.PayloadID
+}
+
+// newPayloadIDCache creates a new, empty payloadIDCache.
+func newPayloadIDCache() *payloadIDCache {
+	return &payloadIDCache{
+		groups: make(map[engineprimitives.PayloadID]*payloadIDGroup),
+		order:  make([]engineprimitives.PayloadID, 0, payloadIDCacheSize),
+	}
+}
+
+// add stores the group under the given payload ID, evicting the oldest
+// entry once the cache is full.
+func (c *payloadIDCache) add(
+	id engineprimitives.PayloadID,
+	group *payloadIDGroup,
+) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.groups[id]; !ok {
+		if len(c.order) == payloadIDCacheSize {
+			delete(c.groups, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, id)
+	}
+	c.groups[id] = group
+}
+
+// resolve returns the payload ID to use for the given endpoint. If nothing
+// is known about the payload ID it is assumed to be valid for every
+// endpoint.
+func (c *payloadIDCache) resolve(
+	id engineprimitives.PayloadID,
+	name string,
+) (engineprimitives.PayloadID, bool) {
+	c.mu.Lock()
+	group, ok := c.groups[id]
+	c.mu.Unlock()
+	if !ok {
+		return id, true
+	}
+	return group.get(name)
+}
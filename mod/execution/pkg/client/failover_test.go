@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	forkchoiceUpdated = "engine_forkchoiceUpdatedV3"
+	getPayload        = "engine_getPayloadV3"
+)
+
+// forkchoiceUpdate sends an empty forkchoice update through the client.
+func forkchoiceUpdate(
+	c *testEngineClient,
+) (*engineprimitives.PayloadID, error) {
+	id, _, err := c.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{},
+		nil,
+		version.Deneb,
+	)
+	return id, err
+}
+
+func TestForkchoiceUpdatedDoesNotWaitForHungPrimary(t *testing.T) {
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.hang(forkchoiceUpdated)
+	fallback.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000002",
+	))
+	c := newTestClient(t, nil, nil, primary, fallback)
+
+	start := time.Now()
+	id, err := forkchoiceUpdate(c)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadID{7: 0x2}, *id)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestForkchoiceUpdatedWaitsForValidAnswer(t *testing.T) {
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusSyncing, "0x0000000000000001",
+	))
+	fallback.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000002",
+	))
+	fallback.delay(forkchoiceUpdated, 100*time.Millisecond)
+	c := newTestClient(t, nil, nil, primary, fallback)
+
+	id, err := forkchoiceUpdate(c)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadID{7: 0x2}, *id)
+}
+
+func TestForkchoiceUpdatedPrefersPrimaryWithoutValidAnswer(t *testing.T) {
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusSyncing, "0x0000000000000001",
+	))
+	primary.delay(forkchoiceUpdated, 100*time.Millisecond)
+	fallback.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusInvalid, "0x0000000000000002",
+	))
+	c := newTestClient(t, nil, nil, primary, fallback)
+
+	_, err := forkchoiceUpdate(c)
+	require.ErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+}
+
+func TestForkchoiceUpdatedFailsOnPrimaryRejection(t *testing.T) {
+	// The fallback considers the head valid first, the healthy primary
+	// rejecting it shortly after prevails.
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusInvalid, "0x0000000000000001",
+	))
+	primary.delay(forkchoiceUpdated, 50*time.Millisecond)
+	fallback.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000002",
+	))
+	c := newTestClient(t, nil, nil, primary, fallback)
+
+	id, err := forkchoiceUpdate(c)
+	require.ErrorIs(t, err, client.ErrForkchoiceDisagreement)
+	require.Nil(t, id)
+
+	// A rejection of the primary answered first fails the update as well.
+	primary.delay(forkchoiceUpdated, 0)
+	fallback.delay(forkchoiceUpdated, 50*time.Millisecond)
+	_, err = forkchoiceUpdate(c)
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+}
+
+func TestForkchoiceUpdatedSkipsUnhealthyEndpoints(t *testing.T) {
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000001",
+	))
+	fallback.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000002",
+	))
+	c := newTestClient(t, nil, nil, primary, fallback)
+	for range 3 {
+		c.RecordEndpointFailure("fallback-1")
+	}
+
+	id, err := forkchoiceUpdate(c)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadID{7: 0x1}, *id)
+	require.Never(t, func() bool {
+		return len(fallback.received(forkchoiceUpdated)) > 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestGetPayloadUsesPayloadIDOfFallback(t *testing.T) {
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000001",
+	))
+	fallback.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000002",
+	))
+	// The fallback answers the update after the primary, such that the
+	// payload ID of the primary is handed to the caller.
+	fallback.delay(forkchoiceUpdated, 50*time.Millisecond)
+	primary.fail(getPayload)
+	fallback.set(getPayload, map[string]any{
+		"executionPayload": map[string]any{},
+		"blockValue":       "0x0",
+		"blobsBundle": map[string]any{
+			"commitments": []string{},
+			"proofs":      []string{},
+			"blobs":       []string{},
+		},
+	})
+	c := newTestClient(t, nil, nil, primary, fallback)
+
+	id, err := forkchoiceUpdate(c)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadID{7: 0x1}, *id)
+
+	// The payload is retrieved from the fallback, under its own payload ID,
+	// once it answered the update.
+	require.Eventually(t, func() bool {
+		_, err = c.GetPayload(context.Background(), *id, version.Deneb)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	requests := fallback.received(getPayload)
+	require.Len(t, requests, 1)
+	require.JSONEq(t, `"0x0000000000000002"`, string(requests[0].Params[0]))
+}
+
+func TestGetPayloadSkipsEndpointsNotBuilding(t *testing.T) {
+	primary, fallback := newStubEL(t, nil), newStubEL(t, nil)
+	primary.set(forkchoiceUpdated, forkchoiceResponse(
+		engineprimitives.PayloadStatusValid, "0x0000000000000001",
+	))
+	fallback.hang(forkchoiceUpdated)
+	primary.fail(getPayload)
+	c := newTestClient(t, nil, nil, primary, fallback)
+
+	id, err := forkchoiceUpdate(c)
+	require.NoError(t, err)
+
+	// The fallback never returned a payload ID, so it is not asked for the
+	// payload.
+	_, err = c.GetPayload(context.Background(), *id, version.Deneb)
+	require.Error(t, err)
+	require.Empty(t, fallback.received(getPayload))
+}
+
+func TestOrderedEndpoints(t *testing.T) {
+	c := newTestClient(
+		t, nil, nil, newStubEL(t, nil), newStubEL(t, nil), newStubEL(t, nil),
+	)
+	require.Equal(
+		t,
+		[]string{"primary", "fallback-1", "fallback-2"},
+		c.OrderedEndpointNames(),
+	)
+
+	// Fallbacks are ranked by latency, behind the primary.
+	c.RecordEndpointSuccess("primary", 500*time.Millisecond)
+	c.RecordEndpointSuccess("fallback-1", 200*time.Millisecond)
+	c.RecordEndpointSuccess("fallback-2", 100*time.Millisecond)
+	require.Equal(
+		t,
+		[]string{"primary", "fallback-2", "fallback-1"},
+		c.OrderedEndpointNames(),
+	)
+
+	// An unhealthy primary is only used as a last resort.
+	for range 3 {
+		c.RecordEndpointFailure("primary")
+	}
+	require.Equal(
+		t,
+		[]string{"fallback-2", "fallback-1", "primary"},
+		c.OrderedEndpointNames(),
+	)
+
+	// The primary leads again once it answers.
+	require.True(t, c.RecordEndpointSuccess("primary", time.Second))
+	require.Equal(
+		t,
+		[]string{"primary", "fallback-2", "fallback-1"},
+		c.OrderedEndpointNames(),
+	)
+}
+
+func TestEndpointHealth(t *testing.T) {
+	c := newTestClient(t, nil, nil, newStubEL(t, nil), newStubEL(t, nil))
+
+	// Failures below the threshold keep the endpoint healthy.
+	c.RecordEndpointFailure("primary")
+	c.RecordEndpointFailure("primary")
+	require.Equal(
+		t, []string{"primary", "fallback-1"}, c.OrderedEndpointNames(),
+	)
+	require.False(t, c.RecordEndpointSuccess("primary", 0))
+
+	// The latency is a moving average of the samples.
+	c.RecordEndpointSuccess("fallback-1", 100*time.Millisecond)
+	c.RecordEndpointSuccess("fallback-1", 200*time.Millisecond)
+	require.Equal(t, 120*time.Millisecond, c.EndpointLatency("fallback-1"))
+}
@@ -26,10 +26,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
-	"github.com/berachain/beacon-kit/mod/errors"
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
-	gjwt "github.com/golang-jwt/jwt/v5"
 )
 
 // createContextWithTimeout creates a context with a timeout and returns it
@@ -67,15 +64,3 @@ func processPayloadStatusResult(
 		return nil, engineerrors.ErrUnknownPayloadStatus
 	}
 }
-
-// buildSignedJWT builds a signed JWT from the provided JWT secret.
-func buildSignedJWT(s *jwt.Secret) (string, error) {
-	token := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
-		"iat": &gjwt.NumericDate{Time: time.Now()},
-	})
-	str, err := token.SignedString(s[:])
-	if err != nil {
-		return "", errors.Newf("failed to create JWT token: %w", err)
-	}
-	return str, nil
-}
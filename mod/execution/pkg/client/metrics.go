@@ -73,6 +73,14 @@ func (cm *clientMetrics) measureGetPayloadDuration(startTime time.Time) {
 	)
 }
 
+// measureGetBlobsDuration measures the duration of the get blobs.
+func (cm *clientMetrics) measureGetBlobsDuration(startTime time.Time) {
+	cm.sink.MeasureSince(
+		"beacon_kit.execution.client.get_blobs_duration",
+		startTime,
+	)
+}
+
 // incrementForkchoiceUpdateTimeout increments the timeout counter
 // for forkchoice update.
 func (cm *clientMetrics) incrementForkchoiceUpdateTimeout() {
@@ -99,6 +107,25 @@ func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
 }
 
+// incrementFailover increments the counter for requests that were retried
+// on another endpoint after the given endpoint failed.
+func (cm *clientMetrics) incrementFailover(method, endpoint string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.failover",
+		"method", method,
+		"endpoint", endpoint,
+	)
+}
+
+// incrementEndpointFailure increments the counter for requests that the
+// given endpoint failed to answer.
+func (cm *clientMetrics) incrementEndpointFailure(endpoint string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.endpoint_failure",
+		"endpoint", endpoint,
+	)
+}
+
 // incrementTimeoutCounter increments the timeout counter for
 // the given metric.
 func (cm *clientMetrics) incrementTimeoutCounter(metricName string) {
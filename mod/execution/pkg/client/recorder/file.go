@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder
+
+import (
+	"os"
+	"strconv"
+	"sync"
+)
+
+// rotatingFile is a file that is rotated once it grows past a maximum size.
+// The rotated files are suffixed with an increasing index, at most maxFiles
+// of them are kept besides the current file.
+type rotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  uint64
+	maxFiles uint64
+	file     *os.File
+	size     uint64
+}
+
+// openRotatingFile opens the rotating file at the given path, appending to
+// it if it already exists.
+func openRotatingFile(
+	path string,
+	maxSize, maxFiles uint64,
+) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	return f, f.open()
+}
+
+// Write writes the given bytes to the file, rotating it first if the write
+// would grow it past its maximum size.
+func (f *rotatingFile) Write(bz []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+uint64(len(bz)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(bz)
+	f.size += uint64(n)
+	return n, err
+}
+
+// Close closes the file.
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
+
+// open opens the current file.
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(
+		f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600,
+	)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	f.file = file
+	f.size = uint64(info.Size())
+	return nil
+}
+
+// rotate closes the current file, shifts the rotated files by one, removing
+// the oldest, and opens a new current file.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Remove(f.rotatedPath(f.maxFiles)); err != nil &&
+		!os.IsNotExist(err) {
+		return err
+	}
+	for i := f.maxFiles; i > 0; i-- {
+		err := os.Rename(f.rotatedPath(i-1), f.rotatedPath(i))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return f.open()
+}
+
+// rotatedPath returns the path of the rotated file with the given index,
+// index zero being the current file.
+func (f *rotatingFile) rotatedPath(index uint64) string {
+	if index == 0 {
+		return f.path
+	}
+	return f.path + "." + strconv.FormatUint(index, 10)
+}
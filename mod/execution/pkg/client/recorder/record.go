@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// maxRecordSize is the maximum size of a single record when loading a
+// recording.
+const maxRecordSize = 64 << 20
+
+// Record is a single JSON-RPC exchange with the execution client.
+type Record struct {
+	// Time is when the request was sent.
+	Time time.Time `json:"time"`
+	// Duration is how long the execution client took to respond.
+	Duration time.Duration `json:"duration"`
+	// Endpoint is the name of the execution client that was called.
+	Endpoint string `json:"endpoint"`
+	// Method is the JSON-RPC method that was called.
+	Method string `json:"method"`
+	// Params are the raw JSON-RPC params of the request.
+	Params json.RawMessage `json:"params,omitempty"`
+	// Result is the raw JSON-RPC result of the response.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Error is the JSON-RPC error of the response.
+	Error *Error `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC error.
+type Error struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Load reads the records of the given recording files, in order.
+func Load(paths ...string) ([]*Record, error) {
+	var records []*Record
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, maxRecordSize)
+		for scanner.Scan() {
+			if len(scanner.Bytes()) == 0 {
+				continue
+			}
+			record := new(Record)
+			if err = json.Unmarshal(scanner.Bytes(), record); err != nil {
+				break
+			}
+			records = append(records, record)
+		}
+		if err == nil {
+			err = scanner.Err()
+		}
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
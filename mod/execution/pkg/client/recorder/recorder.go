@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// Recorder writes every JSON-RPC exchange with the execution client, with
+// timing, to a rotating file.
+type Recorder struct {
+	// logger is the logger for the recorder.
+	logger log.Logger[any]
+	// file is the rotating file the records are written to.
+	file *rotatingFile
+}
+
+// New creates a new Recorder writing to the file at the given path, which is
+// rotated once it grows past maxFileSize bytes. At most maxFiles rotated
+// files are kept.
+func New(
+	logger log.Logger[any],
+	path string,
+	maxFileSize, maxFiles uint64,
+) (*Recorder, error) {
+	file, err := openRotatingFile(path, maxFileSize, maxFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		logger: logger,
+		file:   file,
+	}, nil
+}
+
+// Transport returns an http.RoundTripper that records every exchange sent
+// through the next transport as an exchange with the named endpoint.
+func (r *Recorder) Transport(
+	endpoint string,
+	next http.RoundTripper,
+) http.RoundTripper {
+	return &recordingTransport{
+		recorder: r,
+		endpoint: endpoint,
+		next:     next,
+	}
+}
+
+// Close closes the recording file.
+func (r *Recorder) Close() error {
+	return r.file.Close()
+}
+
+// record writes the records of the given exchange.
+func (r *Recorder) record(
+	endpoint string,
+	start time.Time,
+	duration time.Duration,
+	reqBody, respBody []byte,
+	respErr *Error,
+) {
+	reqs, _, err := decodeMessages(reqBody)
+	if err != nil {
+		r.logger.Warn("Failed to decode recorded request", "err", err)
+		return
+	}
+
+	// Responses are matched to requests by ID, since the responses to a
+	// batch may come in any order.
+	responses := make(map[string]*message)
+	if respErr == nil {
+		resps, _, derr := decodeMessages(respBody)
+		if derr != nil {
+			respErr = &Error{Message: derr.Error()}
+		}
+		for _, resp := range resps {
+			responses[string(resp.ID)] = resp
+		}
+	}
+
+	for _, req := range reqs {
+		record := &Record{
+			Time:     start,
+			Duration: duration,
+			Endpoint: endpoint,
+			Method:   req.Method,
+			Params:   req.Params,
+			Error:    respErr,
+		}
+		if resp, ok := responses[string(req.ID)]; ok {
+			record.Result = resp.Result
+			record.Error = resp.Error
+		}
+
+		bz, merr := json.Marshal(record)
+		if merr != nil {
+			r.logger.Warn("Failed to encode record", "err", merr)
+			continue
+		}
+		if _, err = r.file.Write(append(bz, '\n')); err != nil {
+			r.logger.Warn("Failed to write record", "err", err)
+		}
+	}
+}
+
+// recordingTransport is an http.RoundTripper that records the exchanges
+// sent through it.
+type recordingTransport struct {
+	recorder *Recorder
+	endpoint string
+	next     http.RoundTripper
+}
+
+// RoundTrip sends the request through the next transport and records the
+// exchange.
+func (t *recordingTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		if err = req.Body.Close(); err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.recorder.record(
+			t.endpoint, start, time.Since(start), reqBody, nil,
+			&Error{Message: err.Error()},
+		)
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	duration := time.Since(start)
+	if cerr := resp.Body.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	var respErr *Error
+	if resp.StatusCode != http.StatusOK {
+		respErr = &Error{
+			Message: "HTTP " + strconv.Itoa(resp.StatusCode),
+			Data:    jsonString(respBody),
+		}
+	}
+	t.recorder.record(
+		t.endpoint, start, duration, reqBody, respBody, respErr,
+	)
+	return resp, nil
+}
+
+// jsonString encodes the given bytes as a JSON string.
+func jsonString(bz []byte) json.RawMessage {
+	//#nosec:G703 // encoding a string cannot fail.
+	encoded, _ := json.Marshal(string(bz))
+	return encoded
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/stretchr/testify/require"
+)
+
+// testService is served by the test execution client.
+type testService struct {
+	calls int
+}
+
+func (s *testService) Status(number uint64) map[string]any {
+	s.calls++
+	return map[string]any{"number": number, "calls": s.calls}
+}
+
+func newRecordingClient(
+	t *testing.T,
+	path string,
+	maxFileSize, maxFiles uint64,
+) *rpc.Client {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("engine", &testService{}))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	rec, err := recorder.New(
+		noop.NewLogger[any](), path, maxFileSize, maxFiles,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, rec.Close()) })
+
+	client, err := rpc.DialOptions(
+		context.Background(), httpServer.URL,
+		rpc.WithHTTPClient(&http.Client{
+			Transport: rec.Transport("primary", http.DefaultTransport),
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "engine.jsonl")
+	client := newRecordingClient(t, path, 1<<20, 1)
+
+	var recorded []map[string]any
+	for _, number := range []uint64{1, 1, 2} {
+		var result map[string]any
+		require.NoError(t, client.CallContext(
+			context.Background(), &result, "engine_status", number,
+		))
+		recorded = append(recorded, result)
+	}
+	require.Error(t, client.CallContext(
+		context.Background(), nil, "engine_unknown",
+	))
+
+	records, err := recorder.Load(path)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, "primary", records[0].Endpoint)
+	require.Equal(t, "engine_status", records[0].Method)
+	require.NotNil(t, records[3].Error)
+
+	replay, err := rpc.DialOptions(
+		context.Background(), "http://replay.invalid",
+		rpc.WithHTTPClient(&http.Client{
+			Transport: recorder.NewReplayer(records),
+		}),
+	)
+	require.NoError(t, err)
+	defer replay.Close()
+
+	// Identical requests are answered in recorded order.
+	for i, number := range []uint64{1, 1, 2} {
+		var result map[string]any
+		require.NoError(t, replay.CallContext(
+			context.Background(), &result, "engine_status", number,
+		))
+		require.Equal(t, recorded[i], result)
+	}
+	require.Error(t, replay.CallContext(
+		context.Background(), nil, "engine_unknown",
+	))
+	require.Error(t, replay.CallContext(
+		context.Background(), nil, "engine_status", 3,
+	))
+}
+
+func TestRecordRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "engine.jsonl")
+	client := newRecordingClient(t, path, 1, 1)
+
+	for number := range uint64(3) {
+		require.NoError(t, client.CallContext(
+			context.Background(), nil, "engine_status", number,
+		))
+	}
+
+	// Every record rotates the file and only one rotated file is kept.
+	records, err := recorder.Load(path+".1", path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	_, err = os.Stat(path + ".2")
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// errCodeNoRecording is the JSON-RPC error code returned for requests that
+// have no recorded response.
+const errCodeNoRecording = -32000
+
+// Replayer is an http.RoundTripper that answers JSON-RPC requests with the
+// responses of a recording. Requests are matched by method and params; the
+// responses to identical requests are served in recorded order, the last
+// one being repeated once they are exhausted.
+type Replayer struct {
+	mu sync.Mutex
+	// responses maps the key of a request to its recorded responses.
+	responses map[string][]*Record
+}
+
+// NewReplayer creates a new Replayer serving the given records.
+func NewReplayer(records []*Record) *Replayer {
+	r := &Replayer{
+		responses: make(map[string][]*Record),
+	}
+	for _, record := range records {
+		key := requestKey(record.Method, record.Params)
+		r.responses[key] = append(r.responses[key], record)
+	}
+	return r
+}
+
+// RoundTrip answers the request with the recorded responses.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = req.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	reqs, batch, err := decodeMessages(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resps := make([]*message, 0, len(reqs))
+	for _, msg := range reqs {
+		resps = append(resps, r.respond(msg))
+	}
+
+	respBody, err := encodeMessages(resps, batch)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// respond returns the recorded response to the given request.
+func (r *Replayer) respond(req *message) *message {
+	resp := &message{
+		Version: "2.0",
+		ID:      req.ID,
+	}
+
+	record := r.next(requestKey(req.Method, req.Params))
+	switch {
+	case record == nil:
+		resp.Error = &Error{
+			Code:    errCodeNoRecording,
+			Message: "no recorded response for " + req.Method,
+		}
+	case record.Error != nil:
+		resp.Error = record.Error
+	case len(record.Result) == 0:
+		resp.Result = json.RawMessage("null")
+	default:
+		resp.Result = record.Result
+	}
+	return resp
+}
+
+// next returns the next recorded response for the given key.
+func (r *Replayer) next(key string) *Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := r.responses[key]
+	switch len(records) {
+	case 0:
+		return nil
+	case 1:
+		return records[0]
+	default:
+		r.responses[key] = records[1:]
+		return records[0]
+	}
+}
+
+// requestKey returns the key identifying a request by method and params.
+func requestKey(method string, params json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, params); err != nil {
+		return method + string(params)
+	}
+	return method + buf.String()
+}
@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+// testChainID is the chain ID served by the stub execution clients.
+const testChainID = 80087
+
+type testEngineClient = client.EngineClient[*testPayload, *testAttributes]
+
+// testPayload is an execution payload of which the content is ignored.
+type testPayload struct{}
+
+func (*testPayload) Empty(uint32) *testPayload    { return &testPayload{} }
+func (*testPayload) Version() uint32              { return 0 }
+func (p *testPayload) IsNil() bool                { return p == nil }
+func (*testPayload) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+func (*testPayload) UnmarshalJSON(_ []byte) error { return nil }
+
+// testAttributes are payload attributes that are never set.
+type testAttributes struct{}
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+//nolint:lll // the signature is set by the interface.
+func (*testAttributes) GetSuggestedFeeRecipient() gethprimitives.ExecutionAddress {
+	return gethprimitives.ExecutionAddress{}
+}
+
+// noopSink is a telemetry sink that discards every metric.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// rpcRequest is a JSON-RPC request received by a stub execution client.
+type rpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// stubEL is an execution client answering the engine API with canned
+// results, optionally after a delay or never.
+type stubEL struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// results are the results returned for each method.
+	results map[string]any
+	// delays are how long each method takes to answer.
+	delays map[string]time.Duration
+	// hung are the methods that are never answered.
+	hung map[string]bool
+	// failing are the methods answered with an HTTP error.
+	failing map[string]bool
+	// requests are the requests received for each method.
+	requests map[string][]rpcRequest
+	// release unblocks the hung requests on shutdown.
+	release chan struct{}
+}
+
+// newStubEL starts a stub execution client that answers the calls needed to
+// initialize a connection.
+func newStubEL(t *testing.T, handler func(http.Handler) http.Handler) *stubEL {
+	t.Helper()
+	el := &stubEL{
+		results: map[string]any{
+			"eth_chainId":                 "0x138d7",
+			"engine_exchangeCapabilities": []string{},
+		},
+		delays:   make(map[string]time.Duration),
+		hung:     make(map[string]bool),
+		failing:  make(map[string]bool),
+		requests: make(map[string][]rpcRequest),
+		release:  make(chan struct{}),
+	}
+	var h http.Handler = http.HandlerFunc(el.serveHTTP)
+	if handler != nil {
+		h = handler(h)
+	}
+	el.Server = httptest.NewServer(h)
+	t.Cleanup(el.Close)
+	t.Cleanup(func() { close(el.release) })
+	return el
+}
+
+// set configures the result of the given method.
+func (el *stubEL) set(method string, result any) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.results[method] = result
+}
+
+// delay makes the given method answer after the given duration.
+func (el *stubEL) delay(method string, d time.Duration) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.delays[method] = d
+}
+
+// hang makes the given method never answer.
+func (el *stubEL) hang(method string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.hung[method] = true
+}
+
+// fail makes the given method answer with an HTTP error.
+func (el *stubEL) fail(method string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.failing[method] = true
+}
+
+// received returns the requests received for the given method.
+func (el *stubEL) received(method string) []rpcRequest {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return el.requests[method]
+}
+
+func (el *stubEL) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	el.mu.Lock()
+	el.requests[req.Method] = append(el.requests[req.Method], req)
+	result, delay := el.results[req.Method], el.delays[req.Method]
+	hung, failing := el.hung[req.Method], el.failing[req.Method]
+	el.mu.Unlock()
+
+	switch {
+	case hung:
+		select {
+		case <-r.Context().Done():
+		case <-el.release:
+		}
+		return
+	case failing:
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	select {
+	case <-time.After(delay):
+	case <-r.Context().Done():
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	//nolint:errcheck // the client reports malformed answers.
+	json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+		"result":  result,
+	})
+}
+
+// forkchoiceResponse returns the answer to a forkchoice update with the
+// given status and payload ID.
+func forkchoiceResponse(
+	status engineprimitives.PayloadStatusStr,
+	payloadID string,
+) map[string]any {
+	return map[string]any{
+		"payloadStatus": map[string]any{
+			"status":          status,
+			"latestValidHash": gethprimitives.ExecutionHash{0x1},
+		},
+		"payloadId": payloadID,
+	}
+}
+
+// newTestClient starts an engine client using the first stub execution
+// client as primary and the others as fallbacks, and waits until all of
+// them are connected.
+func newTestClient(
+	t *testing.T,
+	secret *jwt.Secret,
+	cfgFn func(*client.Config),
+	els ...*stubEL,
+) *testEngineClient {
+	t.Helper()
+	cfg := client.DefaultConfig()
+	cfg.RPCTimeout = time.Second
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	cfg.RPCFallbackDialURLs = nil
+	for i, el := range els {
+		u, err := url.NewFromRaw(el.URL)
+		require.NoError(t, err)
+		if i == 0 {
+			cfg.RPCDialURL = u
+			continue
+		}
+		cfg.RPCFallbackDialURLs = append(cfg.RPCFallbackDialURLs, u)
+	}
+	if cfgFn != nil {
+		cfgFn(&cfg)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	c := client.New[*testPayload, *testAttributes](
+		&cfg,
+		noop.NewLogger[any](),
+		secret,
+		noopSink{},
+		big.NewInt(testChainID),
+		engineprimitives.ClientVersionV1{},
+	)
+	require.NoError(t, c.Start(ctx))
+	require.Eventually(t, func() bool {
+		return c.InitializedEndpoints() == len(els)
+	}, 5*time.Second, 10*time.Millisecond)
+	return c
+}
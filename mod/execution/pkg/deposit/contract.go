@@ -23,11 +23,13 @@ package deposit
 import (
 	"context"
 	"errors"
+	"math/big"
 
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -38,6 +40,8 @@ type WrappedBeaconDepositContract[
 ] struct {
 	// BeaconDepositContract is a pointer to the codegen ABI binding.
 	deposit.BeaconDepositContract
+	// client is the execution client the contract is read from.
+	client ExecutionClient
 }
 
 // NewWrappedBeaconDepositContract creates a new BeaconDepositContract.
@@ -46,7 +50,7 @@ func NewWrappedBeaconDepositContract[
 	WithdrawalCredentialsT ~[32]byte,
 ](
 	address gethprimitives.ExecutionAddress,
-	client bind.ContractBackend,
+	client ExecutionClient,
 ) (*WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
@@ -66,30 +70,59 @@ func NewWrappedBeaconDepositContract[
 		WithdrawalCredentialsT,
 	]{
 		BeaconDepositContract: *contract,
+		client:                client,
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits emitted in the [from, to] block range from
+// the deposit contract, along with the hash of the last block of the range.
+// Unless parentHash is zero, the range must extend the block with that hash.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
-) ([]DepositT, error) {
+	from, to math.U64,
+	parentHash common.ExecutionHash,
+) ([]DepositT, common.ExecutionHash, error) {
+	hash, err := dc.BlockHash(ctx, to)
+	if err != nil {
+		return nil, common.ExecutionHash{}, err
+	} else if hash == (common.ExecutionHash{}) {
+		return nil, common.ExecutionHash{}, ErrBlockNotFound
+	}
+
+	if parentHash != (common.ExecutionHash{}) {
+		var first *gethprimitives.Header
+		first, err = dc.client.CanonicalHeaderByNumber(
+			ctx, new(big.Int).SetUint64(uint64(from)),
+		)
+		if err != nil {
+			return nil, common.ExecutionHash{}, err
+		} else if first.ParentHash != parentHash {
+			return nil, common.ExecutionHash{}, ErrRangeReorged
+		}
+	}
+
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   uint64(blkNum),
-			End:     (*uint64)(&blkNum),
+			Start:   uint64(from),
+			End:     (*uint64)(&to),
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, common.ExecutionHash{}, err
 	}
+	defer logs.Close()
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
+		if logs.Event.Raw.BlockNumber == uint64(to) &&
+			logs.Event.Raw.BlockHash != hash {
+			return nil, common.ExecutionHash{}, ErrRangeReorged
+		}
+
 		var d DepositT
 		deposits = append(deposits, d.New(
 			bytes.ToBytes48(logs.Event.Pubkey),
@@ -100,6 +133,38 @@ func (dc *WrappedBeaconDepositContract[
 			logs.Event.Index,
 		))
 	}
+	if err = logs.Error(); err != nil {
+		return nil, common.ExecutionHash{}, err
+	}
 
-	return deposits, nil
+	// The logs may belong to a fork that was abandoned while they were read,
+	// in which case the last block of the range has changed.
+	latest, err := dc.BlockHash(ctx, to)
+	if err != nil {
+		return nil, common.ExecutionHash{}, err
+	}
+	if latest != hash {
+		return nil, common.ExecutionHash{}, ErrRangeReorged
+	}
+	return deposits, hash, nil
+}
+
+// BlockHash returns the hash of the canonical execution block with the given
+// number, or the zero hash if the execution client does not have it.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) BlockHash(
+	ctx context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	header, err := dc.client.CanonicalHeaderByNumber(
+		ctx, new(big.Int).SetUint64(uint64(number)),
+	)
+	if errors.Is(err, gethprimitives.ErrNotFound) {
+		return common.ExecutionHash{}, nil
+	} else if err != nil {
+		return common.ExecutionHash{}, err
+	}
+	return header.Hash(), nil
 }
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	mockengine "github.com/berachain/beacon-kit/mod/execution/pkg/mock-engine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/ethclient"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesis = `{
+	"config": {
+		"chainId": 80087,
+		"homesteadBlock": 0,
+		"eip150Block": 0,
+		"eip155Block": 0,
+		"eip158Block": 0,
+		"byzantiumBlock": 0,
+		"constantinopleBlock": 0,
+		"petersburgBlock": 0,
+		"istanbulBlock": 0,
+		"berlinBlock": 0,
+		"londonBlock": 0,
+		"shanghaiTime": 0,
+		"cancunTime": 0,
+		"terminalTotalDifficulty": 0,
+		"terminalTotalDifficultyPassed": true
+	},
+	"gasLimit": "0x1c9c380",
+	"difficulty": "0x0",
+	"timestamp": "0x0",
+	"alloc": {}
+}`
+
+var depositContract = gethprimitives.HexToAddress(
+	"0x4242424242424242424242424242424242424242",
+)
+
+// testDeposit only tracks the index of a deposit.
+type testDeposit struct {
+	index uint64
+}
+
+func (*testDeposit) New(
+	_ crypto.BLSPubkey,
+	_ bytes.B32,
+	_ math.U64,
+	_ crypto.BLSSignature,
+	index uint64,
+) *testDeposit {
+	return &testDeposit{index: index}
+}
+
+func (d *testDeposit) GetIndex() math.U64 {
+	return math.U64(d.index)
+}
+
+// testClient serves canonical headers straight from the execution client.
+type testClient struct {
+	*ethclient.Client
+}
+
+func (c testClient) CanonicalHeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*gethprimitives.Header, error) {
+	return c.HeaderByNumber(ctx, number)
+}
+
+// buildBlock builds a block on top of parent with the mock engine and makes
+// it the head of the chain.
+func buildBlock(
+	t *testing.T,
+	client *rpc.Client,
+	parent *gethprimitives.Header,
+	random byte,
+) gethprimitives.ExecutionHash {
+	t.Helper()
+	ctx := context.Background()
+	beaconRoot := gethprimitives.HexToHash("0xbeac")
+	state := engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      parent.Hash(),
+		SafeBlockHash:      parent.Hash(),
+		FinalizedBlockHash: parent.Hash(),
+	}
+
+	var res engineprimitives.ForkchoiceResponseV1
+	require.NoError(t, client.CallContext(
+		ctx, &res, "engine_forkchoiceUpdatedV3", state,
+		&gethprimitives.PayloadAttributes{
+			Timestamp:             parent.Time + 1,
+			Random:                gethprimitives.ExecutionHash{random},
+			SuggestedFeeRecipient: gethprimitives.HexToAddress("0x02"),
+			Withdrawals:           []*gethprimitives.Withdrawal{},
+			BeaconRoot:            &beaconRoot,
+		},
+	))
+	var envelope gethprimitives.ExecutionPayloadEnvelope
+	require.NoError(t, client.CallContext(
+		ctx, &envelope, "engine_getPayloadV3", res.PayloadID,
+	))
+	payload := envelope.ExecutionPayload
+
+	var status engineprimitives.PayloadStatusV1
+	require.NoError(t, client.CallContext(
+		ctx, &status, "engine_newPayloadV3",
+		payload, []gethprimitives.ExecutionHash{}, &beaconRoot,
+	))
+	state.HeadBlockHash = payload.BlockHash
+	require.NoError(t, client.CallContext(
+		ctx, &res, "engine_forkchoiceUpdatedV3", state, nil,
+	))
+	return payload.BlockHash
+}
+
+func TestReadDepositsDetectsReorgs(t *testing.T) {
+	ctx := context.Background()
+	genesis := new(gethprimitives.Genesis)
+	require.NoError(t, json.Unmarshal([]byte(testGenesis), genesis))
+	engine, err := mockengine.New(mockengine.Config{
+		Address:                "127.0.0.1:0",
+		DepositContractAddress: depositContract,
+	}, genesis)
+	require.NoError(t, err)
+	require.NoError(t, engine.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, engine.Stop()) })
+
+	rpcClient, err := rpc.DialContext(ctx, engine.URL())
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	client := testClient{Client: ethclient.NewClient(rpcClient)}
+	dc, err := deposit.NewWrappedBeaconDepositContract[
+		*testDeposit, bytes.B32,
+	](depositContract, client)
+	require.NoError(t, err)
+
+	// Block 1 carries a deposit, block 2 is empty.
+	engine.InjectDeposit(
+		crypto.BLSPubkey{0x01}, bytes.B32{0x02}, math.Gwei(32e9),
+		crypto.BLSSignature{0x03},
+	)
+	head := engine.Head().Header()
+	first := buildBlock(t, rpcClient, head, 0x01)
+	head = engine.Head().Header()
+	second := buildBlock(t, rpcClient, head, 0x01)
+
+	deposits, hash, err := dc.ReadDeposits(ctx, 0, 2, common.ExecutionHash{})
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, second, hash)
+
+	// Ranges past the head of the execution chain cannot be read yet.
+	_, _, err = dc.ReadDeposits(ctx, 3, 4, second)
+	require.ErrorIs(t, err, deposit.ErrBlockNotFound)
+
+	// Replace block 2 with a sibling; ranges built on the old block 2 are
+	// rejected and its hash is no longer canonical.
+	parent, err := client.HeaderByHash(ctx, first)
+	require.NoError(t, err)
+	sibling := buildBlock(t, rpcClient, parent, 0x02)
+	require.NotEqual(t, second, sibling)
+	canonical, err := dc.BlockHash(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, sibling, canonical)
+
+	head = engine.Head().Header()
+	buildBlock(t, rpcClient, head, 0x01)
+	_, _, err = dc.ReadDeposits(ctx, 3, 3, second)
+	require.ErrorIs(t, err, deposit.ErrRangeReorged)
+	_, hash, err = dc.ReadDeposits(ctx, 3, 3, sibling)
+	require.NoError(t, err)
+	require.Equal(t, engine.Head().Hash(), hash)
+}
@@ -47,3 +47,9 @@ func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
 		strconv.FormatUint(uint64(blockNum), 10),
 	)
 }
+
+// markRollback increments the counter for deposit rollbacks caused by
+// execution reorgs.
+func (m *metrics) markRollback() {
+	m.sink.IncrementCounter("beacon_kit.execution.deposit.rollback")
+}
@@ -42,6 +42,9 @@ type Service[
 	logger log.Logger[any]
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
+	// deployBlock is the execution block the deposit contract was deployed
+	// at, from which deposits are synced.
+	deployBlock math.U64
 	// dc is the contract interface for interacting with the deposit contract.
 	dc Contract[DepositT]
 	// ds is the deposit store that stores deposits.
@@ -50,9 +53,6 @@ type Service[
 	feed chan BlockEventT
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// failedBlocks is a map of blocks that failed to be processed to be
-	// retried.
-	failedBlocks map[math.U64]struct{}
 }
 
 // NewService creates a new instance of the Service struct.
@@ -70,6 +70,7 @@ func NewService[
 ](
 	logger log.Logger[any],
 	eth1FollowDistance math.U64,
+	deployBlock math.U64,
 	telemetrySink TelemetrySink,
 	ds Store[DepositT],
 	dc Contract[DepositT],
@@ -86,10 +87,10 @@ func NewService[
 		feed:               feed,
 		logger:             logger,
 		eth1FollowDistance: eth1FollowDistance,
+		deployBlock:        deployBlock,
 		metrics:            newMetrics(telemetrySink),
 		dc:                 dc,
 		ds:                 ds,
-		failedBlocks:       make(map[math.Slot]struct{}),
 	}
 }
 
@@ -98,7 +99,6 @@ func (s *Service[
 	_, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
 	go s.depositFetcher(ctx)
-	go s.depositCatchupFetcher(ctx)
 	return nil
 }
 
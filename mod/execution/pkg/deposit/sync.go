@@ -24,82 +24,137 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval is the interval at which an incomplete sync is
+	// retried.
+	defaultRetryInterval = 20 * time.Second
+	// defaultLogsBatchSize is the maximum number of blocks whose deposit logs
+	// are read with a single request.
+	defaultLogsBatchSize = 1000
+)
 
-// depositFetcher processes a deposit event.
+// depositFetcher syncs deposits up to the follow distance behind the
+// execution block of every finalized beacon block, and periodically retries
+// syncs that could not complete.
 func (s *Service[
 	_, _, _, _, _, _,
 ]) depositFetcher(ctx context.Context) {
+	var target math.U64
+	ticker := time.NewTicker(defaultRetryInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-ticker.C:
+			s.syncDeposits(ctx, target)
 		case msg := <-s.feed:
-			if msg.Is(events.BeaconBlockFinalized) {
-				blockNum := msg.Data().
-					GetBody().GetExecutionPayload().GetNumber()
-				s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+			if !msg.Is(events.BeaconBlockFinalized) {
+				continue
 			}
+			blockNum := msg.Data().GetBody().GetExecutionPayload().GetNumber()
+			if blockNum < s.eth1FollowDistance {
+				continue
+			}
+			target = blockNum - s.eth1FollowDistance
+			s.syncDeposits(ctx, target)
 		}
 	}
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// syncDeposits ingests the deposits of the blocks between the sync cursor and
+// the target block, in batches, after rolling back any deposits ingested from
+// blocks that have since been reorged out.
 func (s *Service[
-	_, _, _, _, _, _,
-]) depositCatchupFetcher(ctx context.Context) {
-	ticker := time.NewTicker(defaultRetryInterval)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
-				continue
-			}
+	_, _, _, DepositT, _, _,
+]) syncDeposits(ctx context.Context, target math.U64) {
+	number, hash, err := s.ds.GetSyncCursor()
+	if err != nil {
+		s.logger.Error("Failed to get deposit sync cursor", "error", err)
+		return
+	}
+
+	cursor, hash, err := s.rollbackReorged(ctx, math.U64(number), hash)
+	if err != nil {
+		s.logger.Error("Failed to check deposits for reorgs", "error", err)
+		return
+	}
+
+	start := s.deployBlock
+	if hash != (common.ExecutionHash{}) {
+		start = cursor + 1
+	}
+	for start <= target {
+		end := min(start+defaultLogsBatchSize-1, target)
+		var deposits []DepositT
+		deposits, hash, err = s.dc.ReadDeposits(ctx, start, end, hash)
+		if err != nil {
+			s.metrics.markFailedToGetBlockLogs(start)
 			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
+				"Failed to read deposits from block range, retrying later",
+				"start", start, "end", end, "error", err,
 			)
+			return
+		}
 
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+		if len(deposits) > 0 {
+			s.logger.Info(
+				"Found deposits on execution layer",
+				"start", start, "end", end, "deposits", len(deposits),
+			)
 		}
+
+		if err = s.ds.IngestRange(uint64(end), hash, deposits); err != nil {
+			s.logger.Error("Failed to store deposits", "error", err)
+			return
+		}
+		start = end + 1
 	}
 }
 
+// rollbackReorged checks that the block at the sync cursor is still
+// canonical. If it is not, the deposit store is rolled back to the most
+// recent ingested block that is, and that block is returned.
 func (s *Service[
 	_, _, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
-	if err != nil {
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
-	}
+]) rollbackReorged(
+	ctx context.Context,
+	number math.U64,
+	hash common.ExecutionHash,
+) (math.U64, common.ExecutionHash, error) {
+	var reorged bool
+	for hash != (common.ExecutionHash{}) {
+		canonical, err := s.dc.BlockHash(ctx, number)
+		if err != nil {
+			return 0, common.ExecutionHash{}, err
+		} else if canonical == hash {
+			break
+		}
 
-	if len(deposits) > 0 {
-		s.logger.Info(
-			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
-		)
+		reorged = true
+		prev, prevHash, err := s.ds.PreviousCheckpoint(uint64(number))
+		if err != nil {
+			return 0, common.ExecutionHash{}, err
+		}
+		number, hash = math.U64(prev), prevHash
 	}
-
-	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+	if !reorged {
+		return number, hash, nil
 	}
 
-	delete(s.failedBlocks, blockNum)
+	removed, err := s.ds.Rollback(uint64(number), hash)
+	if err != nil {
+		return 0, common.ExecutionHash{}, err
+	}
+	s.metrics.markRollback()
+	s.logger.Warn(
+		"Execution reorg detected, rolled back deposits",
+		"block", number, "hash", hash, "deposits_removed", removed,
+	)
+	return number, hash, nil
 }
@@ -22,8 +22,12 @@ package deposit
 
 import (
 	"context"
+	"math/big"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -65,11 +69,32 @@ type ExecutionPayload interface {
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits emitted in the [from, to] block range
+	// from the deposit contract, along with the hash of the block at to.
+	// Unless parentHash is zero, the range must extend the block with that
+	// hash.
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
-	) ([]DepositT, error)
+		from, to math.U64,
+		parentHash common.ExecutionHash,
+	) ([]DepositT, common.ExecutionHash, error)
+	// BlockHash returns the hash of the canonical execution block with the
+	// given number, or the zero hash if it does not exist.
+	BlockHash(
+		ctx context.Context,
+		number math.U64,
+	) (common.ExecutionHash, error)
+}
+
+// ExecutionClient is the execution client the deposit contract is read from.
+type ExecutionClient interface {
+	bind.ContractBackend
+	// CanonicalHeaderByNumber returns the canonical header with the given
+	// number, bypassing any cache.
+	CanonicalHeaderByNumber(
+		ctx context.Context,
+		number *big.Int,
+	) (*gethprimitives.Header, error)
 }
 
 // Deposit is an interface for deposits.
@@ -90,8 +115,23 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 type Store[DepositT any] interface {
 	// Prune prunes the deposit store of [start, end)
 	Prune(index uint64, numPrune uint64) error
-	// EnqueueDeposits adds a list of deposits to the deposit store.
-	EnqueueDeposits(deposits []DepositT) error
+	// GetSyncCursor returns the number and hash of the last execution block
+	// whose deposit logs were ingested, or the zero hash if there is none.
+	GetSyncCursor() (uint64, common.ExecutionHash, error)
+	// IngestRange stores the deposits read from a block range ending at the
+	// given block and advances the sync cursor to it.
+	IngestRange(
+		number uint64,
+		hash common.ExecutionHash,
+		deposits []DepositT,
+	) error
+	// PreviousCheckpoint returns the number and hash of the last block of the
+	// most recent ingested range with deposits ending before the given block,
+	// or the zero hash if there is none.
+	PreviousCheckpoint(number uint64) (uint64, common.ExecutionHash, error)
+	// Rollback removes the deposits ingested from blocks after the given one
+	// and rewinds the sync cursor to it.
+	Rollback(number uint64, hash common.ExecutionHash) (uint64, error)
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -54,6 +54,9 @@ type Engine[
 	metrics *engineMetrics
 	// statusPublisher is the status publishder for the engine.
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]]
+	// tracker tracks the payloads that were not verified by the
+	// execution client.
+	tracker OptimisticTracker
 }
 
 // New creates a new Engine.
@@ -69,6 +72,7 @@ func New[
 	logger log.Logger[any],
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]],
 	telemtrySink TelemetrySink,
+	tracker OptimisticTracker,
 ) *Engine[
 	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalT,
 ] {
@@ -77,6 +81,7 @@ func New[
 		logger:          logger,
 		metrics:         newEngineMetrics(telemtrySink, logger),
 		statusPublisher: statusPublisher,
+		tracker:         tracker,
 	}
 }
 
@@ -147,6 +152,7 @@ func (ee *Engine[
 		engineerrors.ErrInvalidBlockHashPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateInvalid(req.State, err)
+		ee.markPayloadInvalid(req.State.HeadBlockHash, latestValidHash)
 		return payloadID, latestValidHash, ErrBadBlockProduced
 
 	// JSON-RPC errors are predefined and should be handled as such.
@@ -162,6 +168,7 @@ func (ee *Engine[
 		ee.metrics.markForkchoiceUpdateValid(
 			req.State, hasPayloadAttributes, payloadID,
 		)
+		ee.markPayloadValid(req.State.HeadBlockHash)
 	}
 
 	// If we reached here, and we have a nil payload ID, we should log a
@@ -196,11 +203,12 @@ func (ee *Engine[
 		req.Optimistic,
 	)
 
-	// First we verify the block hash and versioned hashes are valid.
+	// First we verify the versioned hashes are valid, the block hash has
+	// already been verified locally when converting the payload to its header.
 	//
 	// TODO: is this required? Or will the EL handle this for us during
 	// new payload?
-	if err := req.HasValidVersionedAndBlockHashes(); err != nil {
+	if err := req.HasValidVersionedHashes(); err != nil {
 		return err
 	}
 
@@ -241,6 +249,9 @@ func (ee *Engine[
 			req.ExecutionPayload.GetBlockHash(),
 			req.Optimistic,
 		)
+		ee.markPayloadInvalid(
+			req.ExecutionPayload.GetBlockHash(), lastValidHash,
+		)
 
 		// We want to return bad block irrespective of
 		// if we are running in optimistic mode or not.
@@ -274,6 +285,7 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.markPayloadValid(req.ExecutionPayload.GetBlockHash())
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
@@ -291,8 +303,48 @@ func (ee *Engine[
 	// and the beginning of abci.FinalizeBlock. Without handling this case
 	// it would cause a failure of abci.FinalizeBlock and a
 	// "CONSENSUS FAILURE!!!!" at the CometBFT layer.
+	//
+	// Since the payload has not been verified, the block carrying it is
+	// tracked as optimistic until the execution client catches up.
 	if req.Optimistic {
+		if err != nil {
+			ee.tracker.MarkPayloadOptimistic(
+				req.ExecutionPayload.GetBlockHash(),
+			)
+		}
 		return nil
 	}
 	return err
 }
+
+// markPayloadValid records the payload with the given hash as valid in the
+// optimistic tracker.
+func (ee *Engine[_, _, _, _]) markPayloadValid(
+	hash gethprimitives.ExecutionHash,
+) {
+	if err := ee.tracker.MarkPayloadValid(hash); err != nil {
+		ee.logger.Error(
+			"Failed to mark payload as valid",
+			"block_hash", hash,
+			"error", err,
+		)
+	}
+}
+
+// markPayloadInvalid records the payload with the given hash as invalid in
+// the optimistic tracker.
+func (ee *Engine[_, _, _, _]) markPayloadInvalid(
+	hash gethprimitives.ExecutionHash,
+	latestValidHash *gethprimitives.ExecutionHash,
+) {
+	if err := ee.tracker.MarkPayloadInvalid(
+		hash, latestValidHash,
+	); err != nil {
+		ee.logger.Error(
+			"Failed to mark payload as invalid",
+			"block_hash", hash,
+			"latest_valid_hash", latestValidHash,
+			"error", err,
+		)
+	}
+}
@@ -51,6 +51,24 @@ type ExecutionPayload[ExecutionPayloadT, WithdrawalT any] interface {
 	GetTransactions() engineprimitives.Transactions
 }
 
+// OptimisticTracker keeps track of the payloads the execution client was not
+// able to verify, so that the beacon blocks carrying them can be reconciled
+// once it catches up.
+type OptimisticTracker interface {
+	// MarkPayloadOptimistic records that the payload with the given hash
+	// was not verified by the execution client.
+	MarkPayloadOptimistic(hash gethprimitives.ExecutionHash)
+	// MarkPayloadValid records that the payload with the given hash was
+	// verified by the execution client.
+	MarkPayloadValid(hash gethprimitives.ExecutionHash) error
+	// MarkPayloadInvalid records that the payload with the given hash was
+	// rejected by the execution client.
+	MarkPayloadInvalid(
+		hash gethprimitives.ExecutionHash,
+		latestValidHash *gethprimitives.ExecutionHash,
+	) error
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -0,0 +1,442 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// maxPayloadBodiesRequest is the maximum number of payload bodies that can be
+// requested at once.
+const maxPayloadBodiesRequest = 1024
+
+// maxBlobsRequest is the maximum number of blobs that can be requested at
+// once.
+const maxBlobsRequest = 128
+
+// engineAPI serves the engine namespace.
+type engineAPI struct {
+	e *Engine
+}
+
+// NewPayloadV3 serves engine_newPayloadV3.
+func (api *engineAPI) NewPayloadV3(
+	data gethprimitives.ExecutableData,
+	versionedHashes []gethprimitives.ExecutionHash,
+	beaconRoot *gethprimitives.ExecutionHash,
+) (engineprimitives.PayloadStatusV1, error) {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status != "" {
+		return e.forcedStatus(), nil
+	}
+
+	block, err := gethprimitives.ExecutableDataToBlock(
+		data, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		return invalidStatus(e.chain.head, err.Error()), nil
+	}
+	if _, ok := e.chain.get(block.Hash()); ok {
+		return validStatus(block.Hash()), nil
+	}
+	if _, ok := e.chain.get(block.ParentHash()); !ok {
+		return engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusSyncing,
+		}, nil
+	}
+
+	// Carry over the deposit logs of payloads that were built here. The
+	// deposits are no longer pending once a block including them is
+	// imported, which may be a sibling of a block that included fewer.
+	var logs []*gethprimitives.Log
+	for _, payload := range e.payloads {
+		if payload.block.Hash() == block.Hash() {
+			logs = payload.logs
+			e.pendingDeposits = slices.DeleteFunc(
+				e.pendingDeposits, func(d *deposit) bool {
+					return d.index < payload.nextDepositIndex
+				},
+			)
+			break
+		}
+	}
+	e.chain.insert(block, logs)
+	return validStatus(block.Hash()), nil
+}
+
+// ForkchoiceUpdatedV3 serves engine_forkchoiceUpdatedV3.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	state engineprimitives.ForkchoiceStateV1,
+	attrs *gethprimitives.PayloadAttributes,
+) (engineprimitives.ForkchoiceResponseV1, error) {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.status != "" {
+		return engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: e.forcedStatus(),
+		}, nil
+	}
+
+	head, ok := e.chain.get(state.HeadBlockHash)
+	if !ok {
+		return engineprimitives.ForkchoiceResponseV1{
+			PayloadStatus: engineprimitives.PayloadStatusV1{
+				Status: engineprimitives.PayloadStatusSyncing,
+			},
+		}, nil
+	}
+	if !e.chain.setForkchoice(
+		state.HeadBlockHash, state.SafeBlockHash, state.FinalizedBlockHash,
+	) {
+		return engineprimitives.ForkchoiceResponseV1{},
+			errInvalidForkchoiceState
+	}
+
+	response := engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: validStatus(head.Hash()),
+	}
+	if attrs == nil {
+		return response, nil
+	}
+	if attrs.Timestamp <= head.Time() || attrs.BeaconRoot == nil {
+		return engineprimitives.ForkchoiceResponseV1{},
+			errInvalidPayloadAttributes
+	}
+
+	payload, err := e.buildPayload(head, attrs)
+	if err != nil {
+		return engineprimitives.ForkchoiceResponseV1{}, err
+	}
+	id := payloadID(head.Hash(), attrs)
+	e.storePayload(id, payload)
+	response.PayloadID = &id
+	return response, nil
+}
+
+// GetPayloadV3 serves engine_getPayloadV3.
+func (api *engineAPI) GetPayloadV3(
+	id engineprimitives.PayloadID,
+) (*gethprimitives.ExecutionPayloadEnvelope, error) {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	payload, ok := e.payloads[id]
+	if !ok {
+		return nil, errUnknownPayload
+	}
+	return gethprimitives.BlockToExecutableData(
+		payload.block, new(big.Int), nil,
+	), nil
+}
+
+// GetPayloadBodiesByHashV1 serves engine_getPayloadBodiesByHashV1.
+func (api *engineAPI) GetPayloadBodiesByHashV1(
+	hashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	if len(hashes) > maxPayloadBodiesRequest {
+		return nil, errRequestTooLarge
+	}
+
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, len(hashes))
+	for i, hash := range hashes {
+		block, ok := e.chain.get(hash)
+		if !ok {
+			continue
+		}
+		body, err := payloadBody(block)
+		if err != nil {
+			return nil, err
+		}
+		bodies[i] = body
+	}
+	return bodies, nil
+}
+
+// GetPayloadBodiesByRangeV1 serves engine_getPayloadBodiesByRangeV1.
+func (api *engineAPI) GetPayloadBodiesByRangeV1(
+	start, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	if start == 0 || count == 0 {
+		return nil, errInvalidParams
+	}
+	if count > maxPayloadBodiesRequest {
+		return nil, errRequestTooLarge
+	}
+
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// The result is truncated at the head of the chain.
+	head := math.U64(e.chain.headNumber())
+	if start > head {
+		return []*engineprimitives.ExecutionPayloadBodyV1{}, nil
+	}
+	count = min(count, head-start+1)
+
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, count)
+	for i := range bodies {
+		block, ok := e.chain.byNumber(rpc.BlockNumber(start + math.U64(i)))
+		if !ok {
+			continue
+		}
+		body, err := payloadBody(block)
+		if err != nil {
+			return nil, err
+		}
+		bodies[i] = body
+	}
+	return bodies, nil
+}
+
+// GetBlobsV1 serves engine_getBlobsV1. The mock engine has no blob pool,
+// so every blob is reported as missing.
+func (api *engineAPI) GetBlobsV1(
+	versionedHashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof], error) {
+	if len(versionedHashes) > maxBlobsRequest {
+		return nil, errRequestTooLarge
+	}
+	return make(
+		[]*engineprimitives.BlobAndProofV1[eip4844.Blob, eip4844.KZGProof],
+		len(versionedHashes),
+	), nil
+}
+
+// ExchangeCapabilities serves engine_exchangeCapabilities.
+func (api *engineAPI) ExchangeCapabilities([]string) []string {
+	return []string{
+		"engine_newPayloadV3",
+		"engine_forkchoiceUpdatedV3",
+		"engine_getPayloadV3",
+		"engine_getPayloadBodiesByHashV1",
+		"engine_getPayloadBodiesByRangeV1",
+		"engine_getBlobsV1",
+		"engine_getClientVersionV1",
+	}
+}
+
+// GetClientVersionV1 serves engine_getClientVersionV1.
+func (api *engineAPI) GetClientVersionV1(
+	*engineprimitives.ClientVersionV1,
+) []engineprimitives.ClientVersionV1 {
+	return []engineprimitives.ClientVersionV1{{
+		Code:    "MK",
+		Name:    "mock-engine",
+		Version: "v0.0.0",
+		Commit:  "00000000",
+	}}
+}
+
+// payloadBody returns the execution payload body of the given block.
+func payloadBody(
+	block *gethprimitives.Block,
+) (*engineprimitives.ExecutionPayloadBodyV1, error) {
+	body := &engineprimitives.ExecutionPayloadBodyV1{
+		Transactions: make([]bytes.Bytes, 0, len(block.Transactions())),
+		Withdrawals: make(
+			[]*engineprimitives.Withdrawal, 0, len(block.Withdrawals()),
+		),
+	}
+	for _, tx := range block.Transactions() {
+		bz, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		body.Transactions = append(body.Transactions, bz)
+	}
+	for _, w := range block.Withdrawals() {
+		body.Withdrawals = append(body.Withdrawals, &engineprimitives.Withdrawal{
+			Index:     math.U64(w.Index),
+			Validator: math.ValidatorIndex(w.Validator),
+			Address:   w.Address,
+			Amount:    math.Gwei(w.Amount),
+		})
+	}
+	return body, nil
+}
+
+// ethAPI serves the eth namespace.
+type ethAPI struct {
+	e *Engine
+}
+
+// ChainId serves eth_chainId.
+//
+//nolint:revive,stylecheck // the method name is dictated by the API.
+func (api *ethAPI) ChainId() math.U64 {
+	return math.U64(api.e.chainID)
+}
+
+// BlockNumber serves eth_blockNumber.
+func (api *ethAPI) BlockNumber() math.U64 {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return math.U64(e.chain.headNumber())
+}
+
+// GetBlockByNumber serves eth_getBlockByNumber.
+func (api *ethAPI) GetBlockByNumber(
+	number rpc.BlockNumber,
+	fullTx bool,
+) (map[string]any, error) {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	block, ok := e.chain.byNumber(number)
+	if !ok {
+		//nolint:nilnil // null is the answer for unknown blocks.
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx)
+}
+
+// GetBlockByHash serves eth_getBlockByHash.
+func (api *ethAPI) GetBlockByHash(
+	hash gethprimitives.ExecutionHash,
+	fullTx bool,
+) (map[string]any, error) {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	block, ok := e.chain.get(hash)
+	if !ok {
+		//nolint:nilnil // null is the answer for unknown blocks.
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx)
+}
+
+// GetLogs serves eth_getLogs. Only deposit logs exist on the mock chain, so
+// the logs are filtered by block range and address only.
+func (api *ethAPI) GetLogs(
+	_ context.Context,
+	query filterQuery,
+) ([]*gethprimitives.Log, error) {
+	e := api.e
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if query.BlockHash != nil {
+		return query.filter(e.chain.logs[*query.BlockHash]), nil
+	}
+
+	from, ok := e.chain.byNumber(query.fromBlock())
+	if !ok {
+		return []*gethprimitives.Log{}, nil
+	}
+	to, ok := e.chain.byNumber(query.toBlock())
+	if !ok {
+		to, _ = e.chain.get(e.chain.head)
+	}
+
+	logs := make([]*gethprimitives.Log, 0)
+	for number := from.NumberU64(); number <= to.NumberU64(); number++ {
+		logs = append(
+			logs, query.filter(e.chain.logs[e.chain.canonical[number]])...,
+		)
+	}
+	return logs, nil
+}
+
+// forcedStatus returns the payload status set through SetPayloadStatus.
+func (e *Engine) forcedStatus() engineprimitives.PayloadStatusV1 {
+	if e.status == engineprimitives.PayloadStatusInvalid {
+		return invalidStatus(e.chain.head, "forced invalid status")
+	}
+	return engineprimitives.PayloadStatusV1{Status: e.status}
+}
+
+// validStatus returns a VALID payload status.
+func validStatus(
+	hash gethprimitives.ExecutionHash,
+) engineprimitives.PayloadStatusV1 {
+	return engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &hash,
+	}
+}
+
+// invalidStatus returns an INVALID payload status.
+func invalidStatus(
+	latestValidHash gethprimitives.ExecutionHash,
+	reason string,
+) engineprimitives.PayloadStatusV1 {
+	return engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusInvalid,
+		LatestValidHash: &latestValidHash,
+		ValidationError: &reason,
+	}
+}
+
+// marshalBlock encodes the block in the format of eth_getBlockBy*.
+func marshalBlock(
+	block *gethprimitives.Block,
+	fullTx bool,
+) (map[string]any, error) {
+	bz, err := json.Marshal(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]any)
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	txs := make([]any, 0, len(block.Transactions()))
+	for _, tx := range block.Transactions() {
+		if fullTx {
+			txs = append(txs, tx)
+		} else {
+			txs = append(txs, tx.Hash())
+		}
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []gethprimitives.ExecutionHash{}
+	fields["size"] = math.U64(block.Size())
+	if block.Withdrawals() != nil {
+		fields["withdrawals"] = block.Withdrawals()
+	}
+	return fields, nil
+}
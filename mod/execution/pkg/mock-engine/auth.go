@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
+)
+
+// jwtIssuedAtWindow is how far the issued-at claim of a token may be from
+// the current time, as required by the Engine API.
+const jwtIssuedAtWindow = 60 * time.Second
+
+// jwtHandler rejects requests without a valid Engine API JWT.
+type jwtHandler struct {
+	secret *jwt.Secret
+	next   http.Handler
+}
+
+// newJWTHandler wraps the given handler with JWT authentication.
+func newJWTHandler(secret *jwt.Secret, next http.Handler) http.Handler {
+	return &jwtHandler{secret: secret, next: next}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *jwtHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// authorized returns true if the request carries a token signed with the
+// secret and issued within the allowed window.
+func (h *jwtHandler) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	claims := new(gjwt.RegisteredClaims)
+	if _, err := gjwt.ParseWithClaims(
+		token, claims,
+		func(*gjwt.Token) (any, error) { return h.secret[:], nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+	); err != nil || claims.IssuedAt == nil {
+		return false
+	}
+
+	drift := time.Since(claims.IssuedAt.Time)
+	return drift < jwtIssuedAtWindow && drift > -jwtIssuedAtWindow
+}
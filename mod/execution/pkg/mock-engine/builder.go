@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	depositcontract "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// maxPayloads is the number of built payloads that are kept.
+const maxPayloads = 64
+
+// deposit is a deposit that was injected into the mock engine.
+type deposit struct {
+	pubkey      crypto.BLSPubkey
+	credentials bytes.B32
+	amount      math.Gwei
+	signature   crypto.BLSSignature
+	index       uint64
+}
+
+// builtPayload is a payload built by the mock engine.
+type builtPayload struct {
+	block *gethprimitives.Block
+	// logs are the deposit logs emitted in the block.
+	logs []*gethprimitives.Log
+	// nextDepositIndex is the index of the first deposit not included in
+	// the block.
+	nextDepositIndex uint64
+}
+
+// InjectDeposit queues a deposit that is emitted by the deposit contract in
+// the next block built by the mock engine.
+func (e *Engine) InjectDeposit(
+	pubkey crypto.BLSPubkey,
+	credentials bytes.B32,
+	amount math.Gwei,
+	signature crypto.BLSSignature,
+) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pendingDeposits = append(e.pendingDeposits, &deposit{
+		pubkey:      pubkey,
+		credentials: credentials,
+		amount:      amount,
+		signature:   signature,
+		index:       e.depositCount,
+	})
+	e.depositCount++
+}
+
+// buildPayload builds a payload on top of the given parent. The payload only
+// depends on its inputs, so building it twice yields the same block.
+func (e *Engine) buildPayload(
+	parent *gethprimitives.Block,
+	attrs *gethprimitives.PayloadAttributes,
+) (*builtPayload, error) {
+	var (
+		number          = new(big.Int).Add(parent.Number(), big.NewInt(1))
+		zero            uint64
+		withdrawalsHash = gethprimitives.DeriveSha(
+			gethprimitives.Withdrawals(attrs.Withdrawals),
+			gethprimitives.NewStackTrie(nil),
+		)
+	)
+
+	logs, err := e.depositLogs(number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	var bloom gethprimitives.LogsBloom
+	for _, log := range logs {
+		bloom.Add(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			bloom.Add(topic.Bytes())
+		}
+	}
+
+	header := &gethprimitives.Header{
+		ParentHash:       parent.Hash(),
+		UncleHash:        gethprimitives.EmptyUncleHash,
+		Coinbase:         attrs.SuggestedFeeRecipient,
+		Root:             parent.Root(),
+		TxHash:           gethprimitives.EmptyRootHash,
+		ReceiptHash:      gethprimitives.EmptyRootHash,
+		Bloom:            bloom,
+		Difficulty:       new(big.Int),
+		Number:           number,
+		GasLimit:         parent.GasLimit(),
+		Time:             attrs.Timestamp,
+		MixDigest:        attrs.Random,
+		BaseFee:          parent.BaseFee(),
+		WithdrawalsHash:  &withdrawalsHash,
+		BlobGasUsed:      &zero,
+		ExcessBlobGas:    &zero,
+		ParentBeaconRoot: attrs.BeaconRoot,
+	}
+	block := gethprimitives.NewBlockWithHeader(header).WithBody(
+		gethprimitives.Body{Withdrawals: attrs.Withdrawals},
+	)
+
+	// Stamp the logs with the block they were emitted in.
+	for _, log := range logs {
+		log.BlockHash = block.Hash()
+	}
+	return &builtPayload{
+		block:            block,
+		logs:             logs,
+		nextDepositIndex: e.depositCount,
+	}, nil
+}
+
+// storePayload stores the payload under the given ID, evicting the oldest
+// payload once maxPayloads are held.
+func (e *Engine) storePayload(id engineprimitives.PayloadID, p *builtPayload) {
+	if _, ok := e.payloads[id]; !ok {
+		if len(e.payloadIDs) == maxPayloads {
+			delete(e.payloads, e.payloadIDs[0])
+			e.payloadIDs = e.payloadIDs[1:]
+		}
+		e.payloadIDs = append(e.payloadIDs, id)
+	}
+	e.payloads[id] = p
+}
+
+// depositLogs returns the logs that the deposit contract emits for the
+// pending deposits in the block with the given number.
+func (e *Engine) depositLogs(number uint64) ([]*gethprimitives.Log, error) {
+	if len(e.pendingDeposits) == 0 {
+		return nil, nil
+	}
+
+	abi, err := depositcontract.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	event := abi.Events["Deposit"]
+
+	logs := make([]*gethprimitives.Log, 0, len(e.pendingDeposits))
+	for i, d := range e.pendingDeposits {
+		data, err := event.Inputs.Pack(
+			d.pubkey[:],
+			d.credentials[:],
+			d.amount.Unwrap(),
+			d.signature[:],
+			d.index,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var txHash gethprimitives.ExecutionHash
+		binary.BigEndian.PutUint64(txHash[24:], d.index)
+		logs = append(logs, &gethprimitives.Log{
+			Address:     e.cfg.DepositContractAddress,
+			Topics:      []gethprimitives.ExecutionHash{event.ID},
+			Data:        data,
+			BlockNumber: number,
+			TxHash:      txHash,
+			TxIndex:     uint(i),
+			Index:       uint(i),
+		})
+	}
+	return logs, nil
+}
+
+// payloadID derives the payload ID from the parent and the payload
+// attributes.
+func payloadID(
+	parent gethprimitives.ExecutionHash,
+	attrs *gethprimitives.PayloadAttributes,
+) engineprimitives.PayloadID {
+	hasher := sha256.New()
+	hasher.Write(parent[:])
+	hasher.Write(binary.BigEndian.AppendUint64(nil, attrs.Timestamp))
+	hasher.Write(attrs.Random[:])
+	hasher.Write(attrs.SuggestedFeeRecipient[:])
+	if attrs.BeaconRoot != nil {
+		hasher.Write(attrs.BeaconRoot[:])
+	}
+	for _, w := range attrs.Withdrawals {
+		hasher.Write(binary.BigEndian.AppendUint64(nil, w.Index))
+		hasher.Write(binary.BigEndian.AppendUint64(nil, w.Validator))
+		hasher.Write(w.Address[:])
+		hasher.Write(binary.BigEndian.AppendUint64(nil, w.Amount))
+	}
+
+	var id engineprimitives.PayloadID
+	copy(id[:], hasher.Sum(nil))
+	return id
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+)
+
+// chain is an in-memory execution chain.
+type chain struct {
+	// blocks holds every known block by hash.
+	blocks map[gethprimitives.ExecutionHash]*gethprimitives.Block
+	// logs holds the deposit logs emitted in each block, by block hash.
+	logs map[gethprimitives.ExecutionHash][]*gethprimitives.Log
+	// canonical maps block numbers to the hashes of the canonical chain.
+	canonical map[uint64]gethprimitives.ExecutionHash
+	// head, safe and finalized are the forkchoice of the chain.
+	head, safe, finalized gethprimitives.ExecutionHash
+}
+
+// newChain creates a new chain that starts at the given genesis block.
+func newChain(genesis *gethprimitives.Block) *chain {
+	hash := genesis.Hash()
+	return &chain{
+		blocks: map[gethprimitives.ExecutionHash]*gethprimitives.Block{
+			hash: genesis,
+		},
+		logs: make(map[gethprimitives.ExecutionHash][]*gethprimitives.Log),
+		canonical: map[uint64]gethprimitives.ExecutionHash{
+			genesis.NumberU64(): hash,
+		},
+		head:      hash,
+		safe:      hash,
+		finalized: hash,
+	}
+}
+
+// get returns the block with the given hash.
+func (c *chain) get(
+	hash gethprimitives.ExecutionHash,
+) (*gethprimitives.Block, bool) {
+	block, ok := c.blocks[hash]
+	return block, ok
+}
+
+// insert adds a block, along with its deposit logs, to the chain. The block
+// does not become canonical until it is made the head.
+func (c *chain) insert(
+	block *gethprimitives.Block,
+	logs []*gethprimitives.Log,
+) {
+	c.blocks[block.Hash()] = block
+	if len(logs) > 0 {
+		c.logs[block.Hash()] = logs
+	}
+}
+
+// setForkchoice moves the head of the chain and rewrites the canonical chain
+// to end at the new head.
+func (c *chain) setForkchoice(
+	head, safe, finalized gethprimitives.ExecutionHash,
+) bool {
+	block, ok := c.blocks[head]
+	if !ok {
+		return false
+	}
+
+	// Drop canonical entries past the new head, then walk back from the new
+	// head until we meet the existing canonical chain.
+	for number := block.NumberU64() + 1; ; number++ {
+		if _, ok = c.canonical[number]; !ok {
+			break
+		}
+		delete(c.canonical, number)
+	}
+	for block != nil && c.canonical[block.NumberU64()] != block.Hash() {
+		c.canonical[block.NumberU64()] = block.Hash()
+		block = c.blocks[block.ParentHash()]
+	}
+
+	c.head = head
+	if _, ok = c.blocks[safe]; ok {
+		c.safe = safe
+	}
+	if _, ok = c.blocks[finalized]; ok {
+		c.finalized = finalized
+	}
+	return true
+}
+
+// byNumber returns the canonical block with the given number, resolving the
+// latest, safe and finalized tags.
+func (c *chain) byNumber(number rpc.BlockNumber) (*gethprimitives.Block, bool) {
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return c.get(c.head)
+	case rpc.SafeBlockNumber:
+		return c.get(c.safe)
+	case rpc.FinalizedBlockNumber:
+		return c.get(c.finalized)
+	case rpc.EarliestBlockNumber:
+		number = 0
+	}
+	if number < 0 {
+		return nil, false
+	}
+
+	hash, ok := c.canonical[uint64(number)]
+	if !ok {
+		return nil, false
+	}
+	return c.get(hash)
+}
+
+// headNumber returns the number of the head block.
+func (c *chain) headNumber() uint64 {
+	return c.blocks[c.head].NumberU64()
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+)
+
+// readHeaderTimeout is the header read timeout of the HTTP server.
+const readHeaderTimeout = 5 * time.Second
+
+// Config is the configuration of the mock engine.
+type Config struct {
+	// Address is the address the server listens on. Use port 0 to pick a
+	// free port.
+	Address string
+	// DepositContractAddress is the address that deposit logs are emitted
+	// from.
+	DepositContractAddress gethprimitives.ExecutionAddress
+	// JWTSecret is the secret used to authenticate requests. Authentication
+	// is disabled if it is nil.
+	JWTSecret *jwt.Secret
+}
+
+// Engine is an in-process execution client that serves the subset of the
+// Engine API and eth namespace used by beacon-kit over an in-memory chain.
+// Payloads are built deterministically from the payload attributes and carry
+// no transactions.
+type Engine struct {
+	cfg     Config
+	chainID uint64
+
+	mu sync.Mutex
+	// chain is the in-memory execution chain.
+	chain *chain
+	// payloads holds the payloads that are being built, by payload ID.
+	payloads map[engineprimitives.PayloadID]*builtPayload
+	// payloadIDs are the IDs of the payloads, oldest first.
+	payloadIDs []engineprimitives.PayloadID
+	// pendingDeposits are the deposits waiting to be included in a block.
+	pendingDeposits []*deposit
+	// depositCount is the number of deposits injected so far.
+	depositCount uint64
+	// status overrides the status returned by newPayload and
+	// forkchoiceUpdated when set.
+	status engineprimitives.PayloadStatusStr
+
+	server   *http.Server
+	listener net.Listener
+}
+
+// New creates a new mock engine whose chain starts at the block described
+// by the given genesis.
+func New(cfg Config, genesis *gethprimitives.Genesis) (*Engine, error) {
+	if genesis == nil || genesis.Config == nil ||
+		genesis.Config.ChainID == nil {
+		return nil, ErrInvalidGenesis
+	}
+	return &Engine{
+		cfg:      cfg,
+		chainID:  genesis.Config.ChainID.Uint64(),
+		chain:    newChain(genesis.ToBlock()),
+		payloads: make(map[engineprimitives.PayloadID]*builtPayload),
+	}, nil
+}
+
+// Handler returns the HTTP handler that serves the JSON-RPC API.
+func (e *Engine) Handler() (http.Handler, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("engine", &engineAPI{e: e}); err != nil {
+		return nil, err
+	}
+	if err := server.RegisterName("eth", &ethAPI{e: e}); err != nil {
+		return nil, err
+	}
+	if e.cfg.JWTSecret == nil {
+		return server, nil
+	}
+	return newJWTHandler(e.cfg.JWTSecret, server), nil
+}
+
+// Start starts serving the JSON-RPC API on the configured address.
+func (e *Engine) Start(ctx context.Context) error {
+	handler, err := e.Handler()
+	if err != nil {
+		return err
+	}
+
+	var lc net.ListenConfig
+	if e.listener, err = lc.Listen(ctx, "tcp", e.cfg.Address); err != nil {
+		return err
+	}
+	e.server = &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		//#nosec:G104 // the error is always ErrServerClosed on Stop.
+		_ = e.server.Serve(e.listener)
+	}()
+	return nil
+}
+
+// Stop stops serving the JSON-RPC API.
+func (e *Engine) Stop() error {
+	if e.server == nil {
+		return nil
+	}
+	err := e.server.Close()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// URL returns the url the JSON-RPC API is served on, to be used as the
+// dial url of the engine client.
+func (e *Engine) URL() string {
+	if e.listener == nil {
+		return ""
+	}
+	return "http://" + e.listener.Addr().String()
+}
+
+// SetPayloadStatus forces newPayload and forkchoiceUpdated to answer with
+// the given status, i.e. SYNCING, ACCEPTED or INVALID. An empty status
+// restores normal operation.
+func (e *Engine) SetPayloadStatus(status engineprimitives.PayloadStatusStr) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.status = status
+}
+
+// Head returns the current head block of the chain.
+func (e *Engine) Head() *gethprimitives.Block {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	head, _ := e.chain.get(e.chain.head)
+	return head
+}
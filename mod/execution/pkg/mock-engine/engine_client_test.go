@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	mockengine "github.com/berachain/beacon-kit/mod/execution/pkg/mock-engine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	withdrawal        = engineprimitives.Withdrawal
+	payloadAttributes = engineprimitives.PayloadAttributes[*withdrawal]
+	engineClient      = client.EngineClient[*executionPayload, *payloadAttributes]
+)
+
+// executionPayload is the execution payload exchanged by the engine client
+// in these tests.
+type executionPayload struct {
+	gethprimitives.ExecutableData
+}
+
+func (*executionPayload) Empty(uint32) *executionPayload {
+	return &executionPayload{}
+}
+
+func (*executionPayload) Version() uint32 { return version.Deneb }
+
+func (p *executionPayload) IsNil() bool { return p == nil }
+
+func (p *executionPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.ExecutableData)
+}
+
+func (p *executionPayload) UnmarshalJSON(input []byte) error {
+	return p.ExecutableData.UnmarshalJSON(input)
+}
+
+// noopSink is a telemetry sink that discards every metric.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// newEngineClient starts an engine client connected to a new mock engine
+// over JWT authenticated HTTP.
+func newEngineClient(t *testing.T) (*mockengine.Engine, *engineClient) {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	engine, _ := newTestEngine(t, secret)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL, err = url.NewFromRaw(engine.URL())
+	require.NoError(t, err)
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	c := client.New[*executionPayload, *payloadAttributes](
+		&cfg,
+		noop.NewLogger[any](),
+		secret,
+		noopSink{},
+		big.NewInt(80087),
+		engineprimitives.ClientVersionV1{},
+	)
+	require.NoError(t, c.Start(ctx))
+	return engine, c
+}
+
+func TestEngineClientBuildsAndImportsBlocks(t *testing.T) {
+	engine, c := newEngineClient(t)
+	ctx := context.Background()
+	genesis := engine.Head()
+	beaconRoot := common.Root{0xbe}
+
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		version.Deneb,
+		genesis.Time()+1,
+		common.Bytes32{0x01},
+		gethprimitives.HexToAddress("0x02"),
+		[]*withdrawal{},
+		beaconRoot,
+	)
+	require.NoError(t, err)
+	id, _, err := c.ForkchoiceUpdated(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      genesis.Hash(),
+		SafeBlockHash:      genesis.Hash(),
+		FinalizedBlockHash: genesis.Hash(),
+	}, attrs, version.Deneb)
+	require.NoError(t, err)
+	require.NotNil(t, id)
+
+	envelope, err := c.GetPayload(ctx, *id, version.Deneb)
+	require.NoError(t, err)
+	payload := envelope.GetExecutionPayload()
+	require.Equal(t, genesis.Hash(), payload.ParentHash)
+
+	_, err = c.NewPayload(
+		ctx, payload, []gethprimitives.ExecutionHash{}, &beaconRoot,
+	)
+	require.NoError(t, err)
+	_, _, err = c.ForkchoiceUpdated(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      payload.BlockHash,
+		SafeBlockHash:      payload.BlockHash,
+		FinalizedBlockHash: genesis.Hash(),
+	}, nil, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, engine.Head().Hash())
+
+	// The knobs of the mock are seen by the engine client.
+	engine.SetPayloadStatus(engineprimitives.PayloadStatusSyncing)
+	_, _, err = c.ForkchoiceUpdated(ctx, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash: payload.BlockHash,
+	}, nil, version.Deneb)
+	require.ErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+}
@@ -0,0 +1,349 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	mockengine "github.com/berachain/beacon-kit/mod/execution/pkg/mock-engine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/ethclient"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesis = `{
+	"config": {
+		"chainId": 80087,
+		"homesteadBlock": 0,
+		"eip150Block": 0,
+		"eip155Block": 0,
+		"eip158Block": 0,
+		"byzantiumBlock": 0,
+		"constantinopleBlock": 0,
+		"petersburgBlock": 0,
+		"istanbulBlock": 0,
+		"berlinBlock": 0,
+		"londonBlock": 0,
+		"shanghaiTime": 0,
+		"cancunTime": 0,
+		"terminalTotalDifficulty": 0,
+		"terminalTotalDifficultyPassed": true
+	},
+	"gasLimit": "0x1c9c380",
+	"difficulty": "0x0",
+	"timestamp": "0x0",
+	"alloc": {}
+}`
+
+var depositContract = gethprimitives.HexToAddress(
+	"0x4242424242424242424242424242424242424242",
+)
+
+func newTestEngine(
+	t *testing.T,
+	secret *jwt.Secret,
+) (*mockengine.Engine, *rpc.Client) {
+	t.Helper()
+	genesis := new(gethprimitives.Genesis)
+	require.NoError(t, json.Unmarshal([]byte(testGenesis), genesis))
+
+	engine, err := mockengine.New(mockengine.Config{
+		Address:                "127.0.0.1:0",
+		DepositContractAddress: depositContract,
+		JWTSecret:              secret,
+	}, genesis)
+	require.NoError(t, err)
+	require.NoError(t, engine.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, engine.Stop()) })
+
+	client, err := rpc.DialContext(context.Background(), engine.URL())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return engine, client
+}
+
+func forkchoiceUpdated(
+	t *testing.T,
+	client *rpc.Client,
+	head gethprimitives.ExecutionHash,
+	attrs *gethprimitives.PayloadAttributes,
+) engineprimitives.ForkchoiceResponseV1 {
+	t.Helper()
+	var res engineprimitives.ForkchoiceResponseV1
+	require.NoError(t, client.CallContext(
+		context.Background(), &res, "engine_forkchoiceUpdatedV3",
+		engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      head,
+			SafeBlockHash:      head,
+			FinalizedBlockHash: head,
+		}, attrs,
+	))
+	return res
+}
+
+func newPayload(
+	t *testing.T,
+	client *rpc.Client,
+	data *gethprimitives.ExecutableData,
+	beaconRoot *gethprimitives.ExecutionHash,
+) engineprimitives.PayloadStatusV1 {
+	t.Helper()
+	var res engineprimitives.PayloadStatusV1
+	require.NoError(t, client.CallContext(
+		context.Background(), &res, "engine_newPayloadV3",
+		data, []gethprimitives.ExecutionHash{}, beaconRoot,
+	))
+	return res
+}
+
+func TestEngineBuildsAndImportsBlocks(t *testing.T) {
+	engine, client := newTestEngine(t, nil)
+	genesis := engine.Head()
+
+	engine.InjectDeposit(
+		crypto.BLSPubkey{0x01}, bytes.B32{0x02}, math.Gwei(32e9),
+		crypto.BLSSignature{0x03},
+	)
+
+	beaconRoot := gethprimitives.HexToHash("0xbeac")
+	attrs := &gethprimitives.PayloadAttributes{
+		Timestamp:             genesis.Time() + 1,
+		Random:                gethprimitives.HexToHash("0x01"),
+		SuggestedFeeRecipient: gethprimitives.HexToAddress("0x02"),
+		Withdrawals:           []*gethprimitives.Withdrawal{},
+		BeaconRoot:            &beaconRoot,
+	}
+
+	// Building twice from the same inputs yields the same payload.
+	res := forkchoiceUpdated(t, client, genesis.Hash(), attrs)
+	require.Equal(t, engineprimitives.PayloadStatusValid, res.PayloadStatus.Status)
+	require.NotNil(t, res.PayloadID)
+	again := forkchoiceUpdated(t, client, genesis.Hash(), attrs)
+	require.Equal(t, *res.PayloadID, *again.PayloadID)
+
+	var envelope gethprimitives.ExecutionPayloadEnvelope
+	require.NoError(t, client.CallContext(
+		context.Background(), &envelope, "engine_getPayloadV3", res.PayloadID,
+	))
+	require.NotNil(t, envelope.BlobsBundle)
+	payload := envelope.ExecutionPayload
+	require.Equal(t, genesis.Hash(), payload.ParentHash)
+	require.Equal(t, uint64(1), payload.Number)
+
+	status := newPayload(t, client, payload, &beaconRoot)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+	res = forkchoiceUpdated(t, client, payload.BlockHash, nil)
+	require.Equal(t, engineprimitives.PayloadStatusValid, res.PayloadStatus.Status)
+
+	// The new head is served through the eth namespace.
+	eth := ethclient.NewClient(client)
+	header, err := eth.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, header.Hash())
+
+	// The injected deposit is emitted by the deposit contract.
+	var logs []gethprimitives.Log
+	require.NoError(t, client.CallContext(
+		context.Background(), &logs, "eth_getLogs", map[string]any{
+			"address":   []gethprimitives.ExecutionAddress{depositContract},
+			"fromBlock": "0x1",
+			"toBlock":   "0x1",
+		},
+	))
+	require.Len(t, logs, 1)
+	require.Equal(t, payload.BlockHash, logs[0].BlockHash)
+
+	// Payload bodies are served by hash and by range, with a null body for
+	// unknown blocks.
+	var bodies []*engineprimitives.ExecutionPayloadBodyV1
+	require.NoError(t, client.CallContext(
+		context.Background(), &bodies, "engine_getPayloadBodiesByHashV1",
+		[]gethprimitives.ExecutionHash{
+			payload.BlockHash, gethprimitives.HexToHash("0xdead"),
+		},
+	))
+	require.Len(t, bodies, 2)
+	require.NotNil(t, bodies[0])
+	require.Nil(t, bodies[1])
+	require.NoError(t, client.CallContext(
+		context.Background(), &bodies, "engine_getPayloadBodiesByRangeV1",
+		math.U64(1), math.U64(8),
+	))
+	require.Len(t, bodies, 1)
+	require.NotNil(t, bodies[0])
+}
+
+func TestEngineForcedStatus(t *testing.T) {
+	engine, client := newTestEngine(t, nil)
+	engine.SetPayloadStatus(engineprimitives.PayloadStatusSyncing)
+
+	res := forkchoiceUpdated(t, client, engine.Head().Hash(), nil)
+	require.Equal(
+		t, engineprimitives.PayloadStatusSyncing, res.PayloadStatus.Status,
+	)
+
+	engine.SetPayloadStatus(engineprimitives.PayloadStatusInvalid)
+	res = forkchoiceUpdated(t, client, engine.Head().Hash(), nil)
+	require.Equal(
+		t, engineprimitives.PayloadStatusInvalid, res.PayloadStatus.Status,
+	)
+
+	engine.SetPayloadStatus("")
+	res = forkchoiceUpdated(t, client, engine.Head().Hash(), nil)
+	require.Equal(
+		t, engineprimitives.PayloadStatusValid, res.PayloadStatus.Status,
+	)
+}
+
+func TestEngineRequiresJWT(t *testing.T) {
+	secret := new(jwt.Secret)
+	secret[0] = 0x01
+	_, client := newTestEngine(t, secret)
+
+	var chainID math.U64
+	require.Error(t, client.CallContext(
+		context.Background(), &chainID, "eth_chainId",
+	))
+
+	engine, _ := newTestEngine(t, secret)
+	token, err := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
+		"iat": &gjwt.NumericDate{Time: time.Now()},
+	}).SignedString(secret[:])
+	require.NoError(t, err)
+
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer "+token)
+	authed, err := rpc.DialOptions(
+		context.Background(), engine.URL(), rpc.WithHeaders(header),
+	)
+	require.NoError(t, err)
+	defer authed.Close()
+	require.NoError(t, authed.CallContext(
+		context.Background(), &chainID, "eth_chainId",
+	))
+	require.Equal(t, math.U64(80087), chainID)
+}
+
+// buildPayload builds a payload on top of the given parent at the given
+// timestamp and returns it.
+func buildPayload(
+	t *testing.T,
+	client *rpc.Client,
+	parent gethprimitives.ExecutionHash,
+	timestamp uint64,
+	beaconRoot *gethprimitives.ExecutionHash,
+) (engineprimitives.PayloadID, *gethprimitives.ExecutableData) {
+	t.Helper()
+	res := forkchoiceUpdated(t, client, parent, &gethprimitives.PayloadAttributes{
+		Timestamp:   timestamp,
+		Withdrawals: []*gethprimitives.Withdrawal{},
+		BeaconRoot:  beaconRoot,
+	})
+	require.NotNil(t, res.PayloadID)
+
+	var envelope gethprimitives.ExecutionPayloadEnvelope
+	require.NoError(t, client.CallContext(
+		context.Background(), &envelope, "engine_getPayloadV3", res.PayloadID,
+	))
+	return *res.PayloadID, envelope.ExecutionPayload
+}
+
+func TestEngineImportsSiblingPayloads(t *testing.T) {
+	engine, client := newTestEngine(t, nil)
+	genesis := engine.Head()
+	beaconRoot := gethprimitives.HexToHash("0xbeac")
+
+	// The first sibling includes one deposit, the second both.
+	engine.InjectDeposit(
+		crypto.BLSPubkey{0x01}, bytes.B32{}, 32e9, crypto.BLSSignature{},
+	)
+	_, first := buildPayload(
+		t, client, genesis.Hash(), genesis.Time()+1, &beaconRoot,
+	)
+	engine.InjectDeposit(
+		crypto.BLSPubkey{0x02}, bytes.B32{}, 32e9, crypto.BLSSignature{},
+	)
+	_, second := buildPayload(
+		t, client, genesis.Hash(), genesis.Time()+2, &beaconRoot,
+	)
+
+	// Importing the sibling that includes fewer deposits last must not
+	// re-queue or drop deposits.
+	status := newPayload(t, client, second, &beaconRoot)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+	status = newPayload(t, client, first, &beaconRoot)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+	res := forkchoiceUpdated(t, client, first.BlockHash, nil)
+	require.Equal(
+		t, engineprimitives.PayloadStatusValid, res.PayloadStatus.Status,
+	)
+
+	// Both deposits were included by an imported block, so the next block
+	// emits only the deposits injected since.
+	engine.InjectDeposit(
+		crypto.BLSPubkey{0x03}, bytes.B32{}, 32e9, crypto.BLSSignature{},
+	)
+	_, next := buildPayload(
+		t, client, first.BlockHash, first.Timestamp+1, &beaconRoot,
+	)
+	status = newPayload(t, client, next, &beaconRoot)
+	require.Equal(t, engineprimitives.PayloadStatusValid, status.Status)
+	forkchoiceUpdated(t, client, next.BlockHash, nil)
+
+	var logs []gethprimitives.Log
+	require.NoError(t, client.CallContext(
+		context.Background(), &logs, "eth_getLogs", map[string]any{
+			"address":   []gethprimitives.ExecutionAddress{depositContract},
+			"fromBlock": "0x2",
+			"toBlock":   "0x2",
+		},
+	))
+	require.Len(t, logs, 1)
+}
+
+func TestEngineEvictsOldPayloads(t *testing.T) {
+	engine, client := newTestEngine(t, nil)
+	genesis := engine.Head()
+	beaconRoot := gethprimitives.HexToHash("0xbeac")
+
+	oldest, _ := buildPayload(
+		t, client, genesis.Hash(), genesis.Time()+1, &beaconRoot,
+	)
+	for i := range uint64(64) {
+		buildPayload(t, client, genesis.Hash(), genesis.Time()+2+i, &beaconRoot)
+	}
+
+	var envelope gethprimitives.ExecutionPayloadEnvelope
+	require.Error(t, client.CallContext(
+		context.Background(), &envelope, "engine_getPayloadV3", oldest,
+	))
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidGenesis is returned when the genesis has no chain config.
+	ErrInvalidGenesis = errors.New("genesis must have a chain ID")
+
+	// errUnknownPayload is returned when the requested payload is not being
+	// built.
+	errUnknownPayload = &rpcError{code: -38001, msg: "Unknown payload"}
+
+	// errInvalidForkchoiceState is returned when the forkchoice state
+	// references an invalid block.
+	errInvalidForkchoiceState = &rpcError{
+		code: -38002, msg: "Invalid forkchoice state",
+	}
+
+	// errInvalidPayloadAttributes is returned when the payload attributes
+	// are invalid.
+	errInvalidPayloadAttributes = &rpcError{
+		code: -38003, msg: "Invalid payload attributes",
+	}
+
+	// errRequestTooLarge is returned when too many payload bodies are
+	// requested.
+	errRequestTooLarge = &rpcError{code: -38004, msg: "Too large request"}
+
+	// errInvalidParams is returned when the request parameters are invalid.
+	errInvalidParams = &rpcError{code: -32602, msg: "Invalid params"}
+)
+
+// rpcError is a JSON-RPC error with an Engine API error code.
+type rpcError struct {
+	code int
+	msg  string
+}
+
+// Error returns the error message.
+func (e *rpcError) Error() string {
+	return e.msg
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *rpcError) ErrorCode() int {
+	return e.code
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"encoding/json"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+)
+
+// filterQuery is the argument of eth_getLogs.
+type filterQuery struct {
+	BlockHash *gethprimitives.ExecutionHash `json:"blockHash"`
+	FromBlock *rpc.BlockNumber              `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber              `json:"toBlock"`
+	Addresses addresses                     `json:"address"`
+}
+
+// fromBlock returns the first block of the range, defaulting to the head.
+func (q filterQuery) fromBlock() rpc.BlockNumber {
+	if q.FromBlock == nil {
+		return rpc.LatestBlockNumber
+	}
+	return *q.FromBlock
+}
+
+// toBlock returns the last block of the range, defaulting to the head.
+func (q filterQuery) toBlock() rpc.BlockNumber {
+	if q.ToBlock == nil {
+		return rpc.LatestBlockNumber
+	}
+	return *q.ToBlock
+}
+
+// filter returns the logs that were emitted by one of the queried
+// addresses.
+func (q filterQuery) filter(
+	logs []*gethprimitives.Log,
+) []*gethprimitives.Log {
+	if len(q.Addresses) == 0 {
+		return logs
+	}
+	filtered := make([]*gethprimitives.Log, 0, len(logs))
+	for _, log := range logs {
+		for _, address := range q.Addresses {
+			if log.Address == address {
+				filtered = append(filtered, log)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// addresses is a list of addresses that may be encoded as a single address.
+type addresses []gethprimitives.ExecutionAddress
+
+// UnmarshalJSON decodes a single address or a list of addresses.
+func (a *addresses) UnmarshalJSON(input []byte) error {
+	var address gethprimitives.ExecutionAddress
+	if err := json.Unmarshal(input, &address); err == nil {
+		*a = addresses{address}
+		return nil
+	}
+	return json.Unmarshal(input, (*[]gethprimitives.ExecutionAddress)(a))
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reconstructor
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Reconstructor rebuilds full beacon blocks from blinded beacon blocks by
+// fetching their execution payload bodies from the execution client. Bodies
+// are kept in a small LRU cache keyed by execution block hash.
+type Reconstructor[
+	BeaconBlockT any,
+	BlindedBeaconBlockT BlindedBeaconBlock[
+		BeaconBlockT, BlindedBeaconBlockBodyT, ExecutionPayloadHeaderT,
+	],
+	BlindedBeaconBlockBodyT BlindedBeaconBlockBody[ExecutionPayloadHeaderT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+] struct {
+	// fetcher retrieves payload bodies from the execution client.
+	fetcher PayloadBodyFetcher
+	// cache maps execution block hashes to their payload bodies.
+	cache *lru.Cache[
+		gethprimitives.ExecutionHash,
+		*engineprimitives.ExecutionPayloadBodyV1,
+	]
+}
+
+// New creates a new Reconstructor with a cache of the given size.
+func New[
+	BeaconBlockT any,
+	BlindedBeaconBlockT BlindedBeaconBlock[
+		BeaconBlockT, BlindedBeaconBlockBodyT, ExecutionPayloadHeaderT,
+	],
+	BlindedBeaconBlockBodyT BlindedBeaconBlockBody[ExecutionPayloadHeaderT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+](
+	fetcher PayloadBodyFetcher,
+	cacheSize int,
+) (*Reconstructor[
+	BeaconBlockT, BlindedBeaconBlockT,
+	BlindedBeaconBlockBodyT, ExecutionPayloadHeaderT,
+], error) {
+	if cacheSize <= 0 {
+		return nil, ErrInvalidCacheSize
+	}
+
+	cache, err := lru.New[
+		gethprimitives.ExecutionHash,
+		*engineprimitives.ExecutionPayloadBodyV1,
+	](cacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reconstructor[
+		BeaconBlockT, BlindedBeaconBlockT,
+		BlindedBeaconBlockBodyT, ExecutionPayloadHeaderT,
+	]{
+		fetcher: fetcher,
+		cache:   cache,
+	}, nil
+}
+
+// ReconstructBlock rebuilds the full beacon block of the given blinded block.
+func (r *Reconstructor[
+	BeaconBlockT, BlindedBeaconBlockT, _, _,
+]) ReconstructBlock(
+	ctx context.Context,
+	blk BlindedBeaconBlockT,
+) (BeaconBlockT, error) {
+	blks, err := r.ReconstructBlocks(ctx, []BlindedBeaconBlockT{blk})
+	if err != nil {
+		var t BeaconBlockT
+		return t, err
+	}
+	return blks[0], nil
+}
+
+// ReconstructBlocks rebuilds the full beacon blocks of the given blinded
+// blocks. Payload bodies missing from the cache are fetched by range when
+// the blocks have consecutive execution numbers, and by hash otherwise.
+func (r *Reconstructor[
+	BeaconBlockT, BlindedBeaconBlockT, _, ExecutionPayloadHeaderT,
+]) ReconstructBlocks(
+	ctx context.Context,
+	blks []BlindedBeaconBlockT,
+) ([]BeaconBlockT, error) {
+	var (
+		headers = make([]ExecutionPayloadHeaderT, len(blks))
+		bodies  = make(
+			[]*engineprimitives.ExecutionPayloadBodyV1, len(blks),
+		)
+		missing []int
+	)
+
+	for i, blk := range blks {
+		headers[i] = blk.GetBody().GetExecutionPayloadHeader()
+		body, ok := r.cache.Get(headers[i].GetBlockHash())
+		if !ok {
+			missing = append(missing, i)
+			continue
+		}
+		bodies[i] = body
+	}
+
+	if err := r.fetch(ctx, headers, bodies, missing); err != nil {
+		return nil, err
+	}
+
+	result := make([]BeaconBlockT, len(blks))
+	for i, blk := range blks {
+		full, err := blk.Unblind(bodies[i])
+		if err != nil {
+			return nil, err
+		}
+		r.cache.Add(headers[i].GetBlockHash(), bodies[i])
+		result[i] = full
+	}
+	return result, nil
+}
+
+// fetch retrieves the payload bodies at the missing indices from the
+// execution client.
+func (r *Reconstructor[_, _, _, ExecutionPayloadHeaderT]) fetch(
+	ctx context.Context,
+	headers []ExecutionPayloadHeaderT,
+	bodies []*engineprimitives.ExecutionPayloadBodyV1,
+	missing []int,
+) error {
+	var (
+		fetched []*engineprimitives.ExecutionPayloadBodyV1
+		err     error
+	)
+
+	switch {
+	case len(missing) == 0:
+		return nil
+	case len(missing) > 1 && isContiguous(headers, missing):
+		fetched, err = r.fetcher.GetPayloadBodiesByRange(
+			ctx,
+			headers[missing[0]].GetNumber(),
+			math.U64(len(missing)),
+		)
+	default:
+		hashes := make([]gethprimitives.ExecutionHash, len(missing))
+		for i, idx := range missing {
+			hashes[i] = headers[idx].GetBlockHash()
+		}
+		fetched, err = r.fetcher.GetPayloadBodiesByHash(ctx, hashes)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, idx := range missing {
+		if i >= len(fetched) || fetched[i] == nil {
+			return errors.Wrapf(
+				ErrPayloadBodyUnavailable,
+				"block hash %s", headers[idx].GetBlockHash(),
+			)
+		}
+		bodies[idx] = fetched[i]
+	}
+	return nil
+}
+
+// isContiguous returns true if the execution numbers of the headers at the
+// given indices increase by exactly one.
+func isContiguous[ExecutionPayloadHeaderT ExecutionPayloadHeader](
+	headers []ExecutionPayloadHeaderT,
+	indices []int,
+) bool {
+	for i := 1; i < len(indices); i++ {
+		if headers[indices[i]].GetNumber() !=
+			headers[indices[i-1]].GetNumber()+1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reconstructor_test
+
+import (
+	"context"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/reconstructor"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type header struct{ number math.U64 }
+
+func (h header) GetBlockHash() gethprimitives.ExecutionHash {
+	return gethprimitives.ExecutionHash{byte(h.number)}
+}
+
+func (h header) GetNumber() math.U64 { return h.number }
+
+type body struct{ header header }
+
+func (b body) GetExecutionPayloadHeader() header { return b.header }
+
+type block struct {
+	number math.U64
+	body   *engineprimitives.ExecutionPayloadBodyV1
+}
+
+type blindedBlock struct{ header header }
+
+func (b blindedBlock) GetBody() body { return body(b) }
+
+func (b blindedBlock) Unblind(
+	payloadBody *engineprimitives.ExecutionPayloadBodyV1,
+) (block, error) {
+	return block{number: b.header.number, body: payloadBody}, nil
+}
+
+type fetcher struct {
+	byHash, byRange int
+	unknown         map[math.U64]bool
+}
+
+func (f *fetcher) GetPayloadBodiesByHash(
+	_ context.Context,
+	hashes []gethprimitives.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	f.byHash++
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, len(hashes))
+	for i, hash := range hashes {
+		if !f.unknown[math.U64(hash[0])] {
+			bodies[i] = &engineprimitives.ExecutionPayloadBodyV1{}
+		}
+	}
+	return bodies, nil
+}
+
+func (f *fetcher) GetPayloadBodiesByRange(
+	_ context.Context,
+	_, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	f.byRange++
+	bodies := make([]*engineprimitives.ExecutionPayloadBodyV1, count)
+	for i := range bodies {
+		bodies[i] = &engineprimitives.ExecutionPayloadBodyV1{}
+	}
+	return bodies, nil
+}
+
+func newReconstructor(
+	t *testing.T,
+	f *fetcher,
+) *reconstructor.Reconstructor[block, blindedBlock, body, header] {
+	t.Helper()
+	r, err := reconstructor.New[block, blindedBlock, body, header](f, 4)
+	require.NoError(t, err)
+	return r
+}
+
+func TestReconstructor_Cache(t *testing.T) {
+	f := &fetcher{}
+	r := newReconstructor(t, f)
+
+	blk := blindedBlock{header: header{number: 1}}
+	full, err := r.ReconstructBlock(context.Background(), blk)
+	require.NoError(t, err)
+	require.Equal(t, math.U64(1), full.number)
+	require.NotNil(t, full.body)
+
+	_, err = r.ReconstructBlock(context.Background(), blk)
+	require.NoError(t, err)
+	require.Equal(t, 1, f.byHash)
+	require.Equal(t, 0, f.byRange)
+}
+
+func TestReconstructor_ByRange(t *testing.T) {
+	f := &fetcher{}
+	r := newReconstructor(t, f)
+
+	blks := []blindedBlock{
+		{header: header{number: 5}},
+		{header: header{number: 6}},
+		{header: header{number: 7}},
+	}
+	full, err := r.ReconstructBlocks(context.Background(), blks)
+	require.NoError(t, err)
+	require.Len(t, full, 3)
+	require.Equal(t, 1, f.byRange)
+
+	// Non-contiguous blocks are fetched by hash.
+	_, err = r.ReconstructBlocks(context.Background(), []blindedBlock{
+		{header: header{number: 10}},
+		{header: header{number: 12}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, f.byHash)
+}
+
+func TestReconstructor_Unavailable(t *testing.T) {
+	f := &fetcher{unknown: map[math.U64]bool{3: true}}
+	r := newReconstructor(t, f)
+
+	_, err := r.ReconstructBlock(
+		context.Background(), blindedBlock{header: header{number: 3}},
+	)
+	require.ErrorIs(t, err, reconstructor.ErrPayloadBodyUnavailable)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reconstructor
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlindedBeaconBlock is a beacon block that only carries the header of its
+// execution payload.
+type BlindedBeaconBlock[
+	BeaconBlockT any,
+	BlindedBeaconBlockBodyT BlindedBeaconBlockBody[ExecutionPayloadHeaderT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+] interface {
+	// GetBody returns the blinded body of the block.
+	GetBody() BlindedBeaconBlockBodyT
+	// Unblind rebuilds the full block from the given payload body.
+	Unblind(
+		payloadBody *engineprimitives.ExecutionPayloadBodyV1,
+	) (BeaconBlockT, error)
+}
+
+// BlindedBeaconBlockBody is the body of a blinded beacon block.
+type BlindedBeaconBlockBody[ExecutionPayloadHeaderT any] interface {
+	// GetExecutionPayloadHeader returns the execution payload header.
+	GetExecutionPayloadHeader() ExecutionPayloadHeaderT
+}
+
+// ExecutionPayloadHeader is the header of an execution payload.
+type ExecutionPayloadHeader interface {
+	// GetBlockHash returns the execution block hash.
+	GetBlockHash() gethprimitives.ExecutionHash
+	// GetNumber returns the execution block number.
+	GetNumber() math.U64
+}
+
+// PayloadBodyFetcher retrieves execution payload bodies from the execution
+// client.
+type PayloadBodyFetcher interface {
+	// GetPayloadBodiesByHash returns the payload bodies of the given block
+	// hashes.
+	GetPayloadBodiesByHash(
+		ctx context.Context,
+		hashes []gethprimitives.ExecutionHash,
+	) ([]*engineprimitives.ExecutionPayloadBodyV1, error)
+	// GetPayloadBodiesByRange returns the payload bodies of the count blocks
+	// starting at the given block number.
+	GetPayloadBodiesByRange(
+		ctx context.Context,
+		start, count math.U64,
+	) ([]*engineprimitives.ExecutionPayloadBodyV1, error)
+}
@@ -21,6 +21,7 @@
 package gethprimitives
 
 import (
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -38,19 +39,21 @@ type (
 	// currently a Keccak256 hash.
 	ExecutionHash = common.Hash
 	// DisplayBytes is an alias for common.PrettyBytes.
-	DisplayBytes   = common.PrettyBytes
-	ExecutableData = engine.ExecutableData
-	Genesis        = core.Genesis
-	Block          = coretypes.Block
-	Body           = coretypes.Body
-	Log            = coretypes.Log
-	LogsBloom      = coretypes.Bloom
-	Header         = coretypes.Header
-	Receipt        = coretypes.Receipt
-	Transaction    = coretypes.Transaction
-	Transactions   = coretypes.Transactions
-	Withdrawal     = coretypes.Withdrawal
-	Withdrawals    = coretypes.Withdrawals
+	DisplayBytes             = common.PrettyBytes
+	ExecutableData           = engine.ExecutableData
+	ExecutionPayloadEnvelope = engine.ExecutionPayloadEnvelope
+	PayloadAttributes        = engine.PayloadAttributes
+	Genesis                  = core.Genesis
+	Block                    = coretypes.Block
+	Body                     = coretypes.Body
+	Log                      = coretypes.Log
+	LogsBloom                = coretypes.Bloom
+	Header                   = coretypes.Header
+	Receipt                  = coretypes.Receipt
+	Transaction              = coretypes.Transaction
+	Transactions             = coretypes.Transactions
+	Withdrawal               = coretypes.Withdrawal
+	Withdrawals              = coretypes.Withdrawals
 )
 
 //nolint:gochecknoglobals // alias.
@@ -62,6 +65,8 @@ var (
 	ZeroAddress            = ExecutionAddress{}
 	ZeroHash               = ExecutionHash{}
 	BlockToExecutableData  = engine.BlockToExecutableData
+	ExecutableDataToBlock  = engine.ExecutableDataToBlock
+	EmptyRootHash          = coretypes.EmptyRootHash
 	NewBlockWithHeader     = coretypes.NewBlockWithHeader
 	DeriveSha              = coretypes.DeriveSha
 	EmptyUncleHash         = coretypes.EmptyUncleHash
@@ -70,4 +75,5 @@ var (
 	SignTx                 = coretypes.SignTx
 	LatestSignerForChainID = coretypes.LatestSignerForChainID
 	ReceiptStatusFailed    = coretypes.ReceiptStatusFailed
+	ErrNotFound            = ethereum.NotFound
 )
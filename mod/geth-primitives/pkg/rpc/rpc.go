@@ -23,15 +23,27 @@ package rpc
 import "github.com/ethereum/go-ethereum/rpc"
 
 type (
-	BlockNumber = rpc.BlockNumber
-	Client      = rpc.Client
-	DataError   = rpc.DataError
+	BlockNumber  = rpc.BlockNumber
+	Client       = rpc.Client
+	ClientOption = rpc.ClientOption
+	DataError    = rpc.DataError
+	Server       = rpc.Server
+)
+
+const (
+	SafeBlockNumber      = rpc.SafeBlockNumber
+	FinalizedBlockNumber = rpc.FinalizedBlockNumber
+	LatestBlockNumber    = rpc.LatestBlockNumber
+	PendingBlockNumber   = rpc.PendingBlockNumber
+	EarliestBlockNumber  = rpc.EarliestBlockNumber
 )
 
 //nolint:gochecknoglobals // its okay.
 var (
-	DialOptions = rpc.DialOptions
-	DialContext = rpc.DialContext
-	DialIPC     = rpc.DialIPC
-	WithHeaders = rpc.WithHeaders
+	DialOptions    = rpc.DialOptions
+	DialContext    = rpc.DialContext
+	DialIPC        = rpc.DialIPC
+	NewServer      = rpc.NewServer
+	WithHeaders    = rpc.WithHeaders
+	WithHTTPClient = rpc.WithHTTPClient
 )
@@ -34,7 +34,7 @@ type Backend[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -65,6 +65,8 @@ type Backend[
 	node NodeT
 
 	sp StateProcessor[BeaconStateT]
+	ot OptimisticTracker
+	ps ProposalSimulator[BeaconBlockT, BlobSidecarsT]
 }
 
 // New creates and returns a new Backend instance.
@@ -72,7 +74,7 @@ func New[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -101,6 +103,8 @@ func New[
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	ot OptimisticTracker,
+	ps ProposalSimulator[BeaconBlockT, BlobSidecarsT],
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
@@ -118,6 +122,8 @@ func New[
 		sb: storageBackend,
 		cs: cs,
 		sp: sp,
+		ot: ot,
+		ps: ps,
 	}
 }
 
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SimulateProposal builds the block following the head as the validator would
+// propose it, without publishing it. A failure to build the block is reported
+// in the response, along with the time spent until the failure.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) SimulateProposal(
+	proposerIndex *math.ValidatorIndex,
+	mockPayload bool,
+) (*debugtypes.ProposalSimulationResponse[BeaconBlockT, BlobSidecarsT], error) {
+	queryCtx, err := b.node.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := b.sb.StateFromContext(queryCtx).GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	blk, sidecars, timings, err := b.ps.SimulateProposal(
+		queryCtx, proposerIndex, mockPayload,
+	)
+	resp := &debugtypes.ProposalSimulationResponse[
+		BeaconBlockT, BlobSidecarsT,
+	]{
+		Slot:    slot + 1,
+		Timings: make(map[string]string, len(timings)),
+	}
+	for phase, d := range timings {
+		resp.Timings[phase] = d.String()
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	stateRoot := blk.GetStateRoot()
+	resp.Block = &blk
+	resp.Sidecars = &sidecars
+	resp.StateRoot = &stateRoot
+	return resp, nil
+}
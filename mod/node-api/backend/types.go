@@ -22,6 +22,7 @@ package backend
 
 import (
 	"context"
+	"time"
 
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -40,11 +41,21 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	IsDataAvailable(
 		context.Context, math.Slot, BeaconBlockBodyT,
 	) bool
+	// GetBlobSidecars returns the sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot, BeaconBlockBodyT) (BlobSidecarsT, error)
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
 }
 
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	GetSlot() math.Slot
+	GetBody() BeaconBlockBodyT
+	GetProposerIndex() math.ValidatorIndex
+	GetStateRoot() common.Root
+}
+
 // BeaconBlockHeader is the interface for a beacon block header.
 type BeaconBlockHeader[BeaconBlockHeaderT any] interface {
 	constraints.SSZMarshallableRootable
@@ -80,6 +91,8 @@ type BeaconState[
 
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
+	// Get retrieves the block at the given slot from the store.
+	Get(slot math.Slot) (BeaconBlockT, error)
 	// GetSlotByRoot retrieves the slot by a given root from the store.
 	GetSlotByRoot(root common.Root) (math.Slot, error)
 	// GetSlotByExecutionNumber retrieves the slot by a given execution number
@@ -104,6 +117,27 @@ type Node[ContextT any] interface {
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
 }
 
+// OptimisticTracker is the interface for tracking beacon blocks that were
+// imported before their payloads were verified by the execution client.
+type OptimisticTracker interface {
+	// IsOptimistic returns true if the block with the given root was
+	// imported optimistically and has not been verified yet.
+	IsOptimistic(root common.Root) (bool, error)
+}
+
+// ProposalSimulator is the interface for building the block following the
+// head without publishing it.
+type ProposalSimulator[BeaconBlockT, BlobSidecarsT any] interface {
+	// SimulateProposal builds the block following the state in the given
+	// context, and returns it along with its sidecars and the time spent in
+	// every phase of building it.
+	SimulateProposal(
+		ctx context.Context,
+		proposerIndex *math.ValidatorIndex,
+		mockPayload bool,
+	) (BeaconBlockT, BlobSidecarsT, map[string]time.Duration, error)
+}
+
 type StateProcessor[BeaconStateT any] interface {
 	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
 }
@@ -53,6 +53,14 @@ func NewDefaultEngine() *Engine {
 	return New(engine)
 }
 
+// NewAuthenticatedEngine returns a new default Echo Engine instance which
+// only serves the requests carrying the given bearer token.
+func NewAuthenticatedEngine(token string) *Engine {
+	engine := NewDefaultEngine()
+	engine.Use(bearerAuthMiddleware(token))
+	return engine
+}
+
 // Run starts the Echo engine at the given address.
 func (e *Engine) Run(addr string) error {
 	return e.Echo.Start(addr)
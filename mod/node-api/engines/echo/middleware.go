@@ -21,7 +21,9 @@
 package echo
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
@@ -42,6 +44,9 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if status, ok := data.(types.StatusResponse); ok && err == nil {
+			return c.NoContent(status.Code)
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
@@ -75,3 +80,31 @@ func responseFromError(data any, err error) (int, any) {
 		}
 	}
 }
+
+// bearerAuthMiddleware is a middleware that rejects the requests which do not
+// carry the given bearer token. Every request is rejected if the token is
+// empty.
+func bearerAuthMiddleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c Context) error {
+			scheme, credentials, found := strings.Cut(
+				c.Request().Header.Get(echo.HeaderAuthorization), " ",
+			)
+			if !found || !strings.EqualFold(scheme, "Bearer") {
+				return c.JSON(http.StatusUnauthorized, ErrorResponse{
+					Code:    http.StatusUnauthorized,
+					Message: "missing bearer token",
+				})
+			}
+			if token == "" || subtle.ConstantTimeCompare(
+				[]byte(credentials), []byte(token),
+			) != 1 {
+				return c.JSON(http.StatusForbidden, ErrorResponse{
+					Code:    http.StatusForbidden,
+					Message: "invalid bearer token",
+				})
+			}
+			return next(c)
+		}
+	}
+}
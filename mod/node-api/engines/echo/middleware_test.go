@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/stretchr/testify/require"
+)
+
+// serve serves a request to the engine carrying the given authorization
+// header, if not empty, and returns the status of the response.
+func serve(t *testing.T, engine *echo.Engine, authorization string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// newAuthenticatedEngine returns an authenticated engine serving a single
+// route.
+func newAuthenticatedEngine(token string) *echo.Engine {
+	engine := echo.NewAuthenticatedEngine(token)
+	engine.GET("/", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	return engine
+}
+
+func TestBearerAuth(t *testing.T) {
+	engine := newAuthenticatedEngine("secret")
+
+	require.Equal(t, http.StatusOK, serve(t, engine, "Bearer secret"))
+	require.Equal(t, http.StatusOK, serve(t, engine, "bearer secret"))
+
+	// Requests without a bearer token are unauthorized.
+	require.Equal(t, http.StatusUnauthorized, serve(t, engine, ""))
+	require.Equal(t, http.StatusUnauthorized, serve(t, engine, "secret"))
+	require.Equal(
+		t, http.StatusUnauthorized, serve(t, engine, "Basic secret"),
+	)
+
+	// Requests with another token are forbidden.
+	require.Equal(t, http.StatusForbidden, serve(t, engine, "Bearer other"))
+	require.Equal(t, http.StatusForbidden, serve(t, engine, "Bearer "))
+	require.Equal(
+		t, http.StatusForbidden, serve(t, engine, "Bearer secret2"),
+	)
+}
+
+func TestBearerAuth_EmptyToken(t *testing.T) {
+	engine := newAuthenticatedEngine("")
+
+	// Every request is rejected if the engine has no token.
+	require.Equal(t, http.StatusForbidden, serve(t, engine, "Bearer "))
+	require.Equal(t, http.StatusUnauthorized, serve(t, engine, ""))
+}
@@ -64,6 +64,7 @@ func ConstructValidator() *validator.Validate {
 		"epoch":            ValidateUint64,
 		"slot":             ValidateUint64,
 		"committee_index":  ValidateUint64,
+		"validator_index":  ValidateUint64,
 		"hex":              ValidateHex,
 	}
 	validate := validator.New()
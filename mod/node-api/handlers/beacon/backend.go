@@ -27,16 +27,22 @@ import (
 )
 
 // Backend is the interface for backend of the beacon API.
-type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
+type Backend[BlockHeaderT, BlobSidecarsT, ForkT, ValidatorT any] interface {
 	GenesisBackend
+	BlobBackend[BlobSidecarsT]
 	BlockBackend[BlockHeaderT]
 	RandaoBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
+	OptimisticBackend
 	GetSlotByRoot(root common.Root) (math.Slot, error)
 }
 
+type BlobBackend[BlobSidecarsT any] interface {
+	BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error)
+}
+
 type GenesisBackend interface {
 	GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
 }
@@ -46,6 +52,10 @@ type HistoricalBackend[ForkT any] interface {
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type OptimisticBackend interface {
+	ExecutionOptimisticAtSlot(slot math.Slot) (bool, error)
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
@@ -25,7 +25,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
+func (h *Handler[_, _, ContextT, _, _]) GetBlockRewards(
+	c ContextT,
+) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
 	)
@@ -40,8 +42,12 @@ func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimisticAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
 	return &beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                rewards,
 	}, nil
@@ -29,24 +29,28 @@ import (
 // Handler is the handler for the beacon API.
 type Handler[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarsT any,
 	ContextT context.Context,
 	ForkT any,
 	ValidatorT any,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	backend Backend[BeaconBlockHeaderT, BlobSidecarsT, ForkT, ValidatorT]
 }
 
 // NewHandler creates a new handler for the beacon API.
 func NewHandler[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarsT any,
 	ContextT context.Context,
 	ForkT any,
 	ValidatorT any,
 ](
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT],
-) *Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT] {
-	h := &Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT]{
+	backend Backend[BeaconBlockHeaderT, BlobSidecarsT, ForkT, ValidatorT],
+) *Handler[BeaconBlockHeaderT, BlobSidecarsT, ContextT, ForkT, ValidatorT] {
+	h := &Handler[
+		BeaconBlockHeaderT, BlobSidecarsT, ContextT, ForkT, ValidatorT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
@@ -27,7 +27,7 @@ import (
 )
 
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	BeaconBlockHeaderT, _, ContextT, _, _,
 ]) GetBlockHeaders(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeadersRequest](
 		c, h.Logger(),
@@ -43,8 +43,12 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimisticAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data: &beacontypes.BlockHeaderResponse[BeaconBlockHeaderT]{
 			Root:      header.GetBodyRoot(),
@@ -58,7 +62,7 @@ func (h *Handler[
 }
 
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	BeaconBlockHeaderT, _, ContextT, _, _,
 ]) GetBlockHeaderByID(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeaderRequest](
 		c, h.Logger(),
@@ -74,8 +78,12 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimisticAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data: &beacontypes.BlockHeaderResponse[BeaconBlockHeaderT]{
 			Root:      header.GetBodyRoot(),
@@ -26,7 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetStateRoot(c ContextT) (any, error) {
+func (h *Handler[_, _, ContextT, _, _]) GetStateRoot(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateRootRequest](
 		c, h.Logger(),
 	)
@@ -44,8 +44,12 @@ func (h *Handler[_, ContextT, _, _]) GetStateRoot(c ContextT) (any, error) {
 	if len(stateRoot) == 0 {
 		return nil, types.ErrNotFound
 	}
+	optimistic, err := h.backend.ExecutionOptimisticAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data: types.Wrap(
 			beacontypes.RootData{Root: stateRoot},
@@ -53,7 +57,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateRoot(c ContextT) (any, error) {
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateFork(c ContextT) (any, error) {
+func (h *Handler[_, _, ContextT, _, _]) GetStateFork(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateForkRequest](
 		c, h.Logger(),
 	)
@@ -68,8 +72,12 @@ func (h *Handler[_, ContextT, _, _]) GetStateFork(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimisticAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                types.Wrap(fork),
 	}, nil
@@ -26,7 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetRandao(c ContextT) (any, error) {
+func (h *Handler[_, _, ContextT, _, _]) GetRandao(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetRandaoRequest](
 		c,
 		h.Logger(),
@@ -49,8 +49,12 @@ func (h *Handler[_, ContextT, _, _]) GetRandao(c ContextT) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	optimistic, err := h.backend.ExecutionOptimisticAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
+		ExecutionOptimistic: optimistic,
 		Finalized:           false, // stubbed
 		Data:                randao,
 	}, nil
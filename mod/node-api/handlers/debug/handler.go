@@ -25,15 +25,23 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
-type Handler[ContextT context.Context] struct {
+type Handler[
+	ContextT context.Context, BeaconBlockT, BlobSidecarsT any,
+] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend[BeaconBlockT, BlobSidecarsT]
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+func NewHandler[
+	ContextT context.Context, BeaconBlockT, BlobSidecarsT any,
+](
+	backend Backend[BeaconBlockT, BlobSidecarsT],
+) *Handler[ContextT, BeaconBlockT, BlobSidecarsT] {
+	h := &Handler[ContextT, BeaconBlockT, BlobSidecarsT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend: backend,
 	}
 	return h
 }
@@ -27,7 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 )
 
-func (h *Handler[ContextT]) RegisterRoutes(
+func (h *Handler[ContextT, _, _]) RegisterRoutes(
 	logger log.Logger[any],
 ) {
 	h.SetLogger(logger)
@@ -47,5 +47,10 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Path:    "/eth/v1/debug/fork_choice",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/debug/simulate_proposal",
+			Handler: h.SimulateProposal,
+		},
 	})
 }
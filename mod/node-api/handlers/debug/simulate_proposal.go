@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"strconv"
+
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SimulateProposal builds the block following the head as the validator
+// would propose it and returns it, along with its sidecars and the time
+// spent building it, without publishing anything.
+func (h *Handler[ContextT, _, _]) SimulateProposal(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.SimulateProposalRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposerIndex *math.ValidatorIndex
+	if req.ProposerIndex != "" {
+		var index uint64
+		index, err = strconv.ParseUint(req.ProposerIndex, 10, 64)
+		if err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		proposerIndex = (*math.ValidatorIndex)(&index)
+	}
+
+	simulation, err := h.backend.SimulateProposal(
+		proposerIndex, req.MockPayload,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(simulation), nil
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/debug"
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testResponse is the response of the simulations of the backend.
+type testResponse = debugtypes.ProposalSimulationResponse[string, []string]
+
+// backend is an in-memory backend of the debug API recording the
+// simulations requested.
+type backend struct {
+	proposerIndex *math.ValidatorIndex
+	mockPayload   bool
+	calls         int
+}
+
+func (b *backend) SimulateProposal(
+	proposerIndex *math.ValidatorIndex,
+	mockPayload bool,
+) (*testResponse, error) {
+	b.proposerIndex = proposerIndex
+	b.mockPayload = mockPayload
+	b.calls++
+	blk := "block"
+	return &testResponse{
+		Slot:    1,
+		Block:   &blk,
+		Timings: map[string]string{"total": "1ms"},
+	}, nil
+}
+
+// simulateProposal requests a simulation with the given query, and returns
+// the recorded response.
+func simulateProposal(
+	t *testing.T, b *backend, query string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	logger := noop.NewLogger[any]()
+	h := debug.NewHandler[echo.Context](b)
+	h.RegisterRoutes(logger)
+	engine := echo.NewDefaultEngine()
+	engine.RegisterRoutes(h.RouteSet(), logger)
+
+	req := httptest.NewRequest(
+		http.MethodGet, "/bkit/v1/debug/simulate_proposal"+query, nil,
+	)
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestSimulateProposal(t *testing.T) {
+	b := new(backend)
+	rec := simulateProposal(t, b, "?proposer_index=3&mock_payload=true")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, b.calls)
+	require.NotNil(t, b.proposerIndex)
+	require.Equal(t, math.ValidatorIndex(3), *b.proposerIndex)
+	require.True(t, b.mockPayload)
+
+	var resp struct {
+		Data testResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, math.Slot(1), resp.Data.Slot)
+	require.NotNil(t, resp.Data.Block)
+	require.Equal(t, "block", *resp.Data.Block)
+	require.Equal(t, "1ms", resp.Data.Timings["total"])
+}
+
+func TestSimulateProposal_Defaults(t *testing.T) {
+	// Without a query, the block is simulated for the proposer of the slot
+	// on a payload of the execution client.
+	b := new(backend)
+	rec := simulateProposal(t, b, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, b.calls)
+	require.Nil(t, b.proposerIndex)
+	require.False(t, b.mockPayload)
+}
+
+func TestSimulateProposal_InvalidProposerIndex(t *testing.T) {
+	b := new(backend)
+	rec := simulateProposal(t, b, "?proposer_index=abc")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Zero(t, b.calls)
+}
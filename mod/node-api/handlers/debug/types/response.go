@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ProposalSimulationResponse is the response for the
+// `bkit/v1/debug/simulate_proposal` endpoint.
+type ProposalSimulationResponse[BeaconBlockT, BlobSidecarsT any] struct {
+	// Slot is the slot of the simulated proposal.
+	Slot math.Slot `json:"slot"`
+
+	// Block is the block built, unset if building it failed.
+	Block *BeaconBlockT `json:"block,omitempty"`
+
+	// Sidecars are the blob sidecars of the block, unset if building the
+	// block failed.
+	Sidecars *BlobSidecarsT `json:"sidecars,omitempty"`
+
+	// StateRoot is the state root of the block, unset if building the
+	// block failed.
+	StateRoot *common.Root `json:"state_root,omitempty"`
+
+	// Timings is the time spent in every phase of building the block.
+	Timings map[string]string `json:"timings"`
+
+	// Error is the error building the block failed with, if any.
+	Error string `json:"error,omitempty"`
+}
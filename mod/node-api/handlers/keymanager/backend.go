@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// Backend is the interface to the proposer settings of the validator.
+type Backend interface {
+	// PublicKey returns the public key of the validator.
+	PublicKey() crypto.BLSPubkey
+	// FeeRecipient returns the fee recipient of the validator.
+	FeeRecipient() gethprimitives.ExecutionAddress
+	// SetFeeRecipient overrides the fee recipient of the validator.
+	SetFeeRecipient(gethprimitives.ExecutionAddress) error
+	// DeleteFeeRecipient reverts the fee recipient to the configured one.
+	DeleteFeeRecipient() error
+	// Graffiti returns the graffiti of the validator.
+	Graffiti() string
+	// SetGraffiti overrides the graffiti of the validator.
+	SetGraffiti(string) error
+	// DeleteGraffiti reverts the graffiti to the configured one.
+	DeleteGraffiti() error
+	// GasLimit returns the gas limit of the validator.
+	GasLimit() uint64
+	// GasLimitEnabled returns true if the gas limit of the validator is
+	// used, which is only the case for payloads of the relay.
+	GasLimitEnabled() bool
+	// SetGasLimit overrides the gas limit of the validator.
+	SetGasLimit(uint64) error
+	// DeleteGasLimit reverts the gas limit to the configured one.
+	DeleteGasLimit() error
+}
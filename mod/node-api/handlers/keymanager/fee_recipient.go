@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"net/http"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	keymanagertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetFeeRecipient returns the fee recipient of the validator.
+func (h *Handler[ContextT]) GetFeeRecipient(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.PubkeyRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := h.resolvePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Wrap(&keymanagertypes.FeeRecipientResponse{
+		Pubkey:     pubkey,
+		EthAddress: h.backend.FeeRecipient(),
+	}), nil
+}
+
+// SetFeeRecipient overrides the fee recipient of the validator.
+func (h *Handler[ContextT]) SetFeeRecipient(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.SetFeeRecipientRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.resolvePubkey(req.Pubkey); err != nil {
+		return nil, err
+	}
+
+	var feeRecipient gethprimitives.ExecutionAddress
+	if err = feeRecipient.UnmarshalText([]byte(req.EthAddress)); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	if err = h.backend.SetFeeRecipient(feeRecipient); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusAccepted}, nil
+}
+
+// DeleteFeeRecipient reverts the fee recipient of the validator to the
+// configured one.
+func (h *Handler[ContextT]) DeleteFeeRecipient(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.PubkeyRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.resolvePubkey(req.Pubkey); err != nil {
+		return nil, err
+	}
+
+	if err = h.backend.DeleteFeeRecipient(); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusNoContent}, nil
+}
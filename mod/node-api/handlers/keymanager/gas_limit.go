@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	keymanagertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetGasLimit returns the gas limit of the validator.
+func (h *Handler[ContextT]) GetGasLimit(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.PubkeyRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := h.resolvePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Wrap(&keymanagertypes.GasLimitResponse{
+		Pubkey:   pubkey,
+		GasLimit: strconv.FormatUint(h.backend.GasLimit(), 10),
+	}), nil
+}
+
+// SetGasLimit overrides the gas limit of the validator. The request is
+// rejected if payloads are not requested from the relay, as the gas limit of
+// local payloads is configured in the execution client.
+func (h *Handler[ContextT]) SetGasLimit(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.SetGasLimitRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.resolvePubkey(req.Pubkey); err != nil {
+		return nil, err
+	}
+
+	if !h.backend.GasLimitEnabled() {
+		return nil, errors.Wrap(
+			types.ErrInvalidRequest,
+			"gas limit only applies to payloads of the relay, which is disabled",
+		)
+	}
+	gasLimit, err := strconv.ParseUint(req.GasLimit, 10, 64)
+	if err != nil || gasLimit == 0 {
+		return nil, types.ErrInvalidRequest
+	}
+	if err = h.backend.SetGasLimit(gasLimit); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusAccepted}, nil
+}
+
+// DeleteGasLimit reverts the gas limit of the validator to the configured
+// one.
+func (h *Handler[ContextT]) DeleteGasLimit(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.PubkeyRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.resolvePubkey(req.Pubkey); err != nil {
+		return nil, err
+	}
+
+	if err = h.backend.DeleteGasLimit(); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusNoContent}, nil
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// pubkey is the public key of the test validator.
+var pubkey = crypto.BLSPubkey{0x01}
+
+// backend is an in-memory backend of the keymanager API.
+type backend struct {
+	gasLimitEnabled bool
+	gasLimit        uint64
+}
+
+func (b *backend) PublicKey() crypto.BLSPubkey { return pubkey }
+
+func (b *backend) FeeRecipient() gethprimitives.ExecutionAddress {
+	return gethprimitives.ExecutionAddress{}
+}
+
+func (b *backend) SetFeeRecipient(gethprimitives.ExecutionAddress) error {
+	return nil
+}
+
+func (b *backend) DeleteFeeRecipient() error { return nil }
+func (b *backend) Graffiti() string          { return "" }
+func (b *backend) SetGraffiti(string) error  { return nil }
+func (b *backend) DeleteGraffiti() error     { return nil }
+func (b *backend) GasLimit() uint64          { return b.gasLimit }
+func (b *backend) GasLimitEnabled() bool     { return b.gasLimitEnabled }
+func (b *backend) DeleteGasLimit() error     { return nil }
+
+func (b *backend) SetGasLimit(gasLimit uint64) error {
+	b.gasLimit = gasLimit
+	return nil
+}
+
+// setGasLimit requests the gas limit of the validator with the given public
+// key to be set to the given one, and returns the status of the response.
+func setGasLimit(
+	t *testing.T, b *backend, pk crypto.BLSPubkey, gasLimit string,
+) int {
+	t.Helper()
+	logger := noop.NewLogger[any]()
+	h := keymanager.NewHandler[echo.Context](b)
+	h.RegisterRoutes(logger)
+	engine := echo.NewDefaultEngine()
+	engine.RegisterRoutes(h.RouteSet(), logger)
+
+	pkText, err := pk.MarshalText()
+	require.NoError(t, err)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/eth/v1/validator/"+string(pkText)+"/gas_limit",
+		strings.NewReader(`{"gas_limit":"`+gasLimit+`"}`),
+	)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestSetGasLimit(t *testing.T) {
+	b := &backend{gasLimitEnabled: true, gasLimit: 30_000_000}
+	require.Equal(
+		t, http.StatusAccepted, setGasLimit(t, b, pubkey, "36000000"),
+	)
+	require.Equal(t, uint64(36_000_000), b.gasLimit)
+
+	// Invalid gas limits and other validators are rejected.
+	require.Equal(t, http.StatusBadRequest, setGasLimit(t, b, pubkey, "0"))
+	require.Equal(
+		t, http.StatusNotFound,
+		setGasLimit(t, b, crypto.BLSPubkey{0x02}, "36000000"),
+	)
+	require.Equal(t, uint64(36_000_000), b.gasLimit)
+}
+
+func TestSetGasLimit_WithoutRelay(t *testing.T) {
+	// The gas limit only applies to the payloads of the relay, setting it
+	// is rejected rather than accepted without effect.
+	b := &backend{gasLimit: 30_000_000}
+	require.Equal(
+		t, http.StatusBadRequest, setGasLimit(t, b, pubkey, "36000000"),
+	)
+	require.Equal(t, uint64(30_000_000), b.gasLimit)
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"net/http"
+
+	keymanagertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetGraffiti returns the graffiti of the validator.
+func (h *Handler[ContextT]) GetGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.PubkeyRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := h.resolvePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Wrap(&keymanagertypes.GraffitiResponse{
+		Pubkey:   pubkey,
+		Graffiti: h.backend.Graffiti(),
+	}), nil
+}
+
+// SetGraffiti overrides the graffiti of the validator.
+func (h *Handler[ContextT]) SetGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.SetGraffitiRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.resolvePubkey(req.Pubkey); err != nil {
+		return nil, err
+	}
+
+	if err = h.backend.SetGraffiti(req.Graffiti); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusAccepted}, nil
+}
+
+// DeleteGraffiti reverts the graffiti of the validator to the configured
+// one.
+func (h *Handler[ContextT]) DeleteGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[keymanagertypes.PubkeyRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.resolvePubkey(req.Pubkey); err != nil {
+		return nil, err
+	}
+
+	if err = h.backend.DeleteGraffiti(); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusNoContent}, nil
+}
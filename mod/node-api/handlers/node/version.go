@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// GetVersion returns the version of the beacon node, along with the client
+// versions of the beacon node and of the execution client.
+func (h *Handler[ContextT]) GetVersion(ContextT) (any, error) {
+	beaconNode, executionClients := h.backend.ClientVersions()
+	version := beaconNode.Name + "/" + beaconNode.Version
+	if commit := strings.TrimPrefix(beaconNode.Commit, "0x"); commit != "" {
+		version += "-" + commit
+	}
+	return types.Wrap(&nodetypes.VersionResponse{
+		Version: fmt.Sprintf(
+			"%s/%s-%s", version, runtime.GOOS, runtime.GOARCH,
+		),
+		BeaconNode:       beaconNode,
+		ExecutionClients: executionClients,
+	}), nil
+}
@@ -29,3 +29,9 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// StatusResponse is a response without a body, answered with the given
+// status code.
+type StatusResponse struct {
+	Code int
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+const (
+	// defaultAddress is the default address of the keymanager API, which
+	// only accepts local connections.
+	defaultAddress = "127.0.0.1:5062"
+	// defaultTokenPath is the default path of the token file, relative to
+	// the home directory.
+	defaultTokenPath = "config/keymanager_api_token.txt"
+)
+
+// Config is the configuration for the keymanager API server.
+type Config struct {
+	// Enabled is the flag to enable the keymanager API server.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the address to bind the keymanager API server to.
+	Address string `mapstructure:"address"`
+	// Logging is the flag to enable API logging.
+	Logging bool `mapstructure:"logging"`
+	// TokenPath is the path of the file holding the bearer token the
+	// requests must carry, it is generated if missing. Relative paths are
+	// resolved against the home directory.
+	TokenPath string `mapstructure:"token-path"`
+}
+
+// DefaultConfig returns the default configuration for the keymanager API
+// server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		Address:   defaultAddress,
+		Logging:   false,
+		TokenPath: defaultTokenPath,
+	}
+}
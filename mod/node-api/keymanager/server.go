@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+)
+
+// Server is the keymanager API server service. It serves the routes
+// managing the validator apart from the node API, so that they can be
+// bound to a local address and authenticated.
+type Server[
+	ContextT apicontext.Context,
+	EngineT server.Engine[ContextT, EngineT],
+] struct {
+	*server.Server[ContextT, EngineT]
+}
+
+// New initializes a new keymanager API server with the given config, engine
+// and logger.
+func New[
+	ContextT apicontext.Context,
+	EngineT server.Engine[ContextT, EngineT],
+](
+	config Config,
+	engine EngineT,
+	logger log.Logger[any],
+	handlers ...handlers.Handlers[ContextT],
+) *Server[ContextT, EngineT] {
+	return &Server[ContextT, EngineT]{
+		Server: server.New(
+			server.Config{
+				Enabled: config.Enabled,
+				Address: config.Address,
+				Logging: config.Logging,
+			},
+			engine,
+			logger,
+			handlers...,
+		),
+	}
+}
+
+// Name returns the name of the keymanager API server service.
+func (s *Server[_, _]) Name() string {
+	return "keymanager-api-server"
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// tokenLength is the length in bytes of a generated token.
+	tokenLength = 32
+	// tokenFileMode is the file mode of the token file.
+	tokenFileMode = 0o600
+)
+
+// ErrEmptyToken is returned when the token file is empty.
+var ErrEmptyToken = errors.New("keymanager api token file is empty")
+
+// LoadOrCreateToken reads the bearer token from the file at the given path,
+// a random token is written to the file if it does not exist.
+func LoadOrCreateToken(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return createToken(path)
+	} else if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(bz))
+	if token == "" {
+		return "", errors.Wrap(ErrEmptyToken, path)
+	}
+	return token, nil
+}
+
+// createToken writes a random token to the file at the given path.
+func createToken(path string) (string, error) {
+	bz := make([]byte, tokenLength)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	token := hex.EncodeToString(bz)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return "", err
+	}
+	return token, os.WriteFile(path, []byte(token+"\n"), tokenFileMode)
+}
@@ -23,7 +23,12 @@ package app
 import (
 	"io"
 
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/berachain/beacon-kit/mod/errors"
 	bkcomponents "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -41,6 +46,19 @@ var (
 // functions, as object capabilities aren't needed for testing.
 type BeaconApp struct {
 	*runtime.App
+	// paramsSchedule provides the consensus parameter updates handed to
+	// CometBFT when finalizing blocks.
+	paramsSchedule ConsensusParamsSchedule
+	// shutdownHooks are run when the app is closed.
+	shutdownHooks []func() error
+}
+
+// ConsensusParamsSchedule provides the consensus parameters that come into
+// effect at a given height.
+type ConsensusParamsSchedule interface {
+	// UpdatesForHeight returns the consensus parameters that come into effect
+	// at the given height, or nil if they do not change at that height.
+	UpdatesForHeight(height int64) *cmtproto.ConsensusParams
 }
 
 // NewBeaconKitApp returns a reference to an initialized BeaconApp.
@@ -66,3 +84,54 @@ func NewBeaconKitApp(
 
 	return app
 }
+
+// RegisterSnapshotExtensions registers the given extensions with the state
+// sync snapshot manager. It is a no-op if state sync snapshots are disabled.
+func (app *BeaconApp) RegisterSnapshotExtensions(
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) error {
+	manager := app.SnapshotManager()
+	if manager == nil {
+		return nil
+	}
+	return manager.RegisterExtensions(extensions...)
+}
+
+// SetConsensusParamsSchedule sets the schedule the consensus parameter
+// updates returned from FinalizeBlock follow.
+func (app *BeaconApp) SetConsensusParamsSchedule(
+	schedule ConsensusParamsSchedule,
+) {
+	app.paramsSchedule = schedule
+}
+
+// RegisterShutdownHooks registers the given hooks to run when the app is
+// closed on shutdown, before the app itself is closed.
+func (app *BeaconApp) RegisterShutdownHooks(hooks ...func() error) {
+	app.shutdownHooks = append(app.shutdownHooks, hooks...)
+}
+
+// Close runs the shutdown hooks and closes the app.
+func (app *BeaconApp) Close() error {
+	var errs []error
+	for _, hook := range app.shutdownHooks {
+		errs = append(errs, hook())
+	}
+	return errors.Join(append(errs, app.App.Close())...)
+}
+
+// FinalizeBlock finalizes the block and, if a schedule is set, returns the
+// consensus parameters that come into effect at the next height only when
+// they change, rather than echoing the current ones on every block.
+func (app *BeaconApp) FinalizeBlock(
+	req *cmtabci.FinalizeBlockRequest,
+) (*cmtabci.FinalizeBlockResponse, error) {
+	res, err := app.App.FinalizeBlock(req)
+	if err != nil || res == nil || app.paramsSchedule == nil {
+		return res, err
+	}
+	res.ConsensusParamUpdates = app.paramsSchedule.UpdatesForHeight(
+		req.Height + 1,
+	)
+	return res, nil
+}
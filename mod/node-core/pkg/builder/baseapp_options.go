@@ -21,8 +21,9 @@
 package builder
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -33,10 +34,10 @@ import (
 
 // WithCometParamStore sets the param store to the comet consensus engine.
 func WithCometParamStore(
-	chainSpec common.ChainSpec,
+	paramStore *comet.ConsensusParamsStore,
 ) func(bApp *baseapp.BaseApp) {
 	return func(bApp *baseapp.BaseApp) {
-		bApp.SetParamStore(comet.NewConsensusParamsStore(chainSpec))
+		bApp.SetParamStore(paramStore)
 	}
 }
 
@@ -58,6 +59,66 @@ func WithProcessProposal(
 	}
 }
 
+// upgradePrepareProposal returns a prepare proposal handler that lets the
+// upgrade manager migrate the proposal state before running the given handler.
+func upgradePrepareProposal(
+	upgradeManager *components.UpgradeManager,
+	handler sdk.PrepareProposalHandler,
+) sdk.PrepareProposalHandler {
+	return func(
+		ctx sdk.Context, req *cmtabci.PrepareProposalRequest,
+	) (*cmtabci.PrepareProposalResponse, error) {
+		if err := upgradeManager.PreBlock(ctx, req.Height); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// upgradeProcessProposal returns a process proposal handler that lets the
+// upgrade manager migrate the proposal state before running the given handler.
+func upgradeProcessProposal(
+	upgradeManager *components.UpgradeManager,
+	handler sdk.ProcessProposalHandler,
+) sdk.ProcessProposalHandler {
+	return func(
+		ctx sdk.Context, req *cmtabci.ProcessProposalRequest,
+	) (*cmtabci.ProcessProposalResponse, error) {
+		if err := upgradeManager.PreBlock(ctx, req.Height); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// upgradePreBlocker returns a pre-blocker that lets the upgrade manager
+// apply or enforce the upgrade plan before running the given pre-blocker.
+func upgradePreBlocker(
+	upgradeManager *components.UpgradeManager,
+	preBlocker sdk.PreBlocker,
+) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *cmtabci.FinalizeBlockRequest) error {
+		if err := upgradeManager.PreBlock(ctx, req.Height); err != nil {
+			return err
+		}
+		return preBlocker(ctx, req)
+	}
+}
+
+// WithUpgradeHaltHeight lowers the halt height of the baseapp to the height
+// preceding an upgrade this binary lacks, such that the node stops right
+// after committing it. The configured halt height is kept if it is lower.
+func WithUpgradeHaltHeight(
+	upgradeManager *components.UpgradeManager,
+	haltHeight uint64,
+) func(bApp *baseapp.BaseApp) {
+	if upgradeHaltHeight := upgradeManager.HaltHeight(); upgradeHaltHeight > 0 &&
+		(haltHeight == 0 || upgradeHaltHeight < haltHeight) {
+		haltHeight = upgradeHaltHeight
+	}
+	return baseapp.SetHaltHeight(haltHeight)
+}
+
 // WithPreBlocker sets the pre-blocker to the baseapp.
 func WithPreBlocker(
 	preBlocker sdk.PreBlocker,
@@ -26,16 +26,20 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
 // NodeBuilder is a construction helper for creating nodes that implement
@@ -79,12 +83,19 @@ func (nb *NodeBuilder[NodeT]) Build(
 
 	// variables to hold the components needed to set up BeaconApp
 	var (
-		chainSpec       common.ChainSpec
-		appBuilder      *runtime.AppBuilder
-		abciMiddleware  *components.ABCIMiddleware
-		serviceRegistry *service.Registry
-		consensusEngine *components.ConsensusEngine
-		apiBackend      *components.NodeAPIBackend
+		chainSpec         common.ChainSpec
+		appBuilder        *runtime.AppBuilder
+		abciMiddleware    *components.ABCIMiddleware
+		serviceRegistry   *service.Registry
+		consensusEngine   *components.ConsensusEngine
+		apiBackend        *components.NodeAPIBackend
+		snapshotExtension *components.SnapshotExtension
+		upgradeManager    *components.UpgradeManager
+		validatorMonitor  *components.ValidatorMonitor
+		privValServer     *components.PrivValidatorServer
+		doppelganger      *validator.Doppelganger
+		storageBackend    *components.StorageBackend
+		blsSigner         crypto.BLSSigner
 	)
 
 	// build all node components using depinject
@@ -108,25 +119,76 @@ func (nb *NodeBuilder[NodeT]) Build(
 		&serviceRegistry,
 		&consensusEngine,
 		&apiBackend,
+		&snapshotExtension,
+		&upgradeManager,
+		&validatorMonitor,
+		&privValServer,
+		&doppelganger,
+		&storageBackend,
+		&blsSigner,
 	); err != nil {
 		panic(err)
 	}
 
 	// set the application to a new BeaconApp with necessary ABCI handlers
-	nb.node.RegisterApp(
-		app.NewBeaconKitApp(
-			db, traceStore, true, appBuilder,
-			append(
-				server.DefaultBaseappOptions(appOpts),
-				WithCometParamStore(chainSpec),
-				WithPrepareProposal(consensusEngine.PrepareProposal),
-				WithProcessProposal(consensusEngine.ProcessProposal),
-				WithPreBlocker(consensusEngine.PreBlock),
-			)...,
-		),
+	paramStore := comet.NewConsensusParamsStore(chainSpec)
+	beaconApp := app.NewBeaconKitApp(
+		db, traceStore, true, appBuilder,
+		append(
+			server.DefaultBaseappOptions(appOpts),
+			WithCometParamStore(paramStore),
+			WithPrepareProposal(upgradePrepareProposal(
+				upgradeManager, consensusEngine.PrepareProposal,
+			)),
+			WithProcessProposal(upgradeProcessProposal(
+				upgradeManager, consensusEngine.ProcessProposal,
+			)),
+			WithPreBlocker(
+				upgradePreBlocker(upgradeManager, consensusEngine.PreBlock),
+			),
+			WithUpgradeHaltHeight(
+				upgradeManager,
+				cast.ToUint64(appOpts.Get(server.FlagHaltHeight)),
+			),
+		)...,
 	)
+	beaconApp.SetConsensusParamsSchedule(paramStore)
+	// Refuse to process blocks past an upgrade this binary does not know.
+	if err := upgradeManager.CheckStartup(
+		beaconApp.LastBlockHeight(),
+	); err != nil {
+		panic(err)
+	}
+	// Refuse to hold back the votes of a validator the chain cannot progress
+	// without.
+	if height := beaconApp.LastBlockHeight(); height > 0 {
+		queryCtx, err := beaconApp.CreateQueryContext(height, false)
+		if err != nil {
+			panic(err)
+		}
+		if err = components.CheckDoppelgangerVotingPower(
+			doppelganger,
+			chainSpec,
+			storageBackend.StateFromContext(queryCtx),
+			blsSigner.PublicKey(),
+		); err != nil {
+			panic(err)
+		}
+	}
+	// Stop serving the private validator to CometBFT on shutdown.
+	if privValServer != nil {
+		beaconApp.RegisterShutdownHooks(privValServer.Stop)
+	}
+	nb.node.RegisterApp(beaconApp)
 	// TODO: so hood
 	apiBackend.AttachNode(nb.node)
+	snapshotExtension.AttachNode(nb.node)
+	validatorMonitor.AttachNode(nb.node)
+	if err := beaconApp.RegisterSnapshotExtensions(
+		snapshotExtension,
+	); err != nil {
+		panic(err)
+	}
 	nb.node.SetServiceRegistry(serviceRegistry)
 
 	// TODO: put this in some post node creation hook/listener.
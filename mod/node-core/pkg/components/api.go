@@ -42,9 +42,11 @@ func ProvideNodeAPIEngine() *NodeAPIEngine {
 type NodeAPIBackendInput struct {
 	depinject.In
 
-	ChainSpec      common.ChainSpec
-	StateProcessor *StateProcessor
-	StorageBackend *StorageBackend
+	ChainSpec        common.ChainSpec
+	OptimisticStore  *OptimisticStore
+	StateProcessor   *StateProcessor
+	StorageBackend   *StorageBackend
+	ValidatorService *ValidatorService
 }
 
 func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
@@ -74,6 +76,8 @@ func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.OptimisticStore,
+		in.ValidatorService,
 	)
 }
 
@@ -61,6 +61,7 @@ func ProvideNodeAPIHandlers(
 func ProvideNodeAPIBeaconHandler(b *NodeAPIBackend) *BeaconAPIHandler {
 	return beaconapi.NewHandler[
 		*BeaconBlockHeader,
+		*BlobSidecars,
 		NodeAPIContext,
 		*Fork,
 		*Validator,
@@ -75,16 +76,16 @@ func ProvideNodeAPIConfigHandler() *ConfigAPIHandler {
 	return configapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPIDebugHandler() *DebugAPIHandler {
-	return debugapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPIDebugHandler(b *NodeAPIBackend) *DebugAPIHandler {
+	return debugapi.NewHandler[NodeAPIContext](b)
 }
 
 func ProvideNodeAPIEventsHandler() *EventsAPIHandler {
 	return eventsapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPINodeHandler() *NodeAPIHandler {
-	return nodeapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPINodeHandler(v *ValidatorService) *NodeAPIHandler {
+	return nodeapi.NewHandler[NodeAPIContext](v)
 }
 
 func ProvideNodeAPIProofHandler(b *NodeAPIBackend) *ProofAPIHandler {
@@ -22,7 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -31,9 +31,9 @@ import (
 type AttributesFactoryInput struct {
 	depinject.In
 
-	ChainSpec common.ChainSpec
-	Config    *config.Config
-	Logger    log.Logger[any]
+	ChainSpec        common.ChainSpec
+	Logger           log.Logger[any]
+	ProposerSettings *validator.ProposerSettings
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -47,6 +47,6 @@ func ProvideAttributesFactory(
 	](
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.ProposerSettings,
 	), nil
 }
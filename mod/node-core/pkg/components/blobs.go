@@ -24,6 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
@@ -92,6 +93,29 @@ func ProvideBlobProcessor(in BlobProcessorIn) *BlobProcessor {
 	)
 }
 
+// BlobFetcherIn is the input for the BlobFetcher.
+type BlobFetcherIn struct {
+	depinject.In
+
+	Config         *config.Config
+	EngineClient   *EngineClient
+	Logger         log.Logger
+	SidecarFactory *SidecarFactory
+	TelemetrySink  *metrics.TelemetrySink
+}
+
+// ProvideBlobFetcher is a function that provides the BlobFetcher to the
+// depinject framework.
+func ProvideBlobFetcher(in BlobFetcherIn) *BlobFetcher {
+	return dablob.NewFetcher(
+		&in.Config.Blobs,
+		in.Logger.With("service", "blob-fetcher"),
+		in.SidecarFactory,
+		in.EngineClient,
+		in.TelemetrySink,
+	)
+}
+
 // DAServiceIn is the input for the BlobService.
 type DAServiceIn struct {
 	depinject.In
@@ -26,6 +26,7 @@ import (
 	storev2 "cosmossdk.io/store/v2/db"
 	blockservice "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/reconstructor"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -38,7 +39,9 @@ import (
 // BlockStoreInput is the input for the dep inject framework.
 type BlockStoreInput struct {
 	depinject.In
-	AppOpts servertypes.AppOptions
+	AppOpts      servertypes.AppOptions
+	Config       *config.Config
+	EngineClient *EngineClient
 }
 
 // ProvideBlockStore is a function that provides the module to the
@@ -53,7 +56,20 @@ func ProvideBlockStore(
 		return nil, err
 	}
 
-	return block.NewStore[*BeaconBlock](storage.NewKVStoreProvider(kvp)), nil
+	cfg := in.Config.BlockStoreService
+	rc, err := reconstructor.New[
+		*BeaconBlock,
+		*BlindedBeaconBlock,
+		*BlindedBeaconBlockBody,
+		*ExecutionPayloadHeader,
+	](in.EngineClient, cfg.ReconstructionCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.NewStore[*BeaconBlock, *BlindedBeaconBlock](
+		storage.NewKVStoreProvider(kvp), cfg.Blinded, rc,
+	), nil
 }
 
 // BlockPrunerInput is the input for the block pruner.
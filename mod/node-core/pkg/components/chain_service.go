@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 )
 
 // ChainServiceInput is the input for the chain service provider.
@@ -44,6 +45,8 @@ type ChainServiceInput struct {
 	GenesisBrocker        *GenesisBroker
 	LocalBuilder          *LocalBuilder
 	Logger                log.AdvancedLogger[any, sdklog.Logger]
+	OptimisticStore       *OptimisticStore
+	ProposerPredictor     *comet.ProposerPredictor
 	Signer                crypto.BLSSigner
 	StateProcessor        *StateProcessor
 	StorageBackend        *StorageBackend
@@ -74,12 +77,15 @@ func ProvideChainService(
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.LocalBuilder,
+		in.ProposerPredictor,
+		in.OptimisticStore,
 		in.StateProcessor,
 		in.TelemetrySink,
 		in.GenesisBrocker,
 		in.BlockBroker,
 		in.ValidatorUpdateBroker,
-		// If optimistic is enabled, we want to skip post finalization FCUs.
+		// If optimistic is enabled, payloads are also built from the
+		// blocks verified during the proposal.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
 	)
 }
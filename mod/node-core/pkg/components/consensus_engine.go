@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
 )
 
@@ -29,7 +30,9 @@ import (
 type ConsensusEngineInput struct {
 	depinject.In
 	ConsensusMiddleware *ABCIMiddleware
+	Doppelganger        *validator.Doppelganger
 	StorageBackend      *StorageBackend
+	ValidatorMonitor    *ValidatorMonitor
 }
 
 // ProvideConsensusEngine is a depinject provider for the consensus engine.
@@ -46,5 +49,7 @@ func ProvideConsensusEngine(
 	](
 		in.ConsensusMiddleware,
 		in.StorageBackend,
+		in.Doppelganger,
+		in.ValidatorMonitor,
 	), nil
 }
@@ -31,6 +31,7 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideBlockStore,
 		ProvideBlockStoreService,
 		ProvideBlsSigner,
+		ProvideBlobFetcher,
 		ProvideBlobProcessor,
 		ProvideBlobProofVerifier,
 		ProvideBlobVerifier,
@@ -43,17 +44,26 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideDepositPruner,
 		ProvideDepositService,
 		ProvideDepositStore,
+		ProvideDoppelganger,
 		ProvideEngineClient,
 		ProvideExecutionEngine,
 		ProvideJWTSecret,
+		ProvideKeymanagerAPIServer,
 		ProvideLocalBuilder,
+		ProvideOptimisticStore,
+		ProvideProposerPredictor,
+		ProvideProposerSettings,
+		ProvideRelayClient,
 		ProvideReportingService,
 		ProvideServiceRegistry,
 		ProvideSidecarFactory,
+		ProvideSnapshotExtension,
 		ProvideStateProcessor,
 		ProvideStorageBackend,
 		ProvideTelemetrySink,
 		ProvideTrustedSetup,
+		ProvideUpgradeManager,
+		ProvideValidatorMonitor,
 		ProvideValidatorService,
 	}
 	components = append(components, DefaultNodeAPIComponents()...)
@@ -63,6 +63,7 @@ func ProvideDepositService(in DepositServiceIn) (*DepositService, error) {
 	](
 		in.Logger.With("service", "deposit"),
 		math.U64(in.ChainSpec.Eth1FollowDistance()),
+		math.U64(in.ChainSpec.DepositContractDeployBlock()),
 		in.TelemetrySink,
 		in.DepositStore,
 		in.BeaconDepositContract,
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DoppelgangerInput is the input for the dep inject framework.
+type DoppelgangerInput struct {
+	depinject.In
+	Cfg    *config.Config
+	Logger log.AdvancedLogger[any, sdklog.Logger]
+}
+
+// ProvideDoppelganger provides the doppelganger protection holding back the
+// proposals and votes of the validator after startup.
+func ProvideDoppelganger(in DoppelgangerInput) *validator.Doppelganger {
+	return validator.NewDoppelganger(
+		in.Logger.With("service", "doppelganger"),
+		in.Cfg.Validator.DoppelgangerBlocks,
+	)
+}
+
+// CheckDoppelgangerVotingPower returns an error if the doppelganger
+// protection is enabled while the validator of the given public key holds a
+// third or more of the active balance of the given beacon state, which makes
+// up the voting power of CometBFT.
+func CheckDoppelgangerVotingPower(
+	doppelganger *validator.Doppelganger,
+	chainSpec common.ChainSpec,
+	st *BeaconState,
+	pubkey crypto.BLSPubkey,
+) error {
+	if !doppelganger.Enabled() {
+		return nil
+	}
+
+	totalPower, err := st.GetTotalActiveBalances(chainSpec.SlotsPerEpoch())
+	if err != nil {
+		return err
+	}
+
+	// The key does not belong to a validator yet if it is not found.
+	var power math.Gwei
+	if idx, idxErr := st.ValidatorIndexByPubkey(pubkey); idxErr == nil {
+		val, valErr := st.ValidatorByIndex(idx)
+		if valErr != nil {
+			return valErr
+		}
+		power = val.GetEffectiveBalance()
+	}
+	return doppelganger.CheckVotingPower(power, totalPower)
+}
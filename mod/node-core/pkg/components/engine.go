@@ -26,14 +26,20 @@ import (
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/config"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
 
+// clientVersionCommitLength is the number of hex characters of the commit
+// in the client version.
+const clientVersionCommitLength = 8
+
 // EngineClientInputs is the input for the EngineClient.
 type EngineClientInputs struct {
 	depinject.In
@@ -58,16 +64,36 @@ func ProvideEngineClient(
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		consensusClientVersion(),
 	)
 }
 
+// consensusClientVersion returns the client version beacon-kit identifies
+// itself with to the execution client.
+func consensusClientVersion() engineprimitives.ClientVersionV1 {
+	// The commit is the first four bytes of the commit hash.
+	commit := sdkversion.Commit
+	if len(commit) > clientVersionCommitLength {
+		commit = commit[:clientVersionCommitLength]
+	} else if commit == "" {
+		commit = "00000000"
+	}
+	return engineprimitives.ClientVersionV1{
+		Code:    "BK",
+		Name:    "beacon-kit",
+		Version: sdkversion.Version,
+		Commit:  "0x" + commit,
+	}
+}
+
 // EngineClientInputs is the input for the EngineClient.
 type ExecutionEngineInputs struct {
 	depinject.In
-	EngineClient  *EngineClient
-	Logger        log.AdvancedLogger[any, sdklog.Logger]
-	StatusBroker  *StatusBroker
-	TelemetrySink *metrics.TelemetrySink
+	EngineClient    *EngineClient
+	Logger          log.AdvancedLogger[any, sdklog.Logger]
+	OptimisticStore *OptimisticStore
+	StatusBroker    *StatusBroker
+	TelemetrySink   *metrics.TelemetrySink
 }
 
 // ProvideExecutionEngine provides the execution engine to the depinject
@@ -85,5 +111,6 @@ func ProvideExecutionEngine(
 		in.Logger.With("service", "execution-engine"),
 		in.StatusBroker,
 		in.TelemetrySink,
+		in.OptimisticStore,
 	)
 }
@@ -21,13 +21,10 @@
 package components
 
 import (
-	"strings"
-
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
 
@@ -44,10 +41,5 @@ func ProvideJWTSecret(in JWTSecretInput) (*jwt.Secret, error) {
 
 // LoadJWTFromFile reads the JWT secret from a file and returns it.
 func LoadJWTFromFile(filepath string) (*jwt.Secret, error) {
-	data, err := afero.ReadFile(afero.NewOsFs(), filepath)
-	if err != nil {
-		// Return an error if the file cannot be read.
-		return nil, err
-	}
-	return jwt.NewFromHex(strings.TrimSpace(string(data)))
+	return jwt.NewFromFile(filepath)
 }
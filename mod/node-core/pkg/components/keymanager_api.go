@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	keymanagerapi "github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager"
+	"github.com/berachain/beacon-kit/mod/node-api/keymanager"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// KeymanagerAPIServerInput is the input for the keymanager API server
+// provider.
+type KeymanagerAPIServerInput struct {
+	depinject.In
+
+	AppOpts          servertypes.AppOptions
+	Config           *config.Config
+	Logger           log.AdvancedLogger[any, sdklog.Logger]
+	ProposerSettings *validator.ProposerSettings
+}
+
+// ProvideKeymanagerAPIServer provides the keymanager API server, which
+// authenticates its requests with the token stored in the token file.
+func ProvideKeymanagerAPIServer(
+	in KeymanagerAPIServerInput,
+) (*KeymanagerAPIServer, error) {
+	var (
+		cfg   = in.Config.KeymanagerAPI
+		token string
+		err   error
+	)
+	// The token file is only created if the keymanager API is enabled, the
+	// engine rejects every request otherwise.
+	if cfg.Enabled {
+		if token, err = keymanager.LoadOrCreateToken(
+			resolveHomePath(in.AppOpts, cfg.TokenPath),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	in.Logger.AddKeyValColor("service", "keymanager-api-server",
+		log.Blue)
+	return keymanager.New[
+		NodeAPIContext,
+		*NodeAPIEngine,
+	](
+		cfg,
+		echo.NewAuthenticatedEngine(token),
+		in.Logger.With("service", "keymanager-api-server"),
+		keymanagerapi.NewHandler[NodeAPIContext](in.ProposerSettings),
+	), nil
+}
@@ -32,6 +32,7 @@ import (
 type ABCIMiddlewareInput struct {
 	depinject.In
 	BeaconBlockFeed       *BlockBroker
+	BlobFetcher           *BlobFetcher
 	ChainSpec             common.ChainSpec
 	GenesisBroker         *GenesisBroker
 	Logger                log.Logger[any]
@@ -51,12 +52,14 @@ func ProvideABCIMiddleware(
 		return nil, err
 	}
 	return middleware.NewABCIMiddleware[
-		*AvailabilityStore, *BeaconBlock, *BlobSidecars,
-		*Deposit, *ExecutionPayload, *Genesis, *SlotData,
+		*AvailabilityStore, *BeaconBlock, *BeaconBlockBody, *BlobSidecars,
+		*Deposit, *ExecutionPayload, *Genesis, *SlashingInfo, *SlotData,
+		*Withdrawal, WithdrawalCredentials,
 	](
 		in.ChainSpec,
 		in.Logger,
 		in.TelemetrySink,
+		in.BlobFetcher,
 		in.GenesisBroker,
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
@@ -133,6 +133,8 @@ func (am AppModule) InitGenesis(
 	](
 		am.ABCIMiddleware,
 		*am.StorageBackend,
+		nil,
+		nil,
 	).InitGenesis(ctx, bz)
 }
 
@@ -153,5 +155,7 @@ func (am AppModule) EndBlock(
 	](
 		am.ABCIMiddleware,
 		*am.StorageBackend,
+		nil,
+		nil,
 	).EndBlock(ctx)
 }
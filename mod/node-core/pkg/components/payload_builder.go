@@ -23,11 +23,14 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -60,3 +63,30 @@ func ProvideLocalBuilder(
 		in.AttributesFactory,
 	)
 }
+
+// RelayClientInput is an input for the dep inject framework.
+type RelayClientInput struct {
+	depinject.In
+	Cfg              *config.Config
+	Logger           log.AdvancedLogger[any, sdklog.Logger]
+	ProposerSettings *validator.ProposerSettings
+	Signer           crypto.BLSSigner
+}
+
+// ProvideRelayClient provides a client of the relay to the external block
+// builders for the depinject framework.
+func ProvideRelayClient(
+	in RelayClientInput,
+) *RelayClient {
+	return relay.NewClient[
+		*BeaconBlock,
+		*BlindedBeaconBlock,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+	](
+		&in.Cfg.Relay,
+		in.Logger.With("service", "relay"),
+		in.Signer,
+		in.ProposerSettings,
+	)
+}
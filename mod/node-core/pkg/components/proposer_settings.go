@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// ProposerSettingsInput is the input for the dep inject framework.
+type ProposerSettingsInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+	Cfg     *config.Config
+	Signer  crypto.BLSSigner
+}
+
+// ProvideProposerSettings provides the proposer settings of the validator,
+// the settings persisted through the keymanager API take precedence over the
+// configured ones.
+func ProvideProposerSettings(
+	in ProposerSettingsInput,
+) (*validator.ProposerSettings, error) {
+	return validator.NewProposerSettings(
+		resolveHomePath(in.AppOpts, in.Cfg.Validator.ProposerSettingsPath),
+		in.Signer.PublicKey(),
+		in.Cfg.PayloadBuilder.SuggestedFeeRecipient,
+		in.Cfg.Validator.Graffiti,
+		in.Cfg.Relay.GasLimit,
+		in.Cfg.Relay.Enabled,
+	)
+}
+
+// resolveHomePath joins the given path with the home directory, unless it is
+// an absolute path.
+func resolveHomePath(appOpts servertypes.AppOptions, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), path)
+}
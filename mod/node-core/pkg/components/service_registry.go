@@ -39,12 +39,14 @@ type ServiceRegistryInput struct {
 	DepositService        *DepositService
 	EngineClient          *EngineClient
 	GenesisBroker         *GenesisBroker
+	KeymanagerAPIServer   *KeymanagerAPIServer
 	Logger                log.Logger
 	NodeAPIServer         *NodeAPIServer
 	ReportingService      *ReportingService
 	SidecarsBroker        *SidecarsBroker
 	SlotBroker            *SlotBroker
 	TelemetrySink         *metrics.TelemetrySink
+	ValidatorMonitor      *ValidatorMonitor
 	ValidatorService      *ValidatorService
 	ValidatorUpdateBroker *ValidatorUpdateBroker
 }
@@ -56,12 +58,14 @@ func ProvideServiceRegistry(
 	return service.NewRegistry(
 		service.WithLogger(in.Logger),
 		service.WithService(in.ValidatorService),
+		service.WithService(in.ValidatorMonitor),
 		service.WithService(in.BlockStoreService),
 		service.WithService(in.ChainService),
 		service.WithService(in.DAService),
 		service.WithService(in.DepositService),
 		service.WithService(in.ABCIService),
 		service.WithService(in.NodeAPIServer),
+		service.WithService(in.KeymanagerAPIServer),
 		service.WithService(in.ReportingService),
 		service.WithService(in.DBManager),
 		service.WithService(in.GenesisBroker),
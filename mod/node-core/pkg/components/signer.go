@@ -21,44 +21,243 @@
 package components
 
 import (
+	"os"
 	"path/filepath"
+	"time"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/remotesigner"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmttypes "github.com/cometbft/cometbft/types"
 	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
 )
 
+// privValidatorDialTimeout is the timeout of the connection to CometBFT of
+// the private validators holding their key, the remote signer uses its own
+// timeout.
+const privValidatorDialTimeout = 3 * time.Second
+
 // BlsSignerInput is the input for the dep inject framework.
 type BlsSignerInput struct {
 	depinject.In
-	AppOpts servertypes.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	AppOpts      servertypes.AppOptions
+	Cfg          *config.Config
+	Doppelganger *validator.Doppelganger
+	PrivKey      LegacyKey `optional:"true"`
+}
+
+// BlsSignerOutput is the output for the dep inject framework.
+type BlsSignerOutput struct {
+	depinject.Out
+	Signer crypto.BLSSigner
+	// PrivValidatorServer serves the private validator to CometBFT, it is
+	// nil if CometBFT signs with the key file itself.
+	PrivValidatorServer *PrivValidatorServer
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
-func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
-	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
-		// if no private key is provided, use privval signer
-		homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
-		privValKeyFile := cast.ToString(
-			in.AppOpts.Get("priv_validator_key_file"),
+func ProvideBlsSigner(in BlsSignerInput) (BlsSignerOutput, error) {
+	if in.PrivKey != [constants.BLSSecretKeyLength]byte{} {
+		legacySigner, err := signer.NewLegacySigner(in.PrivKey)
+		return BlsSignerOutput{Signer: legacySigner}, err
+	}
+
+	blsSigner, pv, err := LoadBLSSigner(in.AppOpts, in.Cfg)
+	if err != nil {
+		return BlsSignerOutput{}, err
+	}
+
+	// CometBFT signs with the key file itself, unless the key is not held
+	// in it or its votes must be held back by the doppelganger protection.
+	keyFile := !in.Cfg.RemoteSigner.Enabled &&
+		in.Cfg.Validator.KeystorePath == ""
+	if keyFile && !in.Doppelganger.Enabled() {
+		return BlsSignerOutput{Signer: blsSigner}, nil
+	}
+
+	if in.Doppelganger.Enabled() {
+		in.Doppelganger.Watch(blsSigner.PublicKey())
+		pv = comet.NewGuardedPrivValidator(
+			pv, in.Doppelganger.CheckSigning,
 		)
-		privValStateFile := cast.ToString(
-			in.AppOpts.Get("priv_validator_state_file"),
+	}
+
+	timeout := privValidatorDialTimeout
+	if in.Cfg.RemoteSigner.Enabled {
+		timeout = in.Cfg.RemoteSigner.Timeout
+	}
+	server, err := servePrivValidator(in.AppOpts, pv, timeout)
+	if errors.Is(err, comet.ErrMissingListenAddr) && keyFile {
+		// The doppelganger protection cannot hold back the votes and
+		// proposals CometBFT signs with the key file.
+		return BlsSignerOutput{}, errors.Wrap(
+			err, "doppelganger protection is enabled",
 		)
-		// If privValKeyFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValKeyFile) {
-			privValKeyFile = filepath.Join(homeDir, privValKeyFile)
-		}
-		// If privValStateFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValStateFile) {
-			privValStateFile = filepath.Join(homeDir, privValStateFile)
-		}
-		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
-	}
-	return signer.NewLegacySigner(in.PrivKey)
+	} else if err != nil {
+		return BlsSignerOutput{}, err
+	}
+	return BlsSignerOutput{
+		Signer:              blsSigner,
+		PrivValidatorServer: server,
+	}, nil
+}
+
+// LoadBLSSigner loads the signer of the validator key according to the
+// configuration, along with the private validator signing the CometBFT
+// messages with the same key.
+func LoadBLSSigner(
+	appOpts servertypes.AppOptions,
+	cfg *config.Config,
+) (crypto.BLSSigner, cmttypes.PrivValidator, error) {
+	homeDir := cast.ToString(appOpts.Get(clientFlags.FlagHome))
+	privValKeyFile := cast.ToString(
+		appOpts.Get("priv_validator_key_file"),
+	)
+	privValStateFile := cast.ToString(
+		appOpts.Get("priv_validator_state_file"),
+	)
+	// If privValKeyFile is not an absolute path, join with homeDir
+	if !filepath.IsAbs(privValKeyFile) {
+		privValKeyFile = filepath.Join(homeDir, privValKeyFile)
+	}
+	// If privValStateFile is not an absolute path, join with homeDir
+	if !filepath.IsAbs(privValStateFile) {
+		privValStateFile = filepath.Join(homeDir, privValStateFile)
+	}
+
+	switch {
+	case cfg.RemoteSigner.Enabled:
+		return loadRemoteSigner(cfg, privValStateFile)
+	case cfg.Validator.KeystorePath != "":
+		return loadKeystoreSigner(appOpts, cfg, privValStateFile)
+	default:
+		fileSigner := signer.NewBLSSigner(privValKeyFile, privValStateFile)
+		return fileSigner, fileSigner.PrivValidator, nil
+	}
+}
+
+// loadRemoteSigner loads the remote signer, along with the private validator
+// signing the CometBFT messages with it so that no key is held by the node.
+func loadRemoteSigner(
+	cfg *config.Config,
+	privValStateFile string,
+) (*remotesigner.Signer, cmttypes.PrivValidator, error) {
+	// The key of the private validator must be a CometBFT BLS key for its
+	// public key to be sent to CometBFT.
+	if !bls12381.Enabled {
+		return nil, nil, errors.New(
+			"bls12_381 is disabled, beacond must be built with the bls12381 tag",
+		)
+	}
+
+	remote, err := remotesigner.NewSigner(
+		&cfg.RemoteSigner, signer.BLSSigner{}.VerifySignature,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubkey := remote.PublicKey()
+	pv, err := comet.NewPrivValidator(
+		remotesigner.NewPrivKey(remote, bls12381.PubKey(pubkey[:])),
+		privValStateFile,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return remote, pv, nil
+}
+
+// loadKeystoreSigner loads a signer with the key decrypted from the validator
+// keystore, along with its private validator so that the key is never stored
+// in plaintext.
+func loadKeystoreSigner(
+	appOpts servertypes.AppOptions,
+	cfg *config.Config,
+	privValStateFile string,
+) (*signer.BLSSigner, cmttypes.PrivValidator, error) {
+	passwordFile := cfg.Validator.KeystorePasswordFile
+	if passwordFile != "" {
+		passwordFile = resolveHomePath(appOpts, passwordFile)
+	}
+	password, err := signer.ReadKeystorePassword(passwordFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keystore, err := signer.LoadKeystore(
+		resolveHomePath(appOpts, cfg.Validator.KeystorePath),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := keystore.Decrypt(password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privKey, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	pv, err := comet.NewPrivValidator(privKey, privValStateFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &signer.BLSSigner{PrivValidator: pv}, pv, nil
+}
+
+// servePrivValidator serves the given private validator to CometBFT, which
+// must listen for it at priv_validator_laddr. The returned server must be
+// stopped on shutdown.
+func servePrivValidator(
+	appOpts servertypes.AppOptions,
+	pv cmttypes.PrivValidator,
+	timeout time.Duration,
+) (*PrivValidatorServer, error) {
+	chainID, err := readChainID(appOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return comet.ServePrivValidator(
+		cmtlog.NewFilter(
+			cmtlog.NewTMLogger(cmtlog.NewSyncWriter(os.Stdout)),
+			cmtlog.AllowInfo(),
+		),
+		cast.ToString(appOpts.Get("priv_validator_laddr")),
+		chainID,
+		pv,
+		timeout,
+	)
+}
+
+// readChainID returns the chain ID of the node, falling back to the one of
+// the genesis file as the SDK server does.
+func readChainID(appOpts servertypes.AppOptions) (string, error) {
+	if chainID := cast.ToString(
+		appOpts.Get(clientFlags.FlagChainID),
+	); chainID != "" {
+		return chainID, nil
+	}
+
+	reader, err := os.Open(resolveHomePath(
+		appOpts, cast.ToString(appOpts.Get("genesis_file")),
+	))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	return genutiltypes.ParseChainIDFromGenesis(reader)
 }
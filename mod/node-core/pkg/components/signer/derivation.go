@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/cosmos/go-bip39"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// mnemonicEntropyBits is the entropy of the new mnemonics, 256 bits
+	// give mnemonics of 24 words.
+	mnemonicEntropyBits = 256
+	// minSeedLen is the minimum length of a seed as per EIP-2333.
+	minSeedLen = 32
+
+	// hkdfModRL is the length of the output keying material of HKDF_mod_r,
+	// ceil((3 * ceil(log2(r))) / 16).
+	hkdfModRL = 48
+	// lamportChunks is the number of chunks of a lamport secret key.
+	lamportChunks = 255
+	// lamportChunkLen is the length of a lamport secret key chunk.
+	lamportChunkLen = sha256.Size
+
+	// eip2334Purpose and eip2334CoinType are the fixed levels of the
+	// EIP-2334 paths of the validator keys.
+	eip2334Purpose  = 12381
+	eip2334CoinType = 3600
+)
+
+var (
+	// hkdfModRSalt is the initial salt of HKDF_mod_r.
+	hkdfModRSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+	// curveOrder is the order r of the BLS12-381 curve.
+	curveOrder, _ = new(big.Int).SetString(
+		"73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+		16,
+	)
+)
+
+// NewMnemonic generates a new BIP-39 mnemonic of 24 words.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// MnemonicToSeed validates the given BIP-39 mnemonic and returns its seed,
+// salted with the given optional passphrase.
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	return bip39.NewSeedWithErrorChecking(
+		strings.Join(strings.Fields(mnemonic), " "), passphrase,
+	)
+}
+
+// SigningKeyPath returns the EIP-2334 path of the signing key of the
+// validator with the given index.
+func SigningKeyPath(index uint32) string {
+	return fmt.Sprintf(
+		"m/%d/%d/%d/0/0", eip2334Purpose, eip2334CoinType, index,
+	)
+}
+
+// DeriveKey derives the secret key at the given EIP-2334 path, such as
+// m/12381/3600/0/0/0, from the given seed as per EIP-2333.
+// https://eips.ethereum.org/EIPS/eip-2333
+// https://eips.ethereum.org/EIPS/eip-2334
+func DeriveKey(seed []byte, path string) (LegacyKey, error) {
+	indices, err := parseDerivationPath(path)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+
+	sk, err := DeriveMasterKey(seed)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	for _, index := range indices {
+		sk = DeriveChildKey(sk, index)
+	}
+	return sk, nil
+}
+
+// DeriveMasterKey derives the master secret key from the given seed.
+func DeriveMasterKey(seed []byte) (LegacyKey, error) {
+	if len(seed) < minSeedLen {
+		return LegacyKey{}, ErrSeedTooShort
+	}
+	return hkdfModR(seed), nil
+}
+
+// DeriveChildKey derives the child secret key with the given index from the
+// given parent secret key.
+func DeriveChildKey(parent LegacyKey, index uint32) LegacyKey {
+	return hkdfModR(parentToLamportPK(parent, index))
+}
+
+// parseDerivationPath returns the indices of the given derivation path.
+func parseDerivationPath(path string) ([]uint32, error) {
+	levels := strings.Split(path, "/")
+	if len(levels) < 2 || levels[0] != "m" {
+		return nil, errors.Wrapf(ErrInvalidDerivationPath, "%s", path)
+	}
+
+	indices := make([]uint32, 0, len(levels)-1)
+	for _, level := range levels[1:] {
+		index, err := strconv.ParseUint(level, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidDerivationPath, "%s", path)
+		}
+		indices = append(indices, uint32(index))
+	}
+	return indices, nil
+}
+
+// hkdfModR derives a secret key from the given input keying material.
+func hkdfModR(ikm []byte) LegacyKey {
+	var (
+		salt = hkdfModRSalt
+		sk   = new(big.Int)
+		okm  = make([]byte, hkdfModRL)
+	)
+	for sk.Sign() == 0 {
+		hashedSalt := sha256.Sum256(salt)
+		salt = hashedSalt[:]
+		prk := hkdf.Extract(
+			sha256.New, append(ikm[:len(ikm):len(ikm)], 0), salt,
+		)
+		// Reading less than 255 blocks from HKDF never fails.
+		_, _ = io.ReadFull(
+			hkdf.Expand(sha256.New, prk, []byte{0, hkdfModRL}), okm,
+		)
+		sk.Mod(new(big.Int).SetBytes(okm), curveOrder)
+	}
+
+	var key LegacyKey
+	sk.FillBytes(key[:])
+	return key
+}
+
+// parentToLamportPK returns the compressed lamport public key of the given
+// parent secret key and child index.
+func parentToLamportPK(parent LegacyKey, index uint32) []byte {
+	salt := binary.BigEndian.AppendUint32(nil, index)
+	notIKM := make([]byte, len(parent))
+	for i, b := range parent {
+		notIKM[i] = ^b
+	}
+
+	hasher := sha256.New()
+	for _, ikm := range [][]byte{parent[:], notIKM} {
+		lamportSK := make([]byte, lamportChunks*lamportChunkLen)
+		_, _ = io.ReadFull(
+			hkdf.New(sha256.New, ikm, salt, nil), lamportSK,
+		)
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(
+				lamportSK[i*lamportChunkLen : (i+1)*lamportChunkLen],
+			)
+			hasher.Write(chunk[:])
+		}
+	}
+	return hasher.Sum(nil)
+}
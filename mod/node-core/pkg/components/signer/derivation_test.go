@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/stretchr/testify/require"
+)
+
+// The test vectors of EIP-2333.
+//
+//nolint:lll // test vectors.
+var derivationVectors = []struct {
+	seed       string
+	masterSK   string
+	childIndex uint32
+	childSK    string
+}{
+	{
+		seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		childIndex: 0,
+		childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+	},
+	{
+		seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+		masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		childIndex: 3141592653,
+		childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+	},
+	{
+		seed:       "0099FF991111002299DD7744EE3355BBDD8844115566CC55663355668888CC00",
+		masterSK:   "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+		childIndex: 4294967295,
+		childSK:    "29358610794459428860402234341874281240803786294062035874021252734817515685787",
+	},
+}
+
+func TestDeriveKey_Vectors(t *testing.T) {
+	for _, v := range derivationVectors {
+		seed, err := hex.DecodeString(v.seed)
+		require.NoError(t, err)
+
+		master, err := signer.DeriveMasterKey(seed)
+		require.NoError(t, err)
+		require.Equal(t, v.masterSK, new(big.Int).SetBytes(master[:]).String())
+
+		child := signer.DeriveChildKey(master, v.childIndex)
+		require.Equal(t, v.childSK, new(big.Int).SetBytes(child[:]).String())
+	}
+}
+
+func TestDeriveKey_Path(t *testing.T) {
+	seed, err := signer.MnemonicToSeed(
+		"abandon abandon abandon abandon abandon abandon abandon abandon "+
+			"abandon abandon abandon about",
+		"TREZOR",
+	)
+	require.NoError(t, err)
+	require.Equal(t, derivationVectors[0].seed, hex.EncodeToString(seed))
+
+	key, err := signer.DeriveKey(seed, "m/0")
+	require.NoError(t, err)
+	require.Equal(
+		t, derivationVectors[0].childSK,
+		new(big.Int).SetBytes(key[:]).String(),
+	)
+
+	require.Equal(t, "m/12381/3600/7/0/0", signer.SigningKeyPath(7))
+	for _, path := range []string{"", "m", "12381/3600", "m/a/0", "m/-1"} {
+		_, err = signer.DeriveKey(seed, path)
+		require.ErrorIs(t, err, signer.ErrInvalidDerivationPath)
+	}
+
+	_, err = signer.MnemonicToSeed("abandon about", "")
+	require.Error(t, err)
+	_, err = signer.DeriveMasterKey(seed[:16])
+	require.ErrorIs(t, err, signer.ErrSeedTooShort)
+}
@@ -38,4 +38,30 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrUnsupportedKeystore is returned when a keystore does not follow
+	// EIP-2335 or uses an unsupported function.
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+	// ErrInvalidKeystorePassword is returned when a keystore cannot be
+	// decrypted with the given password.
+	ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+
+	// ErrKeystorePubkeyMismatch is returned when the public key of a
+	// keystore is not the one of its secret key.
+	ErrKeystorePubkeyMismatch = errors.New(
+		"keystore pubkey does not match its secret key",
+	)
+
+	// ErrKeystorePasswordRequired is returned when neither a password file
+	// nor the password environment variable is given.
+	ErrKeystorePasswordRequired = errors.New("keystore password required")
+
+	// ErrSeedTooShort is returned when a seed is too short to derive keys
+	// from.
+	ErrSeedTooShort = errors.New("seed must be at least 32 bytes")
+
+	// ErrInvalidDerivationPath is returned when a key derivation path does
+	// not follow EIP-2334.
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
 )
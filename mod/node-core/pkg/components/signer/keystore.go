@@ -0,0 +1,362 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/itsdevbear/comet-bls12-381/bls/blst"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// KeystorePasswordEnv is the environment variable the keystore password
+	// is read from when no password file is given.
+	KeystorePasswordEnv = "BEACOND_KEYSTORE_PASSWORD"
+
+	// keystoreVersion is the version of the EIP-2335 keystores.
+	keystoreVersion = 4
+	// keystoreFileMode is the file mode of the keystore files.
+	keystoreFileMode = 0o600
+
+	// kdfScrypt and kdfPBKDF2 are the supported key derivation functions.
+	kdfScrypt = "scrypt"
+	kdfPBKDF2 = "pbkdf2"
+	// prfHMACSHA256 is the only pseudo-random function of PBKDF2 in
+	// EIP-2335.
+	prfHMACSHA256 = "hmac-sha256"
+	// checksumSHA256 is the checksum function of EIP-2335.
+	checksumSHA256 = "sha256"
+	// cipherAES128CTR is the cipher function of EIP-2335.
+	cipherAES128CTR = "aes-128-ctr"
+
+	// Parameters of the scrypt key derivation of new keystores, as
+	// recommended by EIP-2335.
+	scryptN       = 1 << 18
+	scryptR       = 8
+	scryptP       = 1
+	derivedKeyLen = 32
+	saltLen       = 32
+)
+
+// Keystore is an EIP-2335 keystore, holding a BLS secret key encrypted with
+// a password.
+// https://eips.ethereum.org/EIPS/eip-2335
+type Keystore struct {
+	// Crypto holds the encrypted key and the parameters to decrypt it.
+	Crypto KeystoreCrypto `json:"crypto"`
+	// Description is an optional description of the keystore.
+	Description string `json:"description"`
+	// Pubkey is the hex encoded public key of the key, without prefix.
+	Pubkey string `json:"pubkey"`
+	// Path is the EIP-2334 derivation path of the key, if any.
+	Path string `json:"path"`
+	// UUID identifies the keystore.
+	UUID string `json:"uuid"`
+	// Version is the version of the keystore format.
+	Version uint `json:"version"`
+}
+
+// KeystoreCrypto holds the modules of an EIP-2335 keystore.
+type KeystoreCrypto struct {
+	KDF      KeystoreModule[KDFParams]    `json:"kdf"`
+	Checksum KeystoreModule[struct{}]     `json:"checksum"`
+	Cipher   KeystoreModule[CipherParams] `json:"cipher"`
+}
+
+// KeystoreModule is a module of an EIP-2335 keystore, its message is hex
+// encoded without prefix.
+type KeystoreModule[ParamsT any] struct {
+	Function string  `json:"function"`
+	Params   ParamsT `json:"params"`
+	Message  string  `json:"message"`
+}
+
+// KDFParams are the parameters of the key derivation function, scrypt uses
+// N, R and P while PBKDF2 uses C and PRF.
+type KDFParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+	Salt  string `json:"salt"`
+}
+
+// CipherParams are the parameters of the cipher.
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKeystore encrypts the given secret key with the given password into
+// a new keystore, using scrypt as key derivation function.
+func EncryptKeystore(key LegacyKey, password string) (*Keystore, error) {
+	secretKey, err := blst.SecretKeyFromBytes(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLen)
+	iv := make([]byte, aes.BlockSize)
+	if _, err = rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err = rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF: KeystoreModule[KDFParams]{
+				Function: kdfScrypt,
+				Params: KDFParams{
+					DKLen: derivedKeyLen,
+					N:     scryptN,
+					R:     scryptR,
+					P:     scryptP,
+					Salt:  hex.EncodeToString(salt),
+				},
+			},
+			Checksum: KeystoreModule[struct{}]{Function: checksumSHA256},
+			Cipher: KeystoreModule[CipherParams]{
+				Function: cipherAES128CTR,
+				Params:   CipherParams{IV: hex.EncodeToString(iv)},
+			},
+		},
+		Pubkey:  hex.EncodeToString(secretKey.PublicKey().Marshal()),
+		UUID:    uuid.NewString(),
+		Version: keystoreVersion,
+	}
+
+	derivedKey, err := ks.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(derivedKey[:16], iv, key[:])
+	if err != nil {
+		return nil, err
+	}
+	checksum := keystoreChecksum(derivedKey, cipherText)
+	ks.Crypto.Cipher.Message = hex.EncodeToString(cipherText)
+	ks.Crypto.Checksum.Message = hex.EncodeToString(checksum[:])
+	return ks, nil
+}
+
+// LoadKeystore reads the keystore at the given path.
+func LoadKeystore(path string) (*Keystore, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := new(Keystore)
+	if err = json.Unmarshal(bz, ks); err != nil {
+		return nil, errors.Wrapf(err, "keystore %s", path)
+	}
+	if ks.Version != keystoreVersion {
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "version %d", ks.Version,
+		)
+	}
+	return ks, nil
+}
+
+// Save writes the keystore to the given path, readable by its owner only.
+func (ks *Keystore) Save(path string) error {
+	bz, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, keystoreFileMode)
+}
+
+// PublicKey returns the public key of the keystore.
+func (ks *Keystore) PublicKey() (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	bz, err := hex.DecodeString(ks.Pubkey)
+	if err != nil {
+		return pubkey, err
+	}
+	if len(bz) != len(pubkey) {
+		return pubkey, errors.Wrapf(
+			ErrUnsupportedKeystore, "pubkey length %d", len(bz),
+		)
+	}
+	return crypto.BLSPubkey(bz), nil
+}
+
+// Decrypt decrypts the secret key of the keystore with the given password.
+func (ks *Keystore) Decrypt(password string) (LegacyKey, error) {
+	derivedKey, err := ks.deriveKey(password)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+
+	if ks.Crypto.Checksum.Function != checksumSHA256 {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "checksum %s",
+			ks.Crypto.Checksum.Function,
+		)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	checksum := keystoreChecksum(derivedKey, cipherText)
+	if hex.EncodeToString(checksum[:]) != ks.Crypto.Checksum.Message {
+		return LegacyKey{}, ErrInvalidKeystorePassword
+	}
+
+	if ks.Crypto.Cipher.Function != cipherAES128CTR {
+		return LegacyKey{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "cipher %s", ks.Crypto.Cipher.Function,
+		)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.Cipher.Params.IV)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	plainText, err := aes128CTR(derivedKey[:16], iv, cipherText)
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if len(plainText) != len(LegacyKey{}) {
+		return LegacyKey{}, ErrInvalidValidatorPrivateKeyLength
+	}
+
+	// The public key of the keystore is not authenticated by the checksum,
+	// make sure it matches the decrypted key.
+	key := LegacyKey(plainText)
+	secretKey, err := blst.SecretKeyFromBytes(key[:])
+	if err != nil {
+		return LegacyKey{}, err
+	}
+	if hex.EncodeToString(
+		secretKey.PublicKey().Marshal(),
+	) != strings.ToLower(ks.Pubkey) {
+		return LegacyKey{}, ErrKeystorePubkeyMismatch
+	}
+	return key, nil
+}
+
+// deriveKey derives the decryption key from the given password with the
+// key derivation function of the keystore.
+func (ks *Keystore) deriveKey(password string) ([]byte, error) {
+	params := ks.Crypto.KDF.Params
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if params.DKLen < derivedKeyLen {
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "derived key length %d", params.DKLen,
+		)
+	}
+
+	normalized := normalizePassword(password)
+	switch ks.Crypto.KDF.Function {
+	case kdfScrypt:
+		return scrypt.Key(
+			normalized, salt, params.N, params.R, params.P, params.DKLen,
+		)
+	case kdfPBKDF2:
+		if params.PRF != prfHMACSHA256 {
+			return nil, errors.Wrapf(
+				ErrUnsupportedKeystore, "prf %s", params.PRF,
+			)
+		}
+		return pbkdf2.Key(
+			normalized, salt, params.C, params.DKLen, sha256.New,
+		), nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "kdf %s", ks.Crypto.KDF.Function,
+		)
+	}
+}
+
+// ReadKeystorePassword reads the keystore password from the given file, or
+// from the KeystorePasswordEnv environment variable if no file is given.
+// Trailing newlines of the password file are ignored.
+func ReadKeystorePassword(passwordFile string) (string, error) {
+	if passwordFile == "" {
+		password, ok := os.LookupEnv(KeystorePasswordEnv)
+		if !ok {
+			return "", ErrKeystorePasswordRequired
+		}
+		return password, nil
+	}
+
+	bz, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// normalizePassword processes the password as per EIP-2335: it is NFKD
+// normalized and stripped of its control codes.
+func normalizePassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
+
+// keystoreChecksum computes the checksum of the given cipher text.
+func keystoreChecksum(derivedKey []byte, cipherText []byte) [32]byte {
+	return sha256.Sum256(append(derivedKey[16:32:32], cipherText...))
+}
+
+// aes128CTR encrypts, or decrypts, the given text with AES-128-CTR.
+func aes128CTR(key []byte, iv []byte, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Wrapf(
+			ErrUnsupportedKeystore, "iv length %d", len(iv),
+		)
+	}
+	out := make([]byte, len(text))
+	cipher.NewCTR(block, iv).XORKeyStream(out, text)
+	return out, nil
+}
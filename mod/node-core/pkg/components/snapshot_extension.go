@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/snapshot"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SnapshotExtensionInput is the input for the snapshot extension provider.
+type SnapshotExtensionInput struct {
+	depinject.In
+
+	BlobProcessor  *BlobProcessor
+	ChainSpec      common.ChainSpec
+	Config         *config.Config
+	Logger         log.AdvancedLogger[any, sdklog.Logger]
+	StorageBackend *StorageBackend
+}
+
+// ProvideSnapshotExtension is a depinject provider for the state sync
+// snapshot extension.
+func ProvideSnapshotExtension(
+	in SnapshotExtensionInput,
+) *SnapshotExtension {
+	return snapshot.NewExtension[
+		*AvailabilityStore,
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconBlockHeader,
+		*BeaconState,
+		*BlobProcessor,
+		*BlobSidecars,
+		*BlockStore,
+		sdk.Context,
+		*Deposit,
+		*DepositStore,
+		nodetypes.Node,
+		*StorageBackend,
+	](
+		in.Logger.With("service", "snapshot-extension"),
+		in.ChainSpec,
+		in.BlobProcessor,
+		in.StorageBackend,
+		in.Config.BlockStoreService.Enabled,
+		in.Config.BlockStoreService.AvailabilityWindow,
+	)
+}
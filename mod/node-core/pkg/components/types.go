@@ -26,6 +26,7 @@ import (
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/monitor"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
@@ -38,6 +39,7 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/reconstructor"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
@@ -45,8 +47,10 @@ import (
 	configapi "github.com/berachain/beacon-kit/mod/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/mod/node-api/handlers/debug"
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
+	keymanagerapi "github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	"github.com/berachain/beacon-kit/mod/node-api/keymanager"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
@@ -54,9 +58,12 @@ import (
 	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/snapshot"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/upgrade"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
@@ -64,7 +71,9 @@ import (
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/optimistic"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/cometbft/cometbft/privval"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -73,11 +82,15 @@ type (
 	ABCIMiddleware = middleware.ABCIMiddleware[
 		*AvailabilityStore,
 		*BeaconBlock,
+		*BeaconBlockBody,
 		*BlobSidecars,
 		*Deposit,
 		*ExecutionPayload,
 		*Genesis,
+		*SlashingInfo,
 		*SlotData,
+		*Withdrawal,
+		WithdrawalCredentials,
 	]
 
 	// AttestationData is a type alias for the attestation data.
@@ -98,6 +111,10 @@ type (
 	BeaconBlockBody   = types.BeaconBlockBody
 	BeaconBlockHeader = types.BeaconBlockHeader
 
+	// BlindedBeaconBlock type aliases.
+	BlindedBeaconBlock     = types.BlindedBeaconBlock
+	BlindedBeaconBlockBody = types.BlindedBeaconBlockBody
+
 	// BeaconState is a type alias for the BeaconState.
 	BeaconState = statedb.StateDB[
 		*BeaconBlockHeader,
@@ -126,6 +143,12 @@ type (
 		Validator,
 	]
 
+	// BlobFetcher is a type alias for the blob fetcher.
+	BlobFetcher = dablob.Fetcher[
+		*BeaconBlock,
+		*BeaconBlockBody,
+	]
+
 	// BlobProcessor is a type alias for the blob processor.
 	BlobProcessor = dablob.Processor[
 		*AvailabilityStore,
@@ -141,8 +164,16 @@ type (
 	// BlockStoreService is a type alias for the block store service.
 	BlockStoreService = blockstore.Service[*BeaconBlock, *BlockStore]
 
+	// BlockReconstructor is a type alias for the block reconstructor.
+	BlockReconstructor = reconstructor.Reconstructor[
+		*BeaconBlock,
+		*BlindedBeaconBlock,
+		*BlindedBeaconBlockBody,
+		*ExecutionPayloadHeader,
+	]
+
 	// BlockStore is a type alias for the block store.
-	BlockStore = block.KVStore[*BeaconBlock]
+	BlockStore = block.KVStore[*BeaconBlock, *BlindedBeaconBlock]
 
 	// ChainService is a type alias for the chain service.
 	ChainService = blockchain.Service[
@@ -266,6 +297,12 @@ type (
 		Validators,
 	]
 
+	// KeymanagerAPIServer is a type alias for the keymanager API server.
+	KeymanagerAPIServer = keymanager.Server[
+		NodeAPIContext,
+		*NodeAPIEngine,
+	]
+
 	// LegacyKey type alias to LegacyKey used for LegacySinger construction.
 	LegacyKey = signer.LegacyKey
 
@@ -316,12 +353,28 @@ type (
 		*NodeAPIEngine,
 	]
 
+	// OptimisticStore is a type alias for the optimistic block store.
+	OptimisticStore = optimistic.Store
+
 	// PayloadAttributes is a type alias for the payload attributes.
 	PayloadAttributes = engineprimitives.PayloadAttributes[*Withdrawal]
 
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// PrivValidatorServer is a type alias for the server of the private
+	// validator to CometBFT.
+	PrivValidatorServer = privval.SignerServer
+
+	// RelayClient is a type alias for the client of the relay to the
+	// external block builders.
+	RelayClient = relay.Client[
+		*BeaconBlock,
+		*BlindedBeaconBlock,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+	]
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -334,6 +387,23 @@ type (
 	// SlashingInfo is a type alias for the slashing info.
 	SlashingInfo = types.SlashingInfo
 
+	// SnapshotExtension is a type alias for the state sync snapshot extension.
+	SnapshotExtension = snapshot.Extension[
+		*AvailabilityStore,
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconBlockHeader,
+		*BeaconState,
+		*BlobProcessor,
+		*BlobSidecars,
+		*BlockStore,
+		sdk.Context,
+		*Deposit,
+		*DepositStore,
+		nodetypes.Node,
+		*StorageBackend,
+	]
+
 	// StateProcessor is the type alias for the state processor interface.
 	StateProcessor = core.StateProcessor[
 		*BeaconBlock,
@@ -376,12 +446,32 @@ type (
 		WithdrawalCredentials,
 	]
 
+	// UpgradeHandler is a type alias for the upgrade handler.
+	UpgradeHandler = upgrade.Handler[*BeaconState]
+
+	// UpgradeManager is a type alias for the upgrade manager.
+	UpgradeManager = upgrade.Manager[*BeaconState, *StorageBackend]
+
 	// Validator is a type alias for the validator.
 	Validator = types.Validator
 
 	// Validators is a type alias for the validators.
 	Validators = types.Validators
 
+	// ValidatorMonitor is a type alias for the validator monitor.
+	ValidatorMonitor = monitor.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		sdk.Context,
+		*Deposit,
+		*ExecutionPayload,
+		nodetypes.Node,
+		*StorageBackend,
+		*Validator,
+		*Withdrawal,
+	]
+
 	// ValidatorService is a type alias for the validator service.
 	ValidatorService = validator.Service[
 		*AttestationData,
@@ -479,7 +569,7 @@ type (
 type (
 	// BeaconAPIHandler is a type alias for the beacon handler.
 	BeaconAPIHandler = beaconapi.Handler[
-		*BeaconBlockHeader, NodeAPIContext, *Fork, *Validator,
+		*BeaconBlockHeader, *BlobSidecars, NodeAPIContext, *Fork, *Validator,
 	]
 
 	// BuilderAPIHandler is a type alias for the builder handler.
@@ -489,11 +579,16 @@ type (
 	ConfigAPIHandler = configapi.Handler[NodeAPIContext]
 
 	// DebugAPIHandler is a type alias for the debug handler.
-	DebugAPIHandler = debugapi.Handler[NodeAPIContext]
+	DebugAPIHandler = debugapi.Handler[
+		NodeAPIContext, *BeaconBlock, *BlobSidecars,
+	]
 
 	// EventsAPIHandler is a type alias for the events handler.
 	EventsAPIHandler = eventsapi.Handler[NodeAPIContext]
 
+	// KeymanagerAPIHandler is a type alias for the keymanager handler.
+	KeymanagerAPIHandler = keymanagerapi.Handler[NodeAPIContext]
+
 	// NodeAPIHandler is a type alias for the node handler.
 	NodeAPIHandler = nodeapi.Handler[NodeAPIContext]
 
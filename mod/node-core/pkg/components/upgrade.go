@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/upgrade"
+)
+
+// UpgradeManagerInput is the input for the upgrade manager provider.
+type UpgradeManagerInput struct {
+	depinject.In
+
+	Config         *config.Config
+	Logger         log.AdvancedLogger[any, sdklog.Logger]
+	StorageBackend *StorageBackend
+}
+
+// ProvideUpgradeManager is a depinject provider for the upgrade manager.
+func ProvideUpgradeManager(in UpgradeManagerInput) *UpgradeManager {
+	return upgrade.NewManager[*BeaconState, *StorageBackend](
+		in.Config.Upgrade,
+		in.Logger.With("service", "upgrade"),
+		in.StorageBackend,
+		DefaultUpgradeHandlers(),
+	)
+}
+
+// DefaultUpgradeHandlers returns the upgrade handlers shipped with this
+// binary, keyed by the name of the upgrade plan they perform. A binary meant
+// to take over at an upgrade registers the state migrations of that upgrade
+// here, nodes running a binary without the handler halt at the upgrade.
+func DefaultUpgradeHandlers() map[string]UpgradeHandler {
+	return map[string]UpgradeHandler{}
+}
@@ -34,19 +34,24 @@ import (
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
-	BeaconBlockFeed *BlockBroker
-	BlobProcessor   *BlobProcessor
-	Cfg             *config.Config
-	ChainSpec       common.ChainSpec
-	LocalBuilder    *LocalBuilder
-	Logger          log.AdvancedLogger[any, sdklog.Logger]
-	StateProcessor  *StateProcessor
-	StorageBackend  *StorageBackend
-	Signer          crypto.BLSSigner
-	SidecarsFeed    *SidecarsBroker
-	SidecarFactory  *SidecarFactory
-	SlotBroker      *SlotBroker
-	TelemetrySink   *metrics.TelemetrySink
+	BeaconBlockFeed  *BlockBroker
+	BlobProcessor    *BlobProcessor
+	Cfg              *config.Config
+	ChainSpec        common.ChainSpec
+	Doppelganger     *validator.Doppelganger
+	EngineClient     *EngineClient
+	LocalBuilder     *LocalBuilder
+	Logger           log.AdvancedLogger[any, sdklog.Logger]
+	OptimisticStore  *OptimisticStore
+	ProposerSettings *validator.ProposerSettings
+	RelayClient      *RelayClient
+	StateProcessor   *StateProcessor
+	StorageBackend   *StorageBackend
+	Signer           crypto.BLSSigner
+	SidecarsFeed     *SidecarsBroker
+	SidecarFactory   *SidecarFactory
+	SlotBroker       *SlotBroker
+	TelemetrySink    *metrics.TelemetrySink
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -82,9 +87,13 @@ func ProvideValidatorService(
 		in.Signer,
 		in.SidecarFactory,
 		in.LocalBuilder,
-		[]validator.PayloadBuilder[*BeaconState, *ExecutionPayload]{
-			in.LocalBuilder,
-		},
+		in.LocalBuilder.ForSimulation(),
+		in.RelayClient,
+		in.OptimisticStore,
+		in.ProposerSettings,
+		in.Doppelganger,
+		in.EngineClient,
+		consensusClientVersion(),
 		in.TelemetrySink,
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"io"
+	"testing"
+
+	"cosmossdk.io/core/appmodule/v2"
+	sdklog "cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// appOptions are the app options of the test node.
+type appOptions map[string]any
+
+func (o appOptions) Get(key string) any { return o[key] }
+
+func TestValidatorService_SimulateProposal(t *testing.T) {
+	var (
+		cs      = components.ProvideChainSpec()
+		cfg     = config.DefaultConfig()
+		appOpts = appOptions{flags.FlagHome: t.TempDir()}
+		logCfg  = phuslu.DefaultConfig()
+		logger  = phuslu.NewLogger[sdklog.Logger](io.Discard, &logCfg)
+		sink    = components.ProvideTelemetrySink()
+	)
+
+	key, err := signer.DeriveMasterKey(make([]byte, 32))
+	require.NoError(t, err)
+	blsSigner, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+
+	// Wire the node over an in-memory store, the execution client is never
+	// reached while simulating on a mock payload.
+	storeKey := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		storeKey, storetypes.NewTransientStoreKey("transient"),
+	)
+	depositStore, err := components.ProvideDepositStore(
+		components.DepositStoreInput{AppOpts: appOpts},
+	)
+	require.NoError(t, err)
+	backend := components.ProvideStorageBackend(
+		components.StorageBackendInput{
+			ChainSpec:    cs,
+			DepositStore: depositStore,
+			KVStore: components.ProvideKVStore(components.KVStoreInput{
+				Environment: appmodule.Environment{
+					KVStoreService: runtime.NewKVStoreService(storeKey),
+				},
+			}),
+		},
+	)
+	sp := components.ProvideStateProcessor(components.StateProcessorInput{
+		ChainSpec: cs,
+		Signer:    blsSigner,
+	})
+	proposerSettings, err := components.ProvideProposerSettings(
+		components.ProposerSettingsInput{
+			AppOpts: appOpts,
+			Cfg:     cfg,
+			Signer:  blsSigner,
+		},
+	)
+	require.NoError(t, err)
+	attributesFactory, err := components.ProvideAttributesFactory(
+		components.AttributesFactoryInput{
+			ChainSpec:        cs,
+			Logger:           logger,
+			ProposerSettings: proposerSettings,
+		},
+	)
+	require.NoError(t, err)
+	optimisticStore, err := components.ProvideOptimisticStore(
+		components.OptimisticStoreInput{AppOpts: appOpts},
+	)
+	require.NoError(t, err)
+	svc, err := components.ProvideValidatorService(
+		components.ValidatorServiceInput{
+			BeaconBlockFeed: components.ProvideBlockBroker(),
+			Cfg:             cfg,
+			ChainSpec:       cs,
+			LocalBuilder: components.ProvideLocalBuilder(
+				components.LocalBuilderInput{
+					AttributesFactory: attributesFactory,
+					Cfg:               cfg,
+					ChainSpec:         cs,
+					Logger:            logger,
+				},
+			),
+			Logger:           logger,
+			OptimisticStore:  optimisticStore,
+			ProposerSettings: proposerSettings,
+			RelayClient: components.ProvideRelayClient(
+				components.RelayClientInput{
+					Cfg:              cfg,
+					Logger:           logger,
+					ProposerSettings: proposerSettings,
+					Signer:           blsSigner,
+				},
+			),
+			StateProcessor: sp,
+			StorageBackend: backend,
+			Signer:         blsSigner,
+			SidecarsFeed:   components.ProvideBlobBroker(),
+			SidecarFactory: components.ProvideSidecarFactory(
+				components.SidecarFactoryInput{
+					ChainSpec:     cs,
+					TelemetrySink: sink,
+				},
+			),
+			SlotBroker:    components.ProvideSlotBroker(),
+			TelemetrySink: sink,
+		},
+	)
+	require.NoError(t, err)
+
+	// Start from a genesis holding the validator of the node only.
+	genesisVersion := version.FromUint32[common.Version](version.Deneb)
+	credentials := types.NewCredentialsFromExecutionAddress(
+		gethprimitives.ExecutionAddress{0x01},
+	)
+	amount := math.Gwei(cs.MaxEffectiveBalance())
+	_, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(genesisVersion, common.Root{}),
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		credentials,
+		amount,
+	)
+	require.NoError(t, err)
+	header, err := types.DefaultGenesisExecutionPayloadHeaderDeneb()
+	require.NoError(t, err)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		backend.StateFromContext(ctx),
+		[]*components.Deposit{{
+			Pubkey:      blsSigner.PublicKey(),
+			Credentials: credentials,
+			Amount:      amount,
+			Signature:   signature,
+		}},
+		header,
+		genesisVersion,
+	)
+	require.NoError(t, err)
+
+	// Simulate the proposal on a copy of the state.
+	simCtx, _ := ctx.CacheContext()
+	blk, sidecars, timings, err := svc.SimulateProposal(simCtx, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), blk.GetSlot())
+	require.Equal(t, math.ValidatorIndex(0), blk.GetProposerIndex())
+	require.Empty(t, sidecars.Sidecars)
+	require.Contains(t, timings, "total")
+
+	// The simulated block, mock payload included, passes the state
+	// transition on the untouched state with its result and randao
+	// validated.
+	slot, err := backend.StateFromContext(ctx).GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(0), slot)
+	_, err = sp.Transition(
+		&transition.Context{
+			Context:                 ctx,
+			SkipPayloadVerification: true,
+		},
+		backend.StateFromContext(ctx),
+		blk,
+	)
+	require.NoError(t, err)
+}
@@ -21,7 +21,6 @@
 package attributes
 
 import (
-	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -37,9 +36,9 @@ type Factory[
 	chainSpec common.ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger[any]
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient gethprimitives.ExecutionAddress
+	// proposerSettings provides the suggested fee recipient sent to the
+	// execution client for the payload build.
+	proposerSettings ProposerSettings
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -50,12 +49,12 @@ func NewAttributesFactory[
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
-	suggestedFeeRecipient gethprimitives.ExecutionAddress,
+	proposerSettings ProposerSettings,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:        chainSpec,
+		logger:           logger,
+		proposerSettings: proposerSettings,
 	}
 }
 
@@ -98,7 +97,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.proposerSettings.FeeRecipient(),
 		withdrawals,
 		prevHeadRoot,
 	)
@@ -34,6 +34,12 @@ type BeaconState[WithdrawalT any] interface {
 	GetRandaoMixAtIndex(index uint64) (common.Root, error)
 }
 
+// ProposerSettings is the interface for the settings of the proposer.
+type ProposerSettings interface {
+	// FeeRecipient returns the fee recipient of the proposer.
+	FeeRecipient() gethprimitives.ExecutionAddress
+}
+
 // PayloadAttributes is the interface for the payload attributes.
 type PayloadAttributes[SelfT any, WithdrawalT any] interface {
 	engineprimitives.PayloadAttributer
@@ -21,6 +21,8 @@
 package builder
 
 import (
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
@@ -52,6 +54,13 @@ type PayloadBuilder[
 	pc *cache.PayloadIDCache[
 		PayloadIDT, [32]byte, math.Slot,
 	]
+	// reserved is the payload ID cache of the payload builder this one
+	// simulates proposals for, nil otherwise. The payloads of its IDs are
+	// never retrieved, as retrieving a payload stops the execution client
+	// from improving it.
+	reserved *cache.PayloadIDCache[
+		PayloadIDT, [32]byte, math.Slot,
+	]
 	// attributesFactory is used to create attributes for the
 	attributesFactory *attributes.Factory[
 		BeaconStateT, PayloadAttributesT, WithdrawalT,
@@ -101,3 +110,36 @@ func (pb *PayloadBuilder[
 ]) Enabled() bool {
 	return pb.cfg.Enabled
 }
+
+// ForSimulation returns a copy of the payload builder for simulating
+// proposals. Its payload IDs are stored in a cache of its own, and it
+// refuses the payload IDs stored by this payload builder such that the
+// payloads of the proposals are left untouched.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) ForSimulation() *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+] {
+	sim := *pb
+	sim.pc = cache.NewPayloadIDCache[PayloadIDT, [32]byte, math.Slot]()
+	sim.reserved = pb.pc
+	return &sim
+}
+
+// isReserved returns true if the given payload ID is stored for the given
+// slot and parent block root by the payload builder this one simulates
+// proposals for.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) isReserved(
+	slot math.Slot,
+	parentBlockRoot common.Root,
+	payloadID PayloadIDT,
+) bool {
+	return pb.reserved != nil && slices.Contains(
+		pb.reserved.Candidates(slot, parentBlockRoot), payloadID,
+	)
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// slot is the slot the payloads are built for.
+	slot = 7
+	// timestamp is the timestamp of the payloads.
+	timestamp = 1_000
+)
+
+var (
+	// proposalID is the payload ID of the proposal.
+	proposalID = engineprimitives.PayloadID{0x01}
+	// simulationID is a payload ID of a simulated proposal.
+	simulationID = engineprimitives.PayloadID{0x02}
+)
+
+// requestPayloadAsync requests a payload on the given payload builder.
+func requestPayloadAsync(t *testing.T, pb *testBuilder) {
+	t.Helper()
+	_, err := pb.RequestPayloadAsync(
+		context.Background(), newTestState(), slot, timestamp,
+		parentBlockRoot, parentHash, parentHash,
+	)
+	require.NoError(t, err)
+}
+
+// requestPayloadNow requests and retrieves a payload on the given payload
+// builder.
+func requestPayloadNow(pb *testBuilder) error {
+	_, err := pb.RequestPayloadNow(
+		context.Background(), newTestState(), slot, timestamp,
+		parentBlockRoot, parentHash, parentHash,
+	)
+	return err
+}
+
+// retrievePayload retrieves the payload built on the given payload builder.
+func retrievePayload(pb *testBuilder) error {
+	_, err := pb.RetrievePayload(context.Background(), slot, parentBlockRoot)
+	return err
+}
+
+func TestForSimulation(t *testing.T) {
+	ee := &testEngine{payloadID: proposalID}
+	pb := newTestBuilder(t, ee)
+	requestPayloadAsync(t, pb)
+	sim := pb.ForSimulation()
+
+	// The payload IDs of the proposal are not shared with the simulation.
+	require.ErrorIs(t, retrievePayload(sim), builder.ErrPayloadIDNotFound)
+
+	// The payload ID of the proposal is refused when returned again by the
+	// execution client.
+	require.ErrorIs(t, requestPayloadNow(sim), builder.ErrPayloadIDReserved)
+	require.Empty(t, ee.retrieved)
+
+	// The payload IDs of the simulation are kept apart from the proposal.
+	ee.payloadID = simulationID
+	require.NoError(t, requestPayloadNow(sim))
+	require.NoError(t, retrievePayload(pb))
+	require.Equal(
+		t, []engineprimitives.PayloadID{simulationID, proposalID}, ee.retrieved,
+	)
+}
+
+func TestForSimulation_ReservedLater(t *testing.T) {
+	ee := &testEngine{payloadID: simulationID}
+	pb := newTestBuilder(t, ee)
+	sim := pb.ForSimulation()
+	requestPayloadAsync(t, sim)
+
+	// The proposal is given the payload ID of the simulation.
+	requestPayloadAsync(t, pb)
+	require.ErrorIs(t, retrievePayload(sim), builder.ErrPayloadIDReserved)
+	require.Empty(t, ee.retrieved)
+}
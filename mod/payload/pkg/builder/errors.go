@@ -37,4 +37,10 @@ var (
 	// ErrNilPayloadEnvelope is returned when a nil payload envelope is
 	// received.
 	ErrNilPayloadEnvelope = errors.New("received nil payload envelope")
+
+	// ErrPayloadIDReserved is returned when a simulated proposal is given
+	// the payload ID of a proposal.
+	ErrPayloadIDReserved = errors.New(
+		"payload ID belongs to a proposal, not retrieving it",
+	)
 )
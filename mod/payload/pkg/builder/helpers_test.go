@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	stdmath "math"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+type (
+	// testWithdrawal is a withdrawal of the test payloads.
+	testWithdrawal = engineprimitives.Withdrawal
+	// testAttributes are the payload attributes of the test payloads.
+	testAttributes = engineprimitives.PayloadAttributes[*testWithdrawal]
+	// testBuilder is the payload builder of the test payloads.
+	testBuilder = builder.PayloadBuilder[
+		*testState, *testPayload, *testHeader, *testAttributes,
+		engineprimitives.PayloadID, *testWithdrawal,
+	]
+	// testBlobsBundle is the blobs bundle of the test payloads.
+	testBlobsBundle = engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]
+)
+
+var (
+	// feeRecipient is the fee recipient of the proposer.
+	feeRecipient = common.ExecutionAddress{0xfe}
+	// randaoMix is the randao mix of the test state.
+	randaoMix = common.Bytes32{0x0a}
+	// parentBlockRoot is the root of the parent beacon block.
+	parentBlockRoot = common.Root{0x0b}
+	// parentHash is the hash of the parent execution block.
+	parentHash = common.ExecutionHash{0x0c}
+)
+
+// testPayload exposes geth executable data as an execution payload.
+type testPayload struct {
+	gethprimitives.ExecutableData
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return new(testPayload) }
+func (p *testPayload) Version() uint32           { return version.Deneb }
+func (p *testPayload) IsNil() bool               { return p == nil }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return p.BlockHash
+}
+
+func (p *testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return p.FeeRecipient
+}
+
+func (p *testPayload) GetParentHash() common.ExecutionHash {
+	return p.ParentHash
+}
+
+// testHeader is the header of the latest execution payload.
+type testHeader struct {
+	number   math.U64
+	gasLimit math.U64
+	baseFee  *math.U256
+	root     common.Bytes32
+}
+
+func (h *testHeader) GetBlockHash() common.ExecutionHash  { return parentHash }
+func (h *testHeader) GetParentHash() common.ExecutionHash { return parentHash }
+func (h *testHeader) GetStateRoot() common.Bytes32        { return h.root }
+func (h *testHeader) GetNumber() math.U64                 { return h.number }
+func (h *testHeader) GetGasLimit() math.U64               { return h.gasLimit }
+func (h *testHeader) GetBaseFeePerGas() *math.U256        { return h.baseFee }
+func (h *testHeader) GetExcessBlobGas() math.U64          { return 0 }
+
+// testState is a beacon state expecting a single withdrawal.
+type testState struct {
+	header *testHeader
+}
+
+func (s *testState) GetRandaoMixAtIndex(uint64) (common.Bytes32, error) {
+	return randaoMix, nil
+}
+
+func (s *testState) ExpectedWithdrawals() (
+	[]*testWithdrawal, error,
+) {
+	return []*testWithdrawal{{
+		Index:     3,
+		Validator: 4,
+		Address:   common.ExecutionAddress{0x05},
+		Amount:    6,
+	}}, nil
+}
+
+func (s *testState) GetLatestExecutionPayloadHeader() (*testHeader, error) {
+	return s.header, nil
+}
+
+func (s *testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (s *testState) GetBlockRootAtIndex(uint64) (common.Root, error) {
+	return parentBlockRoot, nil
+}
+
+// newTestState returns a beacon state whose latest execution payload is the
+// tenth block.
+func newTestState() *testState {
+	return &testState{header: &testHeader{
+		number:   10,
+		gasLimit: 30_000_000,
+		baseFee:  math.NewU256(7),
+		root:     common.Bytes32{0x0d},
+	}}
+}
+
+// testEngine is an execution engine returning the payload ID it is given,
+// and recording the payload IDs whose payloads are retrieved.
+type testEngine struct {
+	payloadID engineprimitives.PayloadID
+	retrieved []engineprimitives.PayloadID
+}
+
+func (e *testEngine) NotifyForkchoiceUpdate(
+	context.Context,
+	*engineprimitives.ForkchoiceUpdateRequest[*testAttributes],
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	payloadID := e.payloadID
+	return &payloadID, nil, nil
+}
+
+func (e *testEngine) GetPayload(
+	_ context.Context,
+	req *engineprimitives.GetPayloadRequest[engineprimitives.PayloadID],
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	e.retrieved = append(e.retrieved, req.PayloadID)
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*testPayload, *testBlobsBundle,
+	]{
+		ExecutionPayload: &testPayload{gethprimitives.ExecutableData{
+			FeeRecipient: feeRecipient,
+		}},
+		BlockValue:  math.NewU256(0),
+		BlobsBundle: new(testBlobsBundle),
+	}, nil
+}
+
+// testProposerSettings are the settings of the proposer.
+type testProposerSettings struct{}
+
+func (testProposerSettings) FeeRecipient() common.ExecutionAddress {
+	return feeRecipient
+}
+
+// newTestBuilder returns a payload builder over the given execution engine.
+func newTestBuilder(t *testing.T, ee *testEngine) *testBuilder {
+	t.Helper()
+	chainSpec := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:             32,
+			EpochsPerHistoricalVector: 8,
+			DenebPlusForkEpoch:        stdmath.MaxUint64,
+			ElectraForkEpoch:          stdmath.MaxUint64,
+		},
+	)
+	return builder.New[
+		*testState, *testPayload, *testHeader, *testAttributes,
+		engineprimitives.PayloadID, *testWithdrawal,
+	](
+		&builder.Config{
+			Enabled:               true,
+			SuggestedFeeRecipient: feeRecipient,
+			PayloadTimeout:        time.Millisecond,
+		},
+		chainSpec,
+		noop.NewLogger[any](),
+		ee,
+		cache.NewPayloadIDCache[
+			engineprimitives.PayloadID, [32]byte, math.Slot,
+		](),
+		attributes.NewAttributesFactory[
+			*testState, *testAttributes, *testWithdrawal,
+		](chainSpec, noop.NewLogger[any](), testProposerSettings{}),
+	)
+}
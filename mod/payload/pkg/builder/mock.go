@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BuildMockPayload builds an empty payload for the given slot on top of the
+// given execution block, as the execution client would from the payload
+// attributes of the slot, without involving the execution client. The
+// execution client does not know the payload, it is meant for simulating
+// proposals only.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) BuildMockPayload(
+	_ context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	parentEth1Hash gethprimitives.ExecutionHash,
+	_ gethprimitives.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	attrs, err := pb.attributesFactory.BuildPayloadAttributes(
+		st, slot, timestamp, parentBlockRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the attributes as the execution client does.
+	var gethAttrs gethprimitives.PayloadAttributes
+	if err = convertJSON(attrs, &gethAttrs); err != nil {
+		return nil, err
+	}
+
+	var (
+		withdrawals   = gethprimitives.Withdrawals(gethAttrs.Withdrawals)
+		blobGasUsed   uint64
+		excessBlobGas = lph.GetExcessBlobGas().Unwrap()
+	)
+	block := gethprimitives.NewBlockWithHeader(&gethprimitives.Header{
+		ParentHash:  parentEth1Hash,
+		UncleHash:   gethprimitives.EmptyUncleHash,
+		Coinbase:    gethAttrs.SuggestedFeeRecipient,
+		Root:        gethprimitives.ExecutionHash(lph.GetStateRoot()),
+		TxHash:      gethprimitives.EmptyRootHash,
+		ReceiptHash: gethprimitives.EmptyRootHash,
+		Difficulty:  new(big.Int),
+		Number: new(big.Int).SetUint64(
+			lph.GetNumber().Unwrap() + 1,
+		),
+		GasLimit:  lph.GetGasLimit().Unwrap(),
+		Time:      gethAttrs.Timestamp,
+		MixDigest: gethAttrs.Random,
+		BaseFee:   lph.GetBaseFeePerGas().ToBig(),
+		WithdrawalsHash: ptr(gethprimitives.DeriveSha(
+			withdrawals, gethprimitives.NewStackTrie(nil),
+		)),
+		BlobGasUsed:      &blobGasUsed,
+		ExcessBlobGas:    &excessBlobGas,
+		ParentBeaconRoot: gethAttrs.BeaconRoot,
+	}).WithBody(gethprimitives.Body{Withdrawals: withdrawals})
+
+	// Decode the payload as the engine client does.
+	var t ExecutionPayloadT
+	envelope := &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: t.Empty(pb.chainSpec.ActiveForkVersionForSlot(slot)),
+		BlockValue:       math.NewU256(0),
+		BlobsBundle: &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{},
+	}
+	if err = convertJSON(
+		gethprimitives.BlockToExecutableData(block, new(big.Int), nil).
+			ExecutionPayload,
+		envelope.ExecutionPayload,
+	); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// convertJSON converts from to to through their JSON encoding.
+func convertJSON(from, to any) error {
+	bz, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, to)
+}
+
+// ptr returns a pointer to the given value.
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"testing"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMockPayload(t *testing.T) {
+	ee := new(testEngine)
+	pb := newTestBuilder(t, ee)
+	envelope, err := pb.BuildMockPayload(
+		context.Background(), newTestState(), slot, timestamp,
+		parentBlockRoot, parentHash, parentHash,
+	)
+	require.NoError(t, err)
+	require.Empty(t, ee.retrieved)
+	require.Empty(t, envelope.GetBlobsBundle().GetBlobs())
+
+	// The execution client accepts the block hash of the payload.
+	payload := envelope.GetExecutionPayload()
+	beaconRoot := common.ExecutionHash(parentBlockRoot)
+	block, err := gethprimitives.ExecutableDataToBlock(
+		payload.ExecutableData, nil, &beaconRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.GetBlockHash(), block.Hash())
+
+	// The payload follows the latest execution payload and the attributes
+	// of the slot.
+	require.Equal(t, parentHash, payload.GetParentHash())
+	require.Equal(t, uint64(11), payload.Number)
+	require.Equal(t, uint64(timestamp), payload.Timestamp)
+	require.Equal(t, uint64(30_000_000), payload.GasLimit)
+	require.Equal(t, feeRecipient, payload.GetFeeRecipient())
+	require.Equal(t, common.ExecutionHash(randaoMix), payload.Random)
+	require.Equal(t, common.ExecutionHash{0x0d}, payload.StateRoot)
+	require.Len(t, payload.Withdrawals, 1)
+	require.Equal(t, uint64(4), payload.Withdrawals[0].Validator)
+	require.Empty(t, payload.Transactions)
+}
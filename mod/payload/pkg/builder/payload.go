@@ -31,7 +31,9 @@ import (
 )
 
 // RequestPayloadAsync builds a payload for the given slot and
-// returns the payload ID.
+// returns the payload ID. The payload is added to the candidates of the
+// slot and parent block root, even if one was built on them before, as the
+// execution client may have dropped the earlier ones.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -48,17 +50,6 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
-	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
-		pb.logger.Warn(
-			"aborting payload build; payload already exists in cache",
-			"for_slot",
-			slot.Base10(),
-			"parent_block_root",
-			parentBlockRoot,
-		)
-		return &payloadID, nil
-	}
-
 	// Assemble the payload attributes.
 	attrs, err := pb.attributesFactory.
 		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
@@ -83,6 +74,12 @@ func (pb *PayloadBuilder[
 		return nil, err
 	}
 
+	// The execution client returns the payload ID of the proposal to the
+	// simulations with the same payload attributes.
+	if payloadID != nil && pb.isReserved(slot, parentBlockRoot, *payloadID) {
+		return nil, ErrPayloadIDReserved
+	}
+
 	// Only add to cache if we received back a payload ID.
 	if payloadID != nil {
 		pb.pc.Set(slot, parentBlockRoot, *payloadID)
@@ -150,40 +147,78 @@ func (pb *PayloadBuilder[
 	)
 }
 
-// RetrievePayload attempts to pull a previously built payload
-// by reading a payloadID from the builder's cache. If it fails to
-// retrieve a payload, it will build a new payload and wait for the
-// execution client to return the payload.
+// RequestPayloadNow requests a payload for the given slot and retrieves it
+// right away, without waiting for the payload timeout. The execution client
+// returns whatever it assembled by then, possibly an empty payload.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
-]) RetrievePayload(
+]) RequestPayloadNow(
 	ctx context.Context,
+	st BeaconStateT,
 	slot math.Slot,
+	timestamp uint64,
 	parentBlockRoot common.Root,
+	parentEth1Hash gethprimitives.ExecutionHash,
+	finalBlockHash gethprimitives.ExecutionHash,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
 	}
 
-	// Attempt to see if we previously fired off a payload built for
-	// this particular slot and parent block root.
-	payloadID, found := pb.pc.Get(slot, parentBlockRoot)
-	if !found {
-		return nil, ErrPayloadIDNotFound
+	payloadID, err := pb.RequestPayloadAsync(
+		ctx,
+		st,
+		slot,
+		timestamp,
+		parentBlockRoot,
+		parentEth1Hash,
+		finalBlockHash,
+	)
+	if err != nil {
+		return nil, err
+	} else if payloadID == nil {
+		return nil, ErrNilPayloadID
 	}
 
-	envelope, err := pb.ee.GetPayload(
+	return pb.ee.GetPayload(
 		ctx,
 		&engineprimitives.GetPayloadRequest[PayloadIDT]{
-			PayloadID:   payloadID,
+			PayloadID:   *payloadID,
 			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
+}
+
+// RetrievePayload attempts to pull a previously built payload
+// by reading the payloadIDs from the builder's cache, from the latest
+// candidate to the oldest. If it fails to retrieve a payload, it will
+// build a new payload and wait for the execution client to return the
+// payload.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) RetrievePayload(
+	ctx context.Context,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if !pb.Enabled() {
+		return nil, ErrPayloadBuilderDisabled
+	}
+
+	// Attempt to see if we previously fired off payload builds for
+	// this particular slot and parent block root.
+	candidates := pb.pc.Candidates(slot, parentBlockRoot)
+	if len(candidates) == 0 {
+		return nil, ErrPayloadIDNotFound
+	}
+
+	envelope, err := pb.retrieveCandidate(
+		ctx, slot, parentBlockRoot, candidates,
+	)
 	if err != nil {
 		return nil, err
-	} else if envelope == nil {
-		return nil, ErrNilPayloadEnvelope
 	}
 
 	overrideBuilder := envelope.ShouldOverrideBuilder()
@@ -220,6 +255,52 @@ func (pb *PayloadBuilder[
 	return envelope, err
 }
 
+// retrieveCandidate retrieves the payload of the first of the given candidate
+// payload IDs the execution client serves, returning the error of the last
+// candidate if none is served.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) retrieveCandidate(
+	ctx context.Context,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+	candidates []PayloadIDT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var err error
+	for i, payloadID := range candidates {
+		var envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+		if pb.isReserved(slot, parentBlockRoot, payloadID) {
+			err = ErrPayloadIDReserved
+		} else {
+			envelope, err = pb.ee.GetPayload(
+				ctx,
+				&engineprimitives.GetPayloadRequest[PayloadIDT]{
+					PayloadID:   payloadID,
+					ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
+				},
+			)
+		}
+		switch {
+		case err == nil && envelope == nil:
+			err = ErrNilPayloadEnvelope
+		case err == nil:
+			return envelope, nil
+		case ctx.Err() != nil:
+			return nil, err
+		}
+
+		if i < len(candidates)-1 {
+			pb.logger.Warn(
+				"Failed to retrieve candidate payload, trying an older one",
+				"for_slot", slot.Base10(),
+				"error", err,
+			)
+		}
+	}
+	return nil, err
+}
+
 // SendForceHeadFCU builds a payload for the given slot and
 // returns the payload ID.
 //
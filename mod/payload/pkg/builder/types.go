@@ -56,6 +56,7 @@ type BeaconState[
 // ExecutionPayload is the interface for the execution payload.
 type ExecutionPayload[T constraints.ForkTyped[T]] interface {
 	constraints.ForkTyped[T]
+	constraints.JSONMarshallable
 	// GetBlockHash returns the block hash.
 	GetBlockHash() gethprimitives.ExecutionHash
 	// GetFeeRecipient returns the fee recipient.
@@ -70,6 +71,16 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() gethprimitives.ExecutionHash
 	// GetParentHash returns the parent hash.
 	GetParentHash() gethprimitives.ExecutionHash
+	// GetStateRoot returns the state root.
+	GetStateRoot() common.Bytes32
+	// GetNumber returns the block number.
+	GetNumber() math.U64
+	// GetGasLimit returns the gas limit.
+	GetGasLimit() math.U64
+	// GetBaseFeePerGas returns the base fee per gas.
+	GetBaseFeePerGas() *math.U256
+	// GetExcessBlobGas returns the excess blob gas.
+	GetExcessBlobGas() math.U64
 }
 
 // PayloadAttributes is the interface for the payload attributes.
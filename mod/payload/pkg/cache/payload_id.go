@@ -21,6 +21,7 @@
 package cache
 
 import (
+	"slices"
 	"sync"
 )
 
@@ -29,16 +30,27 @@ import (
 // memory usage.
 const historicalPayloadIDCacheSize = 2
 
+// maxPayloadIDCandidates defines the maximum number of candidate payload IDs
+// to retain for a slot and parent block root. Beyond this number, the oldest
+// candidates are dropped.
+const maxPayloadIDCandidates = 4
+
 // PayloadIDCache provides a mechanism to store and retrieve payload IDs based
 // on slot and parent block hash. It is designed to improve the efficiency of
 // payload ID retrieval by caching recent entries.
+//
+// Several candidate payloads may be built on the same parent, for instance
+// once the parent is verified during its proposal and again once it is
+// finalized. The candidates are kept such that an older one can be used if
+// the execution client no longer serves the latest one.
 type PayloadIDCache[
 	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
 ] struct {
-	// mu protects access to the slotToStateRootToPayloadID map.
+	// mu protects access to the slotToStateRootToPayloadIDs map.
 	mu sync.RWMutex
-	// slotToStateRootToPayloadID is used for storing payload ID mappings
-	slotToStateRootToPayloadID map[SlotT]map[RootT]PayloadIDT
+	// slotToStateRootToPayloadIDs is used for storing payload ID mappings,
+	// the candidates are ordered from the oldest to the latest.
+	slotToStateRootToPayloadIDs map[SlotT]map[RootT][]PayloadIDT
 }
 
 // NewPayloadIDCache initializes and returns a new instance of PayloadIDCache.
@@ -48,13 +60,12 @@ func NewPayloadIDCache[
 ]() *PayloadIDCache[PayloadIDT, RootT, SlotT] {
 	return &PayloadIDCache[PayloadIDT, RootT, SlotT]{
 		mu: sync.RWMutex{},
-		slotToStateRootToPayloadID: make(
-			map[SlotT]map[RootT]PayloadIDT,
+		slotToStateRootToPayloadIDs: make(
+			map[SlotT]map[RootT][]PayloadIDT,
 		),
 	}
 }
 
-// Has retrieves the payload ID associated with a given slot and eth1 hash.
 // Has checks if a payload ID exists for a given slot and eth1 hash.
 func (p *PayloadIDCache[_, RootT, SlotT]) Has(
 	slot SlotT,
@@ -62,30 +73,42 @@ func (p *PayloadIDCache[_, RootT, SlotT]) Has(
 ) bool {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	_, ok := p.slotToStateRootToPayloadID[slot][stateRoot]
-	return ok
+	return len(p.slotToStateRootToPayloadIDs[slot][stateRoot]) > 0
 }
 
-// Get was successful.
+// Get retrieves the latest payload ID associated with a given slot and eth1
+// hash. The boolean is true if the retrieval was successful.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Get(
 	slot SlotT,
 	stateRoot RootT,
 ) (PayloadIDT, bool) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	innerMap, ok := p.slotToStateRootToPayloadID[slot]
-	if !ok {
+	pids := p.slotToStateRootToPayloadIDs[slot][stateRoot]
+	if len(pids) == 0 {
 		return PayloadIDT{}, false
 	}
-	pid, ok := innerMap[stateRoot]
-	if !ok {
-		return PayloadIDT{}, false
+	return pids[len(pids)-1], true
+}
+
+// Candidates retrieves the candidate payload IDs associated with a given
+// slot and eth1 hash, from the latest to the oldest.
+func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Candidates(
+	slot SlotT,
+	stateRoot RootT,
+) []PayloadIDT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	pids := p.slotToStateRootToPayloadIDs[slot][stateRoot]
+	candidates := make([]PayloadIDT, len(pids))
+	for i, pid := range pids {
+		candidates[len(pids)-1-i] = pid
 	}
-	return pid, true
+	return candidates
 }
 
-// Set updates or inserts a payload ID for a given slot and eth1 hash.
-// It also prunes entries in the cache that are older than the
+// Set adds a payload ID as the latest candidate for a given slot and eth1
+// hash. It also prunes entries in the cache that are older than the
 // historicalPayloadIDCacheSize limit.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 	slot SlotT, stateRoot RootT, pid PayloadIDT,
@@ -99,12 +122,21 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 	}
 
 	// Update the cache with the new payload ID.
-	innerMap, exists := p.slotToStateRootToPayloadID[slot]
+	innerMap, exists := p.slotToStateRootToPayloadIDs[slot]
 	if !exists {
-		innerMap = make(map[RootT]PayloadIDT)
-		p.slotToStateRootToPayloadID[slot] = innerMap
+		innerMap = make(map[RootT][]PayloadIDT)
+		p.slotToStateRootToPayloadIDs[slot] = innerMap
+	}
+
+	// A payload ID handed out again moves up to the latest candidate.
+	pids := slices.DeleteFunc(innerMap[stateRoot], func(c PayloadIDT) bool {
+		return c == pid
+	})
+	pids = append(pids, pid)
+	if len(pids) > maxPayloadIDCandidates {
+		pids = pids[len(pids)-maxPayloadIDCandidates:]
 	}
-	innerMap[stateRoot] = pid
+	innerMap[stateRoot] = pids
 }
 
 // UnsafePrunePrior removes payload IDs from the cache for slots less than
@@ -121,9 +153,9 @@ func (p *PayloadIDCache[_, _, SlotT]) UnsafePrunePrior(
 // slot. This method helps in managing the memory usage of the cache by
 // discarding outdated entries.
 func (p *PayloadIDCache[_, _, SlotT]) prunePrior(slot SlotT) {
-	for s := range p.slotToStateRootToPayloadID {
+	for s := range p.slotToStateRootToPayloadIDs {
 		if s < slot {
-			delete(p.slotToStateRootToPayloadID, s)
+			delete(p.slotToStateRootToPayloadIDs, s)
 		}
 	}
 }
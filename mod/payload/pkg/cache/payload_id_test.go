@@ -59,6 +59,35 @@ func TestPayloadIDCache(t *testing.T) {
 		require.Equal(t, newPid, p)
 	})
 
+	t.Run("Candidates of the same parent", func(t *testing.T) {
+		slot := uint64(5678)
+		r := [32]byte{7, 8, 9}
+		pids := [][8]byte{{1}, {2}, {3}, {4}, {5}}
+		for _, pid := range pids {
+			cacheUnderTest.Set(slot, r, pid)
+		}
+
+		// Only the latest candidates are kept, latest first.
+		require.True(t, cacheUnderTest.Has(slot, r))
+		require.Equal(
+			t, [][8]byte{{5}, {4}, {3}, {2}},
+			cacheUnderTest.Candidates(slot, r),
+		)
+
+		// Setting a candidate again makes it the latest one.
+		cacheUnderTest.Set(slot, r, [8]byte{3})
+		p, ok := cacheUnderTest.Get(slot, r)
+		require.True(t, ok)
+		require.Equal(t, [8]byte{3}, p)
+		require.Equal(
+			t, [][8]byte{{3}, {5}, {4}, {2}},
+			cacheUnderTest.Candidates(slot, r),
+		)
+
+		// Other parents of the same slot have their own candidates.
+		require.Empty(t, cacheUnderTest.Candidates(slot, [32]byte{}))
+	})
+
 	t.Run("Prune and verify deletion", func(t *testing.T) {
 		slot := uint64(9456456)
 		r := [32]byte{4, 5, 6}
@@ -0,0 +1,436 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// statusPath is the path of the status endpoint of the relay.
+	statusPath = "/eth/v1/builder/status"
+	// registerValidatorPath is the path of the registration endpoint of the
+	// relay.
+	registerValidatorPath = "/eth/v1/builder/validators"
+	// getHeaderPath is the path of the bid endpoint of the relay.
+	getHeaderPath = "/eth/v1/builder/header/"
+	// submitBlindedBlockPath is the path of the endpoint revealing the
+	// payload of a bid in exchange for the signed blinded block.
+	submitBlindedBlockPath = "/eth/v1/builder/blinded_blocks"
+	// maxErrorBodySize is the maximum size of an error body of the relay
+	// that is reported.
+	maxErrorBodySize = 1 << 10
+	// percent is the denominator of the boost factor.
+	percent = 100
+)
+
+// Client is a client of the builder API of a relay, through which the
+// validator obtains payloads from the external block builders.
+type Client[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[ExecutionPayloadHeaderT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+] struct {
+	// cfg is the relay configuration.
+	cfg *Config
+	// logger is used for logging within the Client.
+	logger log.Logger[any]
+	// signer signs the messages of the validator and verifies the ones of
+	// the builders.
+	signer crypto.BLSSigner
+	// settings provides the fee recipient and gas limit the validator
+	// registers with.
+	settings ProposerSettings
+	// mu guards registered.
+	mu sync.Mutex
+	// registered is the last registration accepted by the relay.
+	registered *ValidatorRegistration
+	// client is the underlying HTTP client.
+	client *http.Client
+}
+
+// NewClient creates a new relay client.
+func NewClient[
+	BeaconBlockT BeaconBlock[BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[ExecutionPayloadHeaderT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+](
+	cfg *Config,
+	logger log.Logger[any],
+	signer crypto.BLSSigner,
+	settings ProposerSettings,
+) *Client[
+	BeaconBlockT, BlindedBeaconBlockT, ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+] {
+	return &Client[
+		BeaconBlockT, BlindedBeaconBlockT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT,
+	]{
+		cfg:      cfg,
+		logger:   logger,
+		signer:   signer,
+		settings: settings,
+		client:   &http.Client{},
+	}
+}
+
+// Enabled returns true if payloads are requested from the relay.
+func (c *Client[_, _, _, _]) Enabled() bool {
+	return c.cfg.Enabled
+}
+
+// Status checks that the relay is available.
+func (c *Client[_, _, _, _]) Status(ctx context.Context) error {
+	return c.do(ctx, http.MethodGet, statusPath, nil, nil)
+}
+
+// RegisterValidator registers the validator with the relay, the
+// registration is signed over the given builder domain.
+func (c *Client[_, _, _, _]) RegisterValidator(
+	ctx context.Context,
+	domain common.Domain,
+) error {
+	registration := &ValidatorRegistration{
+		FeeRecipient: c.settings.FeeRecipient(),
+		GasLimit:     math.U64(c.settings.GasLimit()),
+		//#nosec:G701 // the time is past the epoch.
+		Timestamp: math.U64(time.Now().Unix()),
+		Pubkey:    c.signer.PublicKey(),
+	}
+	root := signingRoot(registration.HashTreeRoot(), domain)
+	signature, err := c.signer.Sign(root[:])
+	if err != nil {
+		return err
+	}
+
+	if err = c.do(
+		ctx, http.MethodPost, registerValidatorPath,
+		[]*SignedValidatorRegistration{{
+			Message:   registration,
+			Signature: signature,
+		}},
+		nil,
+	); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.registered = registration
+	return nil
+}
+
+// registrationStale returns true if the validator is not registered with
+// the relay, or registered with other settings than the current ones.
+func (c *Client[_, _, _, _]) registrationStale() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.registered == nil ||
+		c.registered.FeeRecipient != c.settings.FeeRecipient() ||
+		c.registered.GasLimit.Unwrap() != c.settings.GasLimit()
+}
+
+// GetHeader requests the best bid of the relay for the payload of the given
+// slot, built on top of the given parent hash. The bid must be signed by its
+// builder over the given builder domain.
+func (c *Client[_, _, _, ExecutionPayloadHeaderT]) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash gethprimitives.ExecutionHash,
+	domain common.Domain,
+) (*BuilderBid[ExecutionPayloadHeaderT], error) {
+	var resp response[*SignedBuilderBid[ExecutionPayloadHeaderT]]
+	if err := c.do(
+		ctx, http.MethodGet,
+		getHeaderPath+slot.Base10()+"/"+parentHash.Hex()+"/"+
+			c.signer.PublicKey().String(),
+		nil, &resp,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := c.verifyBid(resp.Data, parentHash, domain); err != nil {
+		return nil, err
+	}
+	return resp.Data.Message, nil
+}
+
+// SubmitBlindedBlock signs the given block, blinded with the given header
+// of a bid, over the given proposer domain and submits it to the relay,
+// which reveals the payload of the bid in exchange. The blobs of the payload
+// must match the given commitments of the bid.
+func (c *Client[
+	BeaconBlockT, BlindedBeaconBlockT, ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+]) SubmitBlindedBlock(
+	ctx context.Context,
+	blk BeaconBlockT,
+	header ExecutionPayloadHeaderT,
+	commitments []eip4844.KZGCommitment,
+	value *math.U256,
+	domain common.Domain,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var (
+		payload  ExecutionPayloadT
+		revealed = &ExecutionPayloadAndBlobsBundle[ExecutionPayloadT]{
+			ExecutionPayload: payload.Empty(version.Deneb),
+		}
+		resp = response[*ExecutionPayloadAndBlobsBundle[ExecutionPayloadT]]{
+			Data: revealed,
+		}
+	)
+
+	blinded, err := blk.Blind()
+	if err != nil {
+		return nil, err
+	}
+	blinded.SetExecutionPayloadHeader(header)
+
+	root := signingRoot(blinded.HashTreeRoot(), domain)
+	signature, err := c.signBlock(blinded, root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.do(
+		ctx, http.MethodPost, submitBlindedBlockPath,
+		&SignedBlindedBeaconBlock[BlindedBeaconBlockT]{
+			Message:   blinded,
+			Signature: signature,
+		},
+		&resp,
+	); err != nil {
+		return nil, err
+	}
+
+	// The payload shares the hash tree root of its header, and its blobs
+	// must be the ones committed to by the bid.
+	revealed = resp.Data
+	if revealed.ExecutionPayload.HashTreeRoot() != header.HashTreeRoot() {
+		return nil, errors.Wrapf(
+			ErrPayloadMismatch, "block hash %s",
+			revealed.ExecutionPayload.GetBlockHash(),
+		)
+	}
+	if revealed.BlobsBundle == nil {
+		revealed.BlobsBundle = &BlobsBundle{}
+	}
+	if !slices.Equal(revealed.BlobsBundle.GetCommitments(), commitments) {
+		return nil, errors.Wrap(ErrPayloadMismatch, "blob commitments")
+	}
+
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT, *BlobsBundle,
+	]{
+		ExecutionPayload: revealed.ExecutionPayload,
+		BlockValue:       value,
+		BlobsBundle:      revealed.BlobsBundle,
+	}, nil
+}
+
+// RetrieveBid requests the best bid of the relay for the given slot and
+// returns the header, blob commitments and value of its payload, provided
+// its boosted value beats the given value of the local payload.
+func (c *Client[_, _, _, ExecutionPayloadHeaderT]) RetrieveBid(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash gethprimitives.ExecutionHash,
+	localValue *math.U256,
+	builderDomain common.Domain,
+) (ExecutionPayloadHeaderT, []eip4844.KZGCommitment, *math.U256, error) {
+	var header ExecutionPayloadHeaderT
+
+	// The bids must pay the current fee recipient, renew the registration
+	// if the proposer settings changed since.
+	if c.registrationStale() {
+		if err := c.RegisterValidator(ctx, builderDomain); err != nil {
+			return header, nil, nil, err
+		}
+	}
+
+	bid, err := c.GetHeader(ctx, slot, parentHash, builderDomain)
+	if err != nil {
+		return header, nil, nil, err
+	}
+
+	if !c.ShouldUseBid(bid.Value, localValue) {
+		return header, nil, nil, errors.Wrapf(
+			ErrBidTooLow, "bid %s, local %s", bid.Value, localValue,
+		)
+	}
+
+	c.logger.Info(
+		"Using builder bid",
+		"slot", slot.Base10(),
+		"value", bid.Value,
+		"block_hash", bid.Header.GetBlockHash(),
+	)
+	return bid.Header, bid.BlobKzgCommitments, bid.Value, nil
+}
+
+// ShouldUseBid returns true if the value of a bid, boosted by the boost
+// factor, beats the value of the local payload.
+func (c *Client[_, _, _, _]) ShouldUseBid(
+	bidValue *math.U256,
+	localValue *math.U256,
+) bool {
+	if c.cfg.BoostFactor == 0 {
+		return false
+	}
+	if localValue == nil {
+		return true
+	}
+
+	// Compare bid * boost / 100 against the local value without dividing,
+	// so that no precision is lost.
+	boosted, overflow := new(math.U256).MulOverflow(
+		bidValue, math.NewU256(c.cfg.BoostFactor),
+	)
+	if overflow {
+		return true
+	}
+	scaled, overflow := new(math.U256).MulOverflow(
+		localValue, math.NewU256(percent),
+	)
+	return !overflow && boosted.Gt(scaled)
+}
+
+// verifyBid verifies that the given bid is signed by its builder and builds
+// on top of the given parent hash.
+func (c *Client[_, _, _, ExecutionPayloadHeaderT]) verifyBid(
+	bid *SignedBuilderBid[ExecutionPayloadHeaderT],
+	parentHash gethprimitives.ExecutionHash,
+	domain common.Domain,
+) error {
+	switch {
+	case bid == nil || bid.Message == nil:
+		return errors.Wrap(ErrInvalidBid, "empty bid")
+	case bid.Message.Value == nil || bid.Message.Value.IsZero():
+		return errors.Wrap(ErrInvalidBid, "zero value")
+	case len(bid.Message.BlobKzgCommitments) > maxBlobCommitmentsPerBlock:
+		return errors.Wrap(ErrInvalidBid, "too many blob commitments")
+	case bid.Message.Header.GetParentHash() != parentHash:
+		return errors.Wrapf(
+			ErrInvalidBid, "parent hash %s, expected %s",
+			bid.Message.Header.GetParentHash(), parentHash,
+		)
+	}
+
+	root, err := bid.Message.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(ErrInvalidBid, err.Error())
+	}
+	root = signingRoot(root, domain)
+	if err = c.signer.VerifySignature(
+		bid.Message.Pubkey, root[:], bid.Signature,
+	); err != nil {
+		return errors.Wrapf(ErrInvalidBid, "signature: %v", err)
+	}
+	return nil
+}
+
+// signBlock signs the given blinded block, through a dedicated request if
+// the signer supports one.
+func (c *Client[_, BlindedBeaconBlockT, _, _]) signBlock(
+	blk BlindedBeaconBlockT,
+	root common.Root,
+) (crypto.BLSSignature, error) {
+	if signer, ok := c.signer.(BlockSigner); ok {
+		return signer.SignBlock(
+			blk.GetSlot(), blk.GetProposerIndex(), blk.GetParentBlockRoot(),
+			blk.GetStateRoot(), blk.GetBodyRoot(), root,
+		)
+	}
+	return c.signer.Sign(root[:])
+}
+
+// do sends a request to the relay and decodes its response into the given
+// result, if any.
+func (c *Client[_, _, _, _]) do(
+	ctx context.Context,
+	method string,
+	path string,
+	body any,
+	result any,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		bz, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.cfg.URL+path, reader,
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return ErrNoBid
+	default:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Wrapf(
+			ErrUnexpectedStatus, "%d: %s", resp.StatusCode, msg,
+		)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,582 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	builderDomain  = common.Domain{0x00, 0x00, 0x00, 0x01}
+	proposerDomain = common.Domain{0x00, 0x00, 0x00, 0x00}
+	parentHash     = gethprimitives.ExecutionHash{0x01}
+	proposerKey    = crypto.BLSPubkey{0xaa}
+	proposerIndex  = math.ValidatorIndex(7)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                 Test Types                                 */
+/* -------------------------------------------------------------------------- */
+
+// fields are the fields shared by the test payloads and headers.
+type fields struct {
+	ParentHash gethprimitives.ExecutionHash `json:"parentHash"`
+	BlockHash  gethprimitives.ExecutionHash `json:"blockHash"`
+}
+
+func (f fields) root() common.Root {
+	return sha256.Sum256(append(f.ParentHash[:], f.BlockHash[:]...))
+}
+
+type header struct{ fields }
+
+func (h *header) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.fields)
+}
+
+func (h *header) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, &h.fields)
+}
+
+func (h *header) HashTreeRoot() common.Root {
+	return h.root()
+}
+
+func (h *header) GetBlockHash() gethprimitives.ExecutionHash {
+	return h.BlockHash
+}
+
+func (h *header) GetParentHash() gethprimitives.ExecutionHash {
+	return h.ParentHash
+}
+
+type payload struct{ fields }
+
+func (*payload) Empty(uint32) *payload {
+	return &payload{}
+}
+
+func (p *payload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.fields)
+}
+
+func (p *payload) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, &p.fields)
+}
+
+func (p *payload) HashTreeRoot() common.Root {
+	return p.root()
+}
+
+func (p *payload) GetBlockHash() gethprimitives.ExecutionHash {
+	return p.BlockHash
+}
+
+// block is a beacon block carrying a local payload, proposed with the
+// payload of a bid instead.
+type block struct {
+	slot        math.Slot
+	parentRoot  common.Root
+	stateRoot   common.Root
+	header      *header
+	commitments []eip4844.KZGCommitment
+}
+
+func (b *block) Blind() (*blindedBlock, error) {
+	return &blindedBlock{
+		Slot:          b.slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    b.parentRoot,
+		StateRoot:     b.stateRoot,
+		Body: &blindedBody{
+			ExecutionPayloadHeader: b.header,
+			BlobKzgCommitments:     b.commitments,
+		},
+	}, nil
+}
+
+// blindedBody and blindedBlock follow the JSON encoding of the blinded
+// blocks of the builder API.
+//
+//nolint:lll // struct tags.
+type blindedBody struct {
+	ExecutionPayloadHeader *header                 `json:"execution_payload_header"`
+	BlobKzgCommitments     []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+}
+
+func (b *blindedBody) root() common.Root {
+	root := b.ExecutionPayloadHeader.HashTreeRoot()
+	for _, commitment := range b.BlobKzgCommitments {
+		root = sha256.Sum256(append(root[:], commitment[:]...))
+	}
+	return root
+}
+
+type blindedBlock struct {
+	Slot          math.Slot           `json:"slot"`
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	ParentRoot    common.Root         `json:"parent_root"`
+	StateRoot     common.Root         `json:"state_root"`
+	Body          *blindedBody        `json:"body"`
+}
+
+func (b *blindedBlock) HashTreeRoot() common.Root {
+	bodyRoot := b.Body.root()
+	return sha256.Sum256(slices.Concat(
+		uint64Bytes(b.Slot), uint64Bytes(b.ProposerIndex),
+		b.ParentRoot[:], b.StateRoot[:], bodyRoot[:],
+	))
+}
+
+func (b *blindedBlock) GetSlot() math.Slot {
+	return b.Slot
+}
+
+func (b *blindedBlock) GetProposerIndex() math.ValidatorIndex {
+	return b.ProposerIndex
+}
+
+func (b *blindedBlock) GetParentBlockRoot() common.Root {
+	return b.ParentRoot
+}
+
+func (b *blindedBlock) GetStateRoot() common.Root {
+	return b.StateRoot
+}
+
+func (b *blindedBlock) GetBodyRoot() common.Root {
+	return b.Body.root()
+}
+
+func (b *blindedBlock) SetExecutionPayloadHeader(h *header) {
+	b.Body.ExecutionPayloadHeader = h
+}
+
+func uint64Bytes(v math.U64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, v.Unwrap())
+}
+
+// settings are proposer settings that can be changed by the tests.
+type settings struct {
+	feeRecipient gethprimitives.ExecutionAddress
+	gasLimit     uint64
+}
+
+func (s *settings) FeeRecipient() gethprimitives.ExecutionAddress {
+	return s.feeRecipient
+}
+
+func (s *settings) GasLimit() uint64 {
+	return s.gasLimit
+}
+
+// signer is a crypto.BLSSigner whose signatures are hashes of the public
+// key and the message.
+type signer struct {
+	pubkey crypto.BLSPubkey
+}
+
+func (s signer) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+func (s signer) Sign(msg []byte) (crypto.BLSSignature, error) {
+	return sign(s.pubkey, msg), nil
+}
+
+func (signer) VerifySignature(
+	pubkey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+) error {
+	if sign(pubkey, msg) != signature {
+		return relay.ErrInvalidBid
+	}
+	return nil
+}
+
+func sign(pubkey crypto.BLSPubkey, msg []byte) crypto.BLSSignature {
+	var signature crypto.BLSSignature
+	h := sha256.Sum256(append(pubkey[:], msg...))
+	copy(signature[:], h[:])
+	return signature
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Stub Relay                                 */
+/* -------------------------------------------------------------------------- */
+
+// stubRelay is an in-process relay serving a single bid.
+type stubRelay struct {
+	t       *testing.T
+	builder signer
+	mu      sync.Mutex
+	// bid is the bid served by the relay, nil for no bid.
+	bid *relay.BuilderBid[*header]
+	// payload is the payload revealed by the relay.
+	payload *payload
+	// delay delays every answer of the relay.
+	delay time.Duration
+	// registrations are the registrations received by the relay.
+	registrations []*relay.SignedValidatorRegistration
+	// submitted are the blinded blocks submitted to the relay.
+	submitted []*blindedBlock
+}
+
+func newStubRelay(t *testing.T) (*stubRelay, *httptest.Server) {
+	t.Helper()
+	f := fields{ParentHash: parentHash, BlockHash: common.ExecutionHash{0x02}}
+	r := &stubRelay{
+		t:       t,
+		builder: signer{pubkey: crypto.BLSPubkey{0xbb}},
+		bid: &relay.BuilderBid[*header]{
+			Header:             &header{f},
+			BlobKzgCommitments: []eip4844.KZGCommitment{{0xcc}},
+			Value:              math.NewU256(100),
+		},
+		payload: &payload{f},
+	}
+	r.bid.Pubkey = r.builder.pubkey
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /eth/v1/builder/status", r.status)
+	mux.HandleFunc("POST /eth/v1/builder/validators", r.registerValidator)
+	mux.HandleFunc(
+		"GET /eth/v1/builder/header/{slot}/{parent}/{pubkey}", r.getHeader,
+	)
+	mux.HandleFunc(
+		"POST /eth/v1/builder/blinded_blocks", r.submitBlindedBlock,
+	)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return r, server
+}
+
+func (r *stubRelay) wait() {
+	r.mu.Lock()
+	delay := r.delay
+	r.mu.Unlock()
+	time.Sleep(delay)
+}
+
+func (r *stubRelay) status(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *stubRelay) registerValidator(
+	w http.ResponseWriter, req *http.Request,
+) {
+	var registrations []*relay.SignedValidatorRegistration
+	require.NoError(r.t, json.NewDecoder(req.Body).Decode(&registrations))
+	r.mu.Lock()
+	r.registrations = append(r.registrations, registrations...)
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *stubRelay) getHeader(w http.ResponseWriter, req *http.Request) {
+	r.wait()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.bid == nil || req.PathValue("parent") != parentHash.Hex() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	bidRoot, err := r.bid.HashTreeRoot()
+	require.NoError(r.t, err)
+	root := signingRoot(bidRoot, builderDomain)
+	signature, _ := r.builder.Sign(root[:])
+	writeJSON(r.t, w, map[string]any{
+		"version": "deneb",
+		"data": &relay.SignedBuilderBid[*header]{
+			Message:   r.bid,
+			Signature: signature,
+		},
+	})
+}
+
+// submitBlindedBlock reveals the payload of the bid in exchange for a
+// blinded block carrying its header, signed by the proposer.
+func (r *stubRelay) submitBlindedBlock(
+	w http.ResponseWriter, req *http.Request,
+) {
+	r.wait()
+	var signed struct {
+		Message   *blindedBlock       `json:"message"`
+		Signature crypto.BLSSignature `json:"signature"`
+	}
+	require.NoError(r.t, json.NewDecoder(req.Body).Decode(&signed))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.submitted = append(r.submitted, signed.Message)
+	blk, root := signed.Message, signed.Message.HashTreeRoot()
+	root = signingRoot(root, proposerDomain)
+	switch {
+	case blk == nil || blk.Body == nil ||
+		blk.Body.ExecutionPayloadHeader == nil:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case sign(proposerKey, root[:]) != signed.Signature,
+		blk.Body.ExecutionPayloadHeader.HashTreeRoot() !=
+			r.bid.Header.HashTreeRoot(),
+		!slices.Equal(
+			blk.Body.BlobKzgCommitments, r.bid.BlobKzgCommitments,
+		):
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	writeJSON(r.t, w, map[string]any{
+		"version": "deneb",
+		"data": &relay.ExecutionPayloadAndBlobsBundle[*payload]{
+			ExecutionPayload: r.payload,
+			BlobsBundle: &relay.BlobsBundle{
+				Commitments: r.bid.BlobKzgCommitments,
+				Proofs:      []eip4844.KZGProof{{}},
+				Blobs:       []*eip4844.Blob{{}},
+			},
+		},
+	})
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+// signingRoot mirrors compute_signing_root.
+func signingRoot(root common.Root, domain common.Domain) common.Root {
+	return sha256.Sum256(append(root[:], domain[:]...))
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Tests                                   */
+/* -------------------------------------------------------------------------- */
+
+func newClient(
+	t *testing.T, url string, boostFactor uint64,
+) *relay.Client[*block, *blindedBlock, *payload, *header] {
+	t.Helper()
+	return newClientWithSettings(t, url, boostFactor, &settings{
+		feeRecipient: gethprimitives.ExecutionAddress{0xfe},
+		gasLimit:     30_000_000,
+	})
+}
+
+func newClientWithSettings(
+	t *testing.T, url string, boostFactor uint64, s *settings,
+) *relay.Client[*block, *blindedBlock, *payload, *header] {
+	t.Helper()
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = url
+	cfg.Timeout = 200 * time.Millisecond
+	cfg.BoostFactor = boostFactor
+	return relay.NewClient[*block, *blindedBlock, *payload, *header](
+		&cfg, noop.NewLogger[any](), signer{pubkey: proposerKey}, s,
+	)
+}
+
+// newBlock returns a block of the slot carrying a local payload.
+func newBlock() *block {
+	return &block{
+		slot:       1,
+		parentRoot: common.Root{0x03},
+		stateRoot:  common.Root{0x04},
+		header: &header{
+			fields{ParentHash: parentHash, BlockHash: common.ExecutionHash{9}},
+		},
+	}
+}
+
+// retrieve requests a bid beating the given local value and, if any,
+// proposes the block with its payload.
+func retrieve(
+	c *relay.Client[*block, *blindedBlock, *payload, *header],
+	localValue uint64,
+) (engineprimitives.BuiltExecutionPayloadEnv[*payload], error) {
+	header, commitments, value, err := c.RetrieveBid(
+		context.Background(), 1, parentHash,
+		math.NewU256(localValue), builderDomain,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk := newBlock()
+	blk.commitments = commitments
+	return c.SubmitBlindedBlock(
+		context.Background(), blk, header, commitments, value,
+		proposerDomain,
+	)
+}
+
+func TestClient_RegisterValidator(t *testing.T) {
+	stub, server := newStubRelay(t)
+	c := newClient(t, server.URL, 100)
+	require.NoError(t, c.Status(context.Background()))
+	require.NoError(t, c.RegisterValidator(context.Background(), builderDomain))
+
+	require.Len(t, stub.registrations, 1)
+	reg := stub.registrations[0]
+	require.Equal(t, proposerKey, reg.Message.Pubkey)
+	require.Equal(
+		t, gethprimitives.ExecutionAddress{0xfe}, reg.Message.FeeRecipient,
+	)
+	require.Equal(t, math.U64(30_000_000), reg.Message.GasLimit)
+}
+
+func TestClient_RegistrationRenewedOnSettingsChange(t *testing.T) {
+	stub, server := newStubRelay(t)
+	s := &settings{
+		feeRecipient: gethprimitives.ExecutionAddress{0xfe},
+		gasLimit:     30_000_000,
+	}
+	c := newClientWithSettings(t, server.URL, 100, s)
+
+	// The validator registers before its first payload request ...
+	_, err := retrieve(c, 0)
+	require.NoError(t, err)
+	require.Len(t, stub.registrations, 1)
+
+	// ... but not again while its settings are unchanged ...
+	_, err = retrieve(c, 0)
+	require.NoError(t, err)
+	require.Len(t, stub.registrations, 1)
+
+	// ... and registers its new fee recipient before the next request.
+	s.feeRecipient = gethprimitives.ExecutionAddress{0xef}
+	s.gasLimit = 36_000_000
+	_, err = retrieve(c, 0)
+	require.NoError(t, err)
+	require.Len(t, stub.registrations, 2)
+	require.Equal(
+		t, gethprimitives.ExecutionAddress{0xef},
+		stub.registrations[1].Message.FeeRecipient,
+	)
+	require.Equal(
+		t, math.U64(36_000_000), stub.registrations[1].Message.GasLimit,
+	)
+}
+
+func TestClient_RevealPayload(t *testing.T) {
+	stub, server := newStubRelay(t)
+	envelope, err := retrieve(newClient(t, server.URL, 100), 50)
+	require.NoError(t, err)
+	require.Equal(
+		t, stub.payload.BlockHash, envelope.GetExecutionPayload().BlockHash,
+	)
+	require.Equal(t, math.NewU256(100), envelope.GetValue())
+	require.Equal(
+		t, stub.bid.BlobKzgCommitments,
+		envelope.GetBlobsBundle().GetCommitments(),
+	)
+}
+
+func TestClient_SubmitBlindedBlock(t *testing.T) {
+	stub, server := newStubRelay(t)
+	_, err := retrieve(newClient(t, server.URL, 100), 50)
+	require.NoError(t, err)
+
+	// The relay receives the block blinded with the header of the bid, in
+	// place of the one of the local payload, and signed by the proposer.
+	require.Len(t, stub.submitted, 1)
+	blk := stub.submitted[0]
+	require.Equal(t, math.Slot(1), blk.Slot)
+	require.Equal(t, proposerIndex, blk.ProposerIndex)
+	require.Equal(t, common.Root{0x03}, blk.ParentRoot)
+	require.Equal(t, common.Root{0x04}, blk.StateRoot)
+	require.Equal(
+		t, stub.bid.Header.HashTreeRoot(),
+		blk.Body.ExecutionPayloadHeader.HashTreeRoot(),
+	)
+}
+
+func TestClient_BoostFactor(t *testing.T) {
+	_, server := newStubRelay(t)
+
+	// The bid of 100 does not beat a local payload of 150 ...
+	_, err := retrieve(newClient(t, server.URL, 100), 150)
+	require.ErrorIs(t, err, relay.ErrBidTooLow)
+
+	// ... unless boosted by 2.
+	_, err = retrieve(newClient(t, server.URL, 200), 150)
+	require.NoError(t, err)
+
+	// A boost factor of 0 always selects the local payload.
+	_, err = retrieve(newClient(t, server.URL, 0), 0)
+	require.ErrorIs(t, err, relay.ErrBidTooLow)
+}
+
+func TestClient_InvalidBid(t *testing.T) {
+	stub, server := newStubRelay(t)
+	c := newClient(t, server.URL, 100)
+
+	// The bid must build on top of the requested parent.
+	stub.bid.Header.ParentHash = common.ExecutionHash{0xff}
+	_, err := retrieve(c, 0)
+	require.ErrorIs(t, err, relay.ErrInvalidBid)
+
+	// The bid must be signed by its builder.
+	stub.bid.Header.ParentHash = parentHash
+	stub.bid.Pubkey = crypto.BLSPubkey{0xdd}
+	_, err = retrieve(c, 0)
+	require.ErrorIs(t, err, relay.ErrInvalidBid)
+}
+
+func TestClient_PayloadMismatch(t *testing.T) {
+	stub, server := newStubRelay(t)
+	stub.payload = &payload{fields{BlockHash: common.ExecutionHash{0x04}}}
+	_, err := retrieve(newClient(t, server.URL, 100), 0)
+	require.ErrorIs(t, err, relay.ErrPayloadMismatch)
+}
+
+func TestClient_NoBid(t *testing.T) {
+	stub, server := newStubRelay(t)
+	stub.bid = nil
+	_, err := retrieve(newClient(t, server.URL, 100), 0)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	stub, server := newStubRelay(t)
+	stub.delay = 500 * time.Millisecond
+	_, err := retrieve(newClient(t, server.URL, 100), 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultTimeout is the default timeout of a request to the relay.
+	defaultTimeout = 500 * time.Millisecond
+	// defaultBoostFactor is the default boost factor, in percent, applied to
+	// the value of a builder bid.
+	defaultBoostFactor = 100
+	// defaultGasLimit is the default gas limit the validator registers with
+	// the relay.
+	defaultGasLimit = 30_000_000
+)
+
+// Config is the configuration for the relay to the external block builders.
+type Config struct {
+	// Enabled determines if payloads are requested from the relay.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the address of the relay.
+	URL string `mapstructure:"url"`
+	// Timeout is the timeout of each request to the relay, the local payload
+	// is used if the relay does not answer in time. Both the bid and its
+	// payload must be retrieved within the proposal deadline.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// BoostFactor is the percentage applied to the value of a builder bid
+	// before comparing it to the value of the local payload. A boost factor
+	// of 0 always selects the local payload.
+	BoostFactor uint64 `mapstructure:"boost-factor"`
+	// GasLimit is the gas limit the validator registers with the relay,
+	// unless overridden through the keymanager API.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		URL:         "",
+		Timeout:     defaultTimeout,
+		BoostFactor: defaultBoostFactor,
+		GasLimit:    defaultGasLimit,
+	}
+}
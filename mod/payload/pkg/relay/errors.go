@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoBid is returned when the relay has no bid for the slot.
+	ErrNoBid = errors.New("relay has no bid for the slot")
+
+	// ErrUnexpectedStatus is returned when the relay answers with an
+	// unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected status from relay")
+
+	// ErrInvalidBid is returned when the bid of the relay does not hold.
+	ErrInvalidBid = errors.New("invalid builder bid")
+
+	// ErrBidTooLow is returned when the boosted value of the bid does not
+	// beat the value of the local payload.
+	ErrBidTooLow = errors.New("builder bid does not beat local payload")
+
+	// ErrPayloadMismatch is returned when the payload revealed by the relay
+	// does not match the header of the bid.
+	ErrPayloadMismatch = errors.New("revealed payload does not match bid")
+)
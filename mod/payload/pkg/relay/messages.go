@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"encoding/binary"
+	"slices"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+)
+
+// maxBlobCommitmentsPerBlock is the maximum number of KZG commitments of a
+// bid, as per the Deneb specification.
+const maxBlobCommitmentsPerBlock = 4096
+
+// BlobsBundle is the blobs bundle of a payload revealed by the relay.
+type BlobsBundle = engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+]
+
+// response is the envelope of the responses of the relay.
+type response[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                Registration                                */
+/* -------------------------------------------------------------------------- */
+
+// ValidatorRegistration is the registration of a validator with the relay,
+// as defined in the builder API.
+type ValidatorRegistration struct {
+	// FeeRecipient is the address that receives the fees of the payloads.
+	FeeRecipient gethprimitives.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the gas limit of the payloads.
+	GasLimit math.U64 `json:"gas_limit"`
+	// Timestamp is the time of the registration.
+	Timestamp math.U64 `json:"timestamp"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	var feeRecipient common.Root
+	copy(feeRecipient[:], r.FeeRecipient[:])
+	return merkleizeContainer(
+		feeRecipient,
+		uint64Chunk(r.GasLimit),
+		uint64Chunk(r.Timestamp),
+		common.Root(r.Pubkey.HashTreeRoot()),
+	)
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     Bid                                    */
+/* -------------------------------------------------------------------------- */
+
+// BuilderBid is the bid of a builder for the payload of a slot, as defined
+// in the builder API.
+type BuilderBid[ExecutionPayloadHeaderT ExecutionPayloadHeader] struct {
+	// Header is the header of the payload.
+	Header ExecutionPayloadHeaderT `json:"header"`
+	// BlobKzgCommitments are the commitments to the blobs of the payload.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Value is the Wei value paid to the fee recipient by the payload.
+	Value *math.U256 `json:"value"`
+	// Pubkey is the public key of the builder.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BuilderBid.
+func (b *BuilderBid[_]) HashTreeRoot() (common.Root, error) {
+	hasher := newRootHasher()
+	commitmentsRoot, err := hasher.NewRootWithMaxLeaves(
+		eip4844.KZGCommitments[gethprimitives.ExecutionHash](
+			b.BlobKzgCommitments,
+		).Leafify(),
+		maxBlobCommitmentsPerBlock,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	// The value is SSZ encoded in little-endian.
+	value := common.Root(b.Value.Bytes32())
+	slices.Reverse(value[:])
+
+	return merkleizeContainer(
+		b.Header.HashTreeRoot(),
+		hasher.MixIn(commitmentsRoot, uint64(len(b.BlobKzgCommitments))),
+		value,
+		common.Root(b.Pubkey.HashTreeRoot()),
+	), nil
+}
+
+// SignedBuilderBid is a BuilderBid signed by the builder.
+type SignedBuilderBid[ExecutionPayloadHeaderT ExecutionPayloadHeader] struct {
+	Message   *BuilderBid[ExecutionPayloadHeaderT] `json:"message"`
+	Signature crypto.BLSSignature                  `json:"signature"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Payload                                  */
+/* -------------------------------------------------------------------------- */
+
+// SignedBlindedBeaconBlock is a blinded beacon block signed by the
+// proposer, in exchange for which the relay reveals the payload of the bid
+// whose header the block carries.
+type SignedBlindedBeaconBlock[BlindedBeaconBlockT any] struct {
+	Message   BlindedBeaconBlockT `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// ExecutionPayloadAndBlobsBundle is the payload revealed by the relay, along
+// with its blobs.
+type ExecutionPayloadAndBlobsBundle[ExecutionPayloadT any] struct {
+	ExecutionPayload ExecutionPayloadT `json:"execution_payload"`
+	BlobsBundle      *BlobsBundle      `json:"blobs_bundle"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// newRootHasher returns a new hasher of merkle roots.
+func newRootHasher() *merkle.RootHasher[common.Root] {
+	return merkle.NewRootHasher(
+		merkle.NewHasher[common.Root](sha256.CustomHashFn()),
+		merkle.BuildParentTreeRoots,
+	)
+}
+
+// merkleizeContainer computes the hash tree root of a container from the
+// hash tree roots of its fields.
+func merkleizeContainer(fields ...common.Root) common.Root {
+	// The number of leaves never exceeds the limit, so this cannot fail.
+	root, _ := newRootHasher().NewRootWithMaxLeaves(
+		fields, math.U64(len(fields)),
+	)
+	return root
+}
+
+// uint64Chunk returns the SSZ chunk of the given integer.
+func uint64Chunk(value math.U64) common.Root {
+	var chunk common.Root
+	binary.LittleEndian.PutUint64(chunk[:], value.Unwrap())
+	return chunk
+}
+
+// signingRoot computes the signing root of an object from its hash tree
+// root, as per compute_signing_root of the Ethereum 2.0 specification.
+func signingRoot(objectRoot common.Root, domain common.Domain) common.Root {
+	return merkleizeContainer(objectRoot, common.Root(domain))
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for the beacon block proposed with the
+// payload of a bid.
+type BeaconBlock[BlindedBeaconBlockT any] interface {
+	// Blind returns the block with its execution payload replaced by its
+	// header.
+	Blind() (BlindedBeaconBlockT, error)
+}
+
+// BlindedBeaconBlock is the interface for the blinded beacon block signed
+// by the proposer and submitted to the relay.
+type BlindedBeaconBlock[ExecutionPayloadHeaderT any] interface {
+	// HashTreeRoot returns the hash tree root of the block, which matches
+	// the one of the block carrying the payload.
+	HashTreeRoot() common.Root
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetProposerIndex returns the proposer index of the block.
+	GetProposerIndex() math.ValidatorIndex
+	// GetParentBlockRoot returns the parent block root of the block.
+	GetParentBlockRoot() common.Root
+	// GetStateRoot returns the state root of the block.
+	GetStateRoot() common.Root
+	// GetBodyRoot returns the hash tree root of the body of the block.
+	GetBodyRoot() common.Root
+	// SetExecutionPayloadHeader sets the execution payload header of the
+	// block.
+	SetExecutionPayloadHeader(ExecutionPayloadHeaderT)
+}
+
+// BlockSigner is implemented by the signers which sign the block headers
+// with a dedicated request, such as the remote signer.
+type BlockSigner interface {
+	// SignBlock signs the given signing root of the header of the block
+	// proposed at the given slot.
+	SignBlock(
+		slot math.Slot,
+		proposerIndex math.ValidatorIndex,
+		parentRoot common.Root,
+		stateRoot common.Root,
+		bodyRoot common.Root,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// ExecutionPayload is the interface for the execution payload.
+type ExecutionPayload[T any] interface {
+	constraints.JSONMarshallable
+	constraints.EmptyWithVersion[T]
+	// HashTreeRoot returns the hash tree root of the execution payload,
+	// which matches the one of its header.
+	HashTreeRoot() common.Root
+	// GetBlockHash returns the block hash.
+	GetBlockHash() gethprimitives.ExecutionHash
+}
+
+// ExecutionPayloadHeader is the interface for the execution payload header.
+type ExecutionPayloadHeader interface {
+	constraints.JSONMarshallable
+	// HashTreeRoot returns the hash tree root of the execution payload
+	// header.
+	HashTreeRoot() common.Root
+	// GetBlockHash returns the block hash.
+	GetBlockHash() gethprimitives.ExecutionHash
+	// GetParentHash returns the parent hash.
+	GetParentHash() gethprimitives.ExecutionHash
+}
+
+// ProposerSettings is the interface for the settings of the proposer.
+type ProposerSettings interface {
+	// FeeRecipient returns the fee recipient of the proposer.
+	FeeRecipient() gethprimitives.ExecutionAddress
+	// GasLimit returns the gas limit of the proposer.
+	GasLimit() uint64
+}
@@ -22,6 +22,7 @@ package jwt
 
 import (
 	"crypto/rand"
+	"os"
 	"regexp"
 	"strings"
 
@@ -58,6 +59,15 @@ func NewFromHex(hexStr string) (*Secret, error) {
 	return &s, nil
 }
 
+// NewFromFile reads a hex encoded JWT secret from the file at the given path.
+func NewFromFile(path string) (*Secret, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromHex(strings.TrimSpace(string(data)))
+}
+
 // NewRandom creates a new random JWT secret.
 func NewRandom() (*Secret, error) {
 	secret := make([]byte, EthereumJWTLength)
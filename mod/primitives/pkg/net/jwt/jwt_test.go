@@ -28,6 +28,7 @@ import (
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -172,3 +173,36 @@ func TestSecretRoundTripEncoding(t *testing.T) {
 		"Round trip encoding failed",
 	)
 }
+
+func TestBuildSignedJWTWithClaims(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	parse := func(token string) gjwt.MapClaims {
+		claims := gjwt.MapClaims{}
+		_, err = gjwt.ParseWithClaims(
+			token, claims, func(*gjwt.Token) (any, error) {
+				return secret.Bytes(), nil
+			},
+		)
+		require.NoError(t, err)
+		return claims
+	}
+
+	// The optional claims are omitted unless set.
+	token, err := jwt.BuildSignedJWT(secret)
+	require.NoError(t, err)
+	claims := parse(token)
+	require.Contains(t, claims, "iat")
+	require.NotContains(t, claims, "id")
+	require.NotContains(t, claims, "clv")
+
+	token, err = jwt.BuildSignedJWTWithClaims(secret, jwt.Claims{
+		ID:            "node-1",
+		ClientVersion: "beacon-kit/v1.0.0",
+	})
+	require.NoError(t, err)
+	claims = parse(token)
+	require.Equal(t, "node-1", claims["id"])
+	require.Equal(t, "beacon-kit/v1.0.0", claims["clv"])
+}
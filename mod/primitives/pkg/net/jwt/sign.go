@@ -27,11 +27,35 @@ import (
 	gjwt "github.com/golang-jwt/jwt/v5"
 )
 
+// Claims are the optional claims of a JWT sent to the execution client, as
+// defined by the Engine API authentication specification.
+type Claims struct {
+	// ID uniquely identifies the consensus client node. It is sent as the
+	// "id" claim if set.
+	ID string
+	// ClientVersion is the version of the consensus client. It is sent as
+	// the "clv" claim if set.
+	ClientVersion string
+}
+
 // BuildSignedJWT builds a signed JWT from the provided JWT secret.
 func BuildSignedJWT(s *Secret) (string, error) {
-	token := gjwt.NewWithClaims(gjwt.SigningMethodHS256, gjwt.MapClaims{
+	return BuildSignedJWTWithClaims(s, Claims{})
+}
+
+// BuildSignedJWTWithClaims builds a signed JWT from the provided JWT secret
+// that carries the given optional claims.
+func BuildSignedJWTWithClaims(s *Secret, claims Claims) (string, error) {
+	mapClaims := gjwt.MapClaims{
 		"iat": &gjwt.NumericDate{Time: time.Now()},
-	})
+	}
+	if claims.ID != "" {
+		mapClaims["id"] = claims.ID
+	}
+	if claims.ClientVersion != "" {
+		mapClaims["clv"] = claims.ClientVersion
+	}
+	token := gjwt.NewWithClaims(gjwt.SigningMethodHS256, mapClaims)
 	str, err := token.SignedString(s[:])
 	if err != nil {
 		return "", errors.Wrapf(ErrCreateJWT, "%w", err)
@@ -28,13 +28,18 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
+// ChainSpec is the chain spec the consensus parameters are read from.
 type ChainSpec interface {
 	// GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 	// slot.
 	GetCometBFTConfigForSlot(math.Slot) any
+	// CometBFTConfigUpdateAtSlot returns the CometBFT configuration that
+	// comes into effect at the given slot, if any.
+	CometBFTConfigUpdateAtSlot(math.Slot) (any, bool)
 }
 
-// ConsensusParamsStore is a store for consensus parameters.
+// ConsensusParamsStore is a store for consensus parameters. The parameters
+// are not persisted, they follow the schedule of the chain spec instead.
 type ConsensusParamsStore struct {
 	cs ChainSpec
 }
@@ -46,14 +51,19 @@ func NewConsensusParamsStore(cs ChainSpec) *ConsensusParamsStore {
 	}
 }
 
-// Get retrieves the consensus parameters from the store.
-// It returns the consensus parameters and an error, if any.
+// Get retrieves the consensus parameters in effect at the height of the
+// given context. It returns the consensus parameters and an error, if any.
 func (s *ConsensusParamsStore) Get(
-	context.Context,
+	ctx context.Context,
 ) (cmtproto.ConsensusParams, error) {
-	return s.cs.
-		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams).
-		ToProto(), nil
+	var height int64
+	// The SDK hands its own context to the store, which carries the height
+	// of the block being processed.
+	if hctx, ok := ctx.(interface{ BlockHeight() int64 }); ok {
+		height = hctx.BlockHeight()
+	}
+	params := s.cs.GetCometBFTConfigForSlot(heightToSlot(height))
+	return params.(*cmttypes.ConsensusParams).ToProto(), nil
 }
 
 // Has checks if the consensus parameters exist in the store.
@@ -65,9 +75,36 @@ func (s *ConsensusParamsStore) Has(context.Context) (bool, error) {
 
 // Set stores the given consensus parameters in the store.
 // It returns an error, if any.
+//
+// NOTE: this is a no-op, changes to the consensus parameters are scheduled
+// through the chain spec.
 func (s *ConsensusParamsStore) Set(
 	_ context.Context,
 	_ cmtproto.ConsensusParams,
 ) error {
 	return nil
 }
+
+// UpdatesForHeight returns the consensus parameters that come into effect at
+// the given height, or nil if the parameters do not change at that height.
+// CometBFT applies the updates returned by FinalizeBlock at height H from
+// height H+1 on, hence the updates for H+1 are returned when finalizing H.
+func (s *ConsensusParamsStore) UpdatesForHeight(
+	height int64,
+) *cmtproto.ConsensusParams {
+	params, ok := s.cs.CometBFTConfigUpdateAtSlot(heightToSlot(height))
+	if !ok {
+		return nil
+	}
+	updates := params.(*cmttypes.ConsensusParams).ToProto()
+	return &updates
+}
+
+// heightToSlot converts a CometBFT height to a slot.
+func heightToSlot(height int64) math.Slot {
+	if height < 0 {
+		return 0
+	}
+	//#nosec:G701 // checked above.
+	return math.Slot(height)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrMissingListenAddr is returned when CometBFT does not listen for an
+	// external signer.
+	ErrMissingListenAddr = errors.New(
+		"priv_validator_laddr must be set to serve the private validator",
+	)
+
+	// ErrUnsupportedProtocol is returned when the private validator listen
+	// address of CometBFT is neither a tcp nor a unix address.
+	ErrUnsupportedProtocol = errors.New(
+		"unsupported private validator listen address protocol",
+	)
+
+	// ErrMissingRPCListenAddr is returned when the proposer cannot be
+	// predicted since CometBFT does not serve its RPC.
+	ErrMissingRPCListenAddr = errors.New(
+		"rpc.laddr must be set to predict the proposer",
+	)
+
+	// ErrProposerNotPredictable is returned when the proposer cannot be
+	// predicted from the proposer priorities, as the validator set changed
+	// or the previous one is unknown.
+	ErrProposerNotPredictable = errors.New(
+		"proposer not predictable from the validator set",
+	)
+
+	// ErrEmptyValidatorSet is returned when CometBFT serves an empty
+	// validator set.
+	ErrEmptyValidatorSet = errors.New("empty validator set")
+)
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// privValidatorConnRetries is the number of attempts to connect to the
+// CometBFT node, which listens for the signer once the application is built.
+// The signer is part of the node process so it keeps trying for its
+// lifetime.
+const privValidatorConnRetries = math.MaxInt32
+
+// NewPrivValidator creates the private validator signing the CometBFT votes
+// and proposals with the given key, which is not read from the CometBFT key
+// file. Its last sign state is persisted at the given path, and loaded from
+// it if present, to prevent double signing.
+func NewPrivValidator(
+	privKey cmtcrypto.PrivKey,
+	stateFilePath string,
+) (*privval.FilePV, error) {
+	// The key file path is left empty as the key is never saved.
+	pv := privval.NewFilePV(privKey, "", stateFilePath)
+
+	bz, err := os.ReadFile(stateFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return pv, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = cmtjson.Unmarshal(bz, &pv.LastSignState); err != nil {
+		return nil, errors.Wrapf(
+			err, "private validator state %s", stateFilePath,
+		)
+	}
+	return pv, nil
+}
+
+// GuardedPrivValidator is a private validator refusing to sign the CometBFT
+// votes and proposals while its guard returns an error.
+type GuardedPrivValidator struct {
+	cmttypes.PrivValidator
+	// guard returns an error if the votes and proposals must not be signed.
+	guard func() error
+}
+
+// NewGuardedPrivValidator wraps the given private validator such that it
+// only signs the votes and proposals allowed by the given guard.
+func NewGuardedPrivValidator(
+	pv cmttypes.PrivValidator,
+	guard func() error,
+) *GuardedPrivValidator {
+	return &GuardedPrivValidator{PrivValidator: pv, guard: guard}
+}
+
+// SignVote signs the given vote if the guard allows it.
+func (pv *GuardedPrivValidator) SignVote(
+	chainID string,
+	vote *cmtproto.Vote,
+	signExtension bool,
+) error {
+	if err := pv.guard(); err != nil {
+		return err
+	}
+	return pv.PrivValidator.SignVote(chainID, vote, signExtension)
+}
+
+// SignProposal signs the given proposal if the guard allows it.
+func (pv *GuardedPrivValidator) SignProposal(
+	chainID string,
+	proposal *cmtproto.Proposal,
+) error {
+	if err := pv.guard(); err != nil {
+		return err
+	}
+	return pv.PrivValidator.SignProposal(chainID, proposal)
+}
+
+// ServePrivValidator serves the given private validator to the CometBFT
+// node listening for an external signer at the given address, see the
+// priv_validator_laddr option of CometBFT. The connection is established,
+// and re-established, in the background.
+func ServePrivValidator(
+	logger cmtlog.Logger,
+	listenAddr string,
+	chainID string,
+	pv cmttypes.PrivValidator,
+	timeout time.Duration,
+) (*privval.SignerServer, error) {
+	if listenAddr == "" {
+		return nil, ErrMissingListenAddr
+	}
+
+	var dialer privval.SocketDialer
+	switch protocol, address := splitListenAddr(listenAddr); protocol {
+	case "unix":
+		dialer = privval.DialUnixFn(address)
+	case "tcp":
+		// The connection is authenticated with an ephemeral key, as the
+		// CometBFT node does on its side.
+		dialer = privval.DialTCPFn(
+			address, timeout, ed25519.GenPrivKey(),
+		)
+	default:
+		return nil, errors.Wrap(ErrUnsupportedProtocol, listenAddr)
+	}
+
+	server := privval.NewSignerServer(
+		privval.NewSignerDialerEndpoint(
+			logger, dialer,
+			privval.SignerDialerEndpointConnRetries(privValidatorConnRetries),
+		), chainID, pv,
+	)
+	if err := server.Start(); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// splitListenAddr splits the given listen address into its protocol and
+// address, the protocol defaults to tcp.
+func splitListenAddr(listenAddr string) (string, string) {
+	if protocol, address, found := strings.Cut(listenAddr, "://"); found {
+		return protocol, address
+	}
+	return "tcp", listenAddr
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errHeldBack = errors.New("held back")
+
+func TestGuardedPrivValidator(t *testing.T) {
+	var allowed bool
+	pv := comet.NewGuardedPrivValidator(
+		cmttypes.NewMockPV(),
+		func() error {
+			if !allowed {
+				return errHeldBack
+			}
+			return nil
+		},
+	)
+
+	// The votes and proposals are held back while the guard returns an
+	// error.
+	vote := &cmtproto.Vote{Type: cmtproto.PrevoteType, Height: 1}
+	require.ErrorIs(t, pv.SignVote("chain", vote, false), errHeldBack)
+	require.Empty(t, vote.Signature)
+	proposal := &cmtproto.Proposal{Height: 1}
+	require.ErrorIs(t, pv.SignProposal("chain", proposal), errHeldBack)
+	require.Empty(t, proposal.Signature)
+
+	allowed = true
+	require.NoError(t, pv.SignVote("chain", vote, false))
+	require.NotEmpty(t, vote.Signature)
+	require.NoError(t, pv.SignProposal("chain", proposal))
+	require.NotEmpty(t, proposal.Signature)
+}
@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+const (
+	// proposerLookaheadRounds is the number of rounds of a height for which
+	// the proposer is predicted. A node proposing in one of the first rounds
+	// of a height is a likely proposer, as the round it proposes in depends
+	// on whether the proposers before it manage to get their block through.
+	proposerLookaheadRounds = 2
+
+	// validatorsPerPage is the largest page of validators served by the
+	// CometBFT RPC.
+	validatorsPerPage = 100
+)
+
+// ProposerPredictor predicts the upcoming proposer duties of this node from
+// the proposer priority rotation of CometBFT. The validator sets, along with
+// the proposer priorities, are read from the RPC of the local CometBFT node,
+// which knows the validator set of a height once the height before the
+// previous one is committed.
+type ProposerPredictor struct {
+	// client is the client of the CometBFT RPC, nil if the RPC is not
+	// served.
+	client *jsonrpcclient.Client
+	// address is the CometBFT address of this node.
+	address cmtcrypto.Address
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// height is the height of the cached prediction.
+	height int64
+	// likely is the cached prediction.
+	likely bool
+	// err is the error of the cached prediction.
+	err error
+	// vals is the validator set of the cached prediction, the previous one
+	// of the next prediction.
+	vals *cmttypes.ValidatorSet
+}
+
+// NewProposerPredictor creates a new proposer predictor for the node with
+// the given public key, reading the validator sets from the CometBFT RPC
+// listening at the given address, see the rpc.laddr option of CometBFT.
+func NewProposerPredictor(
+	rpcListenAddr string,
+	pubkey crypto.BLSPubkey,
+) (*ProposerPredictor, error) {
+	p := &ProposerPredictor{
+		address: cmtcrypto.AddressHash(pubkey[:]),
+	}
+	if rpcListenAddr == "" {
+		return p, nil
+	}
+
+	// The RPC may listen on all interfaces, it is reached locally.
+	client, err := jsonrpcclient.New(
+		strings.Replace(rpcListenAddr, "0.0.0.0", "127.0.0.1", 1),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "rpc listen address %s", rpcListenAddr)
+	}
+	p.client = client
+	return p, nil
+}
+
+// IsLikelyProposer returns true if this node is likely to propose the block
+// at the given slot, that is if it proposes in one of the first rounds of
+// the height of the slot. An error is returned if the proposer cannot be
+// predicted.
+func (p *ProposerPredictor) IsLikelyProposer(
+	ctx context.Context,
+	slot math.Slot,
+) (bool, error) {
+	if p.client == nil {
+		return false, ErrMissingRPCListenAddr
+	}
+
+	//#nosec:G701 // slots are far below max int64.
+	height := int64(slot.Unwrap())
+	if height <= 1 {
+		return false, ErrProposerNotPredictable
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.height == height && p.vals != nil {
+		return p.likely, p.err
+	}
+
+	prev := p.vals
+	if p.height != height-1 || prev == nil {
+		var err error
+		if prev, err = p.validators(ctx, height-1); err != nil {
+			return false, err
+		}
+	}
+	vals, err := p.validators(ctx, height)
+	if err != nil {
+		return false, err
+	}
+	p.height, p.vals = height, vals
+	p.likely, p.err = p.isProposer(prev, vals)
+	return p.likely, p.err
+}
+
+// isProposer returns true if this node proposes in one of the lookahead
+// rounds of the height of the given validator set.
+func (p *ProposerPredictor) isProposer(
+	prev, vals *cmttypes.ValidatorSet,
+) (bool, error) {
+	proposers, err := roundProposers(prev, vals, proposerLookaheadRounds-1)
+	if err != nil {
+		return false, err
+	}
+	for _, proposer := range proposers {
+		if bytes.Equal(proposer, p.address) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Proposers returns the CometBFT addresses of the proposers of the rounds of
+// the given height, from the first one up to the given round. An error is
+// returned if the proposers cannot be found.
+func (p *ProposerPredictor) Proposers(
+	ctx context.Context,
+	height int64,
+	round int32,
+) ([][]byte, error) {
+	if p.client == nil {
+		return nil, ErrMissingRPCListenAddr
+	}
+	if height <= 1 {
+		return nil, ErrProposerNotPredictable
+	}
+
+	prev, err := p.validators(ctx, height-1)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := p.validators(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return roundProposers(prev, vals, round)
+}
+
+// roundProposers returns the addresses of the proposers of the rounds of the
+// height of the given validator set, up to the given round, rotating the
+// proposer as CometBFT does.
+//
+// The served proposer priorities of a height are the ones after its first
+// proposer was picked, who is therefore found by rotating the validator set
+// of the previous height. This only holds if the validator set did not
+// change in between, which is verified by the rotation yielding the
+// validator set of the height.
+func roundProposers(
+	prev, vals *cmttypes.ValidatorSet,
+	round int32,
+) ([][]byte, error) {
+	rotated := prev.CopyIncrementProposerPriority(1)
+	if !equalValidatorSets(rotated, vals) {
+		return nil, ErrProposerNotPredictable
+	}
+	proposers := make([][]byte, 0, round+1)
+	proposers = append(proposers, rotated.GetProposer().Address)
+	for r := int32(1); r <= round; r++ {
+		proposers = append(
+			proposers,
+			vals.CopyIncrementProposerPriority(r).GetProposer().Address,
+		)
+	}
+	return proposers, nil
+}
+
+// equalValidatorSets returns true if the given validator sets hold the same
+// validators, with the same voting powers and proposer priorities.
+func equalValidatorSets(a, b *cmttypes.ValidatorSet) bool {
+	if len(a.Validators) != len(b.Validators) {
+		return false
+	}
+	for i, v := range a.Validators {
+		w := b.Validators[i]
+		if !bytes.Equal(v.Address, w.Address) ||
+			v.VotingPower != w.VotingPower ||
+			v.ProposerPriority != w.ProposerPriority {
+			return false
+		}
+	}
+	return true
+}
+
+// rpcValidator is a validator as served by the CometBFT RPC. Only the fields
+// required to rotate the proposer are decoded.
+type rpcValidator struct {
+	Address          cmtbytes.HexBytes `json:"address"`
+	VotingPower      int64             `json:"voting_power"`
+	ProposerPriority int64             `json:"proposer_priority"`
+}
+
+// rpcValidators is a page of validators as served by the CometBFT RPC.
+type rpcValidators struct {
+	Validators []rpcValidator `json:"validators"`
+	Total      int            `json:"total"`
+}
+
+// validators reads the validator set of the given height from the CometBFT
+// RPC, along with the proposer priorities.
+func (p *ProposerPredictor) validators(
+	ctx context.Context,
+	height int64,
+) (*cmttypes.ValidatorSet, error) {
+	vals := make([]*cmttypes.Validator, 0)
+	for page := 1; ; page++ {
+		var res rpcValidators
+		if _, err := p.client.Call(ctx, "validators", map[string]any{
+			"height":   height,
+			"page":     page,
+			"per_page": validatorsPerPage,
+		}, &res); err != nil {
+			return nil, err
+		}
+		for _, v := range res.Validators {
+			vals = append(vals, &cmttypes.Validator{
+				Address:          cmtcrypto.Address(v.Address),
+				VotingPower:      v.VotingPower,
+				ProposerPriority: v.ProposerPriority,
+			})
+		}
+		if len(res.Validators) == 0 || len(vals) >= res.Total {
+			break
+		}
+	}
+
+	if len(vals) == 0 {
+		return nil, ErrEmptyValidatorSet
+	}
+	// The validators are not passed through NewValidatorSet, which would
+	// reset their proposer priorities.
+	return &cmttypes.ValidatorSet{Validators: vals}, nil
+}
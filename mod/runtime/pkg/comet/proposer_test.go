@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// serveValidators serves the given validator sets by height over a stub of
+// the CometBFT RPC, a single validator per page.
+func serveValidators(
+	t *testing.T,
+	sets map[int64]*cmttypes.ValidatorSet,
+) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     json.RawMessage            `json:"id"`
+				Params map[string]json.RawMessage `json:"params"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			height := paramInt(req.Params["height"])
+			page := paramInt(req.Params["page"])
+
+			set, ok := sets[height]
+			if !ok || page < 1 || int(page) > set.Size() {
+				http.Error(w, "not found", http.StatusBadRequest)
+				return
+			}
+			result, err := cmtjson.Marshal(&ctypes.ResultValidators{
+				BlockHeight: height,
+				Validators:  set.Validators[page-1 : page],
+				Count:       1,
+				Total:       set.Size(),
+			})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+				"result":  json.RawMessage(result),
+			})
+		},
+	))
+	t.Cleanup(server.Close)
+	return "tcp://" + server.Listener.Addr().String()
+}
+
+// paramInt decodes an integer parameter, encoded as a string by the
+// CometBFT client.
+func paramInt(bz json.RawMessage) int64 {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return 0
+	}
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+// validatorSet creates a validator set of the given public keys and voting
+// powers.
+func validatorSet(
+	pubkeys []crypto.BLSPubkey,
+	powers []int64,
+) *cmttypes.ValidatorSet {
+	vals := make([]*cmttypes.Validator, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		vals[i] = &cmttypes.Validator{
+			Address:     cmtcrypto.AddressHash(pubkey[:]),
+			VotingPower: powers[i],
+		}
+	}
+	return cmttypes.NewValidatorSet(vals)
+}
+
+func TestProposerPredictorRotation(t *testing.T) {
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}, {0x03}, {0x04}, {0x05}}
+	sets := map[int64]*cmttypes.ValidatorSet{
+		9: validatorSet(pubkeys, []int64{10, 20, 30, 40, 50}),
+	}
+	for height := int64(10); height <= 20; height++ {
+		sets[height] = sets[height-1].CopyIncrementProposerPriority(1)
+	}
+	addr := serveValidators(t, sets)
+
+	for _, pubkey := range pubkeys {
+		predictor, err := comet.NewProposerPredictor(addr, pubkey)
+		require.NoError(t, err)
+		address := cmtcrypto.AddressHash(pubkey[:])
+
+		for height := int64(10); height <= 20; height++ {
+			// The proposer of the first round is known to CometBFT but not
+			// served by its RPC.
+			expected := bytes.Equal(
+				sets[height].Proposer.Address, address,
+			) || bytes.Equal(
+				sets[height].CopyIncrementProposerPriority(1).
+					Proposer.Address, address,
+			)
+
+			//#nosec:G701 // heights are positive.
+			likely, err := predictor.IsLikelyProposer(
+				context.Background(), math.Slot(height),
+			)
+			require.NoError(t, err)
+			require.Equal(t, expected, likely, "height %d", height)
+		}
+	}
+}
+
+func TestProposerPredictorProposers(t *testing.T) {
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}, {0x03}}
+	sets := map[int64]*cmttypes.ValidatorSet{
+		9: validatorSet(pubkeys, []int64{10, 20, 30}),
+	}
+	sets[10] = sets[9].CopyIncrementProposerPriority(1)
+	predictor, err := comet.NewProposerPredictor(
+		serveValidators(t, sets), pubkeys[0],
+	)
+	require.NoError(t, err)
+
+	proposers, err := predictor.Proposers(context.Background(), 10, 3)
+	require.NoError(t, err)
+	require.Len(t, proposers, 4)
+	require.Equal(t, []byte(sets[10].Proposer.Address), proposers[0])
+	for round := int32(1); round <= 3; round++ {
+		require.Equal(
+			t,
+			[]byte(sets[10].CopyIncrementProposerPriority(round).
+				Proposer.Address),
+			proposers[round],
+			"round %d", round,
+		)
+	}
+}
+
+func TestProposerPredictorValidatorSetChange(t *testing.T) {
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}, {0x03}}
+	addr := serveValidators(t, map[int64]*cmttypes.ValidatorSet{
+		9:  validatorSet(pubkeys, []int64{10, 10, 10}),
+		10: validatorSet(pubkeys, []int64{10, 10, 20}),
+	})
+
+	predictor, err := comet.NewProposerPredictor(addr, pubkeys[0])
+	require.NoError(t, err)
+	_, err = predictor.IsLikelyProposer(context.Background(), 10)
+	require.ErrorIs(t, err, comet.ErrProposerNotPredictable)
+}
+
+func TestProposerPredictorWithoutRPC(t *testing.T) {
+	predictor, err := comet.NewProposerPredictor("", crypto.BLSPubkey{})
+	require.NoError(t, err)
+	_, err = predictor.IsLikelyProposer(context.Background(), 10)
+	require.ErrorIs(t, err, comet.ErrMissingRPCListenAddr)
+}
@@ -42,7 +42,8 @@ import (
 
 // InitGenesis is called by the base app to initialize the state of the.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _,
+	GenesisT, _, _, _, _,
 ]) InitGenesis(
 	ctx context.Context,
 	bz []byte,
@@ -78,7 +79,8 @@ func (h *ABCIMiddleware[
 // waitForGenesisData waits for the genesis data to be processed and returns
 // the validator updates.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _,
+	GenesisT, _, _, _, _,
 ]) waitForGenesisData(ctx context.Context) (
 	transition.ValidatorUpdates, error) {
 	select {
@@ -101,7 +103,8 @@ func (h *ABCIMiddleware[
 
 // prepareProposal is the internal handler for preparing proposals.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, SlotDataT,
+	_, _, _, _, _, _,
+	_, _, SlotDataT, _, _,
 ]) PrepareProposal(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -128,9 +131,16 @@ func (h *ABCIMiddleware[
 		return beaconBlockErr
 	})
 
+	// The sidecars the validators find in their blob pool are left out of
+	// the first proposal of a height. Once a proposal of this height was
+	// processed, the round failed, maybe on a missing blob, and every
+	// sidecar is proposed.
+	//#nosec:G701 // the slot is the height of the block.
+	omitPooled := int64(slotData.GetSlot()) > h.processedHeight.Load()
+
 	// Wait for the sidecars to be built.
 	g.Go(func() error {
-		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx)
+		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx, omitPooled)
 		return sidecarsErr
 	})
 
@@ -139,10 +149,11 @@ func (h *ABCIMiddleware[
 	return beaconBlockBz, sidecarsBz, g.Wait()
 }
 
-// waitForSidecars waits for the sidecars to be built and returns them.
+// waitForSidecars waits for the sidecars to be built and returns them,
+// without the ones of the pooled blobs if omitPooled is set.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
-]) waitForSidecars(ctx context.Context) ([]byte, error) {
+	_, _, _, _, _, _, _, _, _, _, _,
+]) waitForSidecars(ctx context.Context, omitPooled bool) ([]byte, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -150,13 +161,17 @@ func (h *ABCIMiddleware[
 		if msg.Error() != nil {
 			return nil, msg.Error()
 		}
-		return h.blobGossiper.Publish(ctx, msg.Data())
+		sidecars := msg.Data()
+		if omitPooled {
+			sidecars = h.blobFetcher.OmitPooledSidecars(ctx, sidecars)
+		}
+		return h.blobGossiper.Publish(ctx, sidecars)
 	}
 }
 
 // waitforBeaconBlk waits for the beacon block to be built and returns it.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) waitforBeaconBlk(ctx context.Context) ([]byte, error) {
 	select {
 	case <-ctx.Done():
@@ -179,7 +194,8 @@ func (h *ABCIMiddleware[
 // ProcessProposal processes the proposal for the ABCI middleware.
 // It handles both the beacon block and blob sidecars concurrently.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
 ]) ProcessProposal(
 	ctx context.Context,
 	req proto.Message,
@@ -197,6 +213,7 @@ func (h *ABCIMiddleware[
 	}
 
 	defer h.metrics.measureProcessProposalDuration(startTime)
+	h.processedHeight.Store(abciReq.GetHeight())
 
 	// Request the beacon block.
 	if blk, err = h.beaconBlockGossiper.Request(ctx, abciReq); err != nil {
@@ -213,21 +230,60 @@ func (h *ABCIMiddleware[
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
+	// Build the sidecars of the blobs held by the execution client locally,
+	// only the sidecars taken from the proposal need to be verified. The
+	// proposal is rejected if some blobs cannot be found.
+	sidecars, unverified, err := h.blobFetcher.FetchSidecars(
+		ctx, blk, sidecars,
+	)
+	if err != nil {
+		return h.createProcessProposalResponse(err)
+	}
+
 	// Begin processing the blob sidecars.
 	g.Go(func() error {
-		return h.verifyBlobSidecars(ctx, sidecars)
+		return h.verifyBlobSidecars(ctx, unverified)
 	})
 
 	// Wait for both processes to complete and then
 	// return the appropriate response.s
-	return h.createProcessProposalResponse(g.Wait())
+	if err = g.Wait(); err == nil {
+		h.storeFetchedSidecars(blk, sidecars)
+	}
+	return h.createProcessProposalResponse(err)
+}
+
+// storeFetchedSidecars keeps the sidecars assembled for a verified proposal,
+// so that they are persisted once the block is finalized.
+func (h *ABCIMiddleware[
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
+]) storeFetchedSidecars(blk BeaconBlockT, sidecars BlobSidecarsT) {
+	h.fetchedSidecarsMu.Lock()
+	defer h.fetchedSidecarsMu.Unlock()
+	h.fetchedSidecars[blk.HashTreeRoot()] = sidecars
+}
+
+// takeFetchedSidecars returns the sidecars assembled for the given block, if
+// it was verified as a proposal, and forgets the sidecars of every proposal
+// of the height.
+func (h *ABCIMiddleware[
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
+]) takeFetchedSidecars(blk BeaconBlockT) (BlobSidecarsT, bool) {
+	h.fetchedSidecarsMu.Lock()
+	defer h.fetchedSidecarsMu.Unlock()
+	sidecars, ok := h.fetchedSidecars[blk.HashTreeRoot()]
+	clear(h.fetchedSidecars)
+	return sidecars, ok
 }
 
 // verifyBeaconBlock handles the processing of the beacon block.
 // It requests the block, publishes a received event, and waits for
 // verification.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
 ]) verifyBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
@@ -258,7 +314,8 @@ func (h *ABCIMiddleware[
 // It requests the sidecars, publishes a received event, and waits for
 // processing.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
 ]) verifyBlobSidecars(
 	ctx context.Context,
 	sidecars BlobSidecarsT,
@@ -288,7 +345,7 @@ func (h *ABCIMiddleware[
 // createResponse generates the appropriate ProcessProposalResponse based on the
 // error.
 func (*ABCIMiddleware[
-	_, BeaconBlockT, _, BlobSidecarsT, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) createProcessProposalResponse(
 	err error,
 ) (proto.Message, error) {
@@ -308,7 +365,7 @@ func (*ABCIMiddleware[
 // is responsible for aggregating oracle data from each validator and writing
 // the oracle data to the store.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) PreBlock(
 	_ context.Context, req proto.Message,
 ) error {
@@ -321,12 +378,15 @@ func (h *ABCIMiddleware[
 	return nil
 }
 
-// EndBlock returns the validator set updates from the beacon state.
+// EndBlock returns the validator set updates from the beacon state, along
+// with the events describing the beacon-chain activity of the block.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT, DepositT,
+	ExecutionPayloadT, _, SlashingInfoT, _, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) EndBlock(
 	ctx context.Context,
-) (transition.ValidatorUpdates, error) {
+) (transition.ValidatorUpdates, []cmtabci.Event, error) {
 	blk, blobs, err := encoding.
 		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](
 		h.req,
@@ -338,23 +398,59 @@ func (h *ABCIMiddleware[
 	if err != nil {
 		// If we don't have a block, we can't do anything.
 		//nolint:nilerr // by design.
-		return nil, nil
+		return nil, nil, nil
+	}
+
+	// Prefer the sidecars assembled while verifying the proposal, as the
+	// blobs found in the blob pool are not taken from the request. The
+	// sidecars of a block this node did not accept as a proposal, such as
+	// the blocks it syncs, are assembled and verified now.
+	if fetched, ok := h.takeFetchedSidecars(blk); ok {
+		blobs = fetched
+	} else if blobs, err = h.recoverSidecars(ctx, blk, blobs); err != nil {
+		return nil, nil, err
 	}
 
 	// Send the sidecars to the sidecars feed and wait for a response
 	if err = h.processSidecars(ctx, blobs); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Process the beacon block and return the validator updates.
-	return h.processBeaconBlock(
-		ctx, blk,
+	updates, err := h.processBeaconBlock(ctx, blk)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updates, BlockEvents[
+		BeaconBlockT, BeaconBlockBodyT, DepositT, ExecutionPayloadT,
+		SlashingInfoT, WithdrawalT, WithdrawalCredentialsT,
+	](blk, updates), nil
+}
+
+// recoverSidecars assembles the sidecars of the given finalized block from
+// the blob pool, the given included sidecars and the peers, and verifies the
+// ones that were not built locally.
+func (h *ABCIMiddleware[
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
+]) recoverSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+	included BlobSidecarsT,
+) (BlobSidecarsT, error) {
+	sidecars, unverified, err := h.blobFetcher.RecoverSidecars(
+		ctx, blk, included,
 	)
+	if err != nil {
+		return sidecars, err
+	}
+	return sidecars, h.verifyBlobSidecars(ctx, unverified)
 }
 
 // processSidecars publishes the sidecars and waits for a response.
 func (h *ABCIMiddleware[
-	_, _, BlobSidecarsT, _, _, _, _,
+	_, _, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
 ]) processSidecars(ctx context.Context, blobs BlobSidecarsT) error {
 	// Publish the sidecars.
 	if err := h.sidecarsBroker.Publish(ctx, asynctypes.NewEvent(
@@ -380,7 +476,8 @@ func (h *ABCIMiddleware[
 
 // processBeaconBlock processes the beacon block and returns validator updates.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _,
+	_, _, _, _, _,
 ]) processBeaconBlock(
 	ctx context.Context, blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
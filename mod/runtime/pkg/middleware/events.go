@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// Event types emitted by the middleware on FinalizeBlock.
+const (
+	// EventTypeBlock is emitted once for every finalized beacon block.
+	EventTypeBlock = "beacon_block"
+	// EventTypeDeposit is emitted for every deposit processed in a block.
+	EventTypeDeposit = "beacon_deposit"
+	// EventTypeWithdrawal is emitted for every withdrawal paid in a block.
+	EventTypeWithdrawal = "beacon_withdrawal"
+	// EventTypeSlashing is emitted for every slashing included in a block.
+	EventTypeSlashing = "beacon_slashing"
+	// EventTypeValidatorUpdate is emitted for every change to the validator
+	// set resulting from the state transition.
+	EventTypeValidatorUpdate = "beacon_validator_update"
+)
+
+// Attribute keys of the events emitted by the middleware.
+const (
+	AttributeKeySlot                  = "slot"
+	AttributeKeyProposerIndex         = "proposer_index"
+	AttributeKeyNumDeposits           = "num_deposits"
+	AttributeKeyNumWithdrawals        = "num_withdrawals"
+	AttributeKeyNumSlashings          = "num_slashings"
+	AttributeKeyNumBlobs              = "num_blobs"
+	AttributeKeyIndex                 = "index"
+	AttributeKeyPubkey                = "pubkey"
+	AttributeKeyValidatorIndex        = "validator_index"
+	AttributeKeyAmount                = "amount"
+	AttributeKeyEffectiveBalance      = "effective_balance"
+	AttributeKeyExecutionAddress      = "execution_address"
+	AttributeKeyWithdrawalCredentials = "withdrawal_credentials"
+	AttributeKeyInfractionSlot        = "infraction_slot"
+)
+
+// BlockEvents builds the ABCI events describing the activity of a finalized
+// beacon block and the validator set updates produced by its state
+// transition. Identifying attributes (pubkeys, indexes and addresses) are
+// marked for indexing so that they can be queried through CometBFT.
+func BlockEvents[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[
+		DepositT, ExecutionPayloadT, SlashingInfoT,
+	],
+	DepositT Deposit[WithdrawalCredentialsT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalT],
+	SlashingInfoT SlashingInfo,
+	WithdrawalT Withdrawal,
+	WithdrawalCredentialsT WithdrawalCredentials,
+](
+	blk BeaconBlockT,
+	updates transition.ValidatorUpdates,
+) []cmtabci.Event {
+	var (
+		slot        = blk.GetSlot()
+		body        = blk.GetBody()
+		deposits    = body.GetDeposits()
+		withdrawals = body.GetExecutionPayload().GetWithdrawals()
+		slashings   []SlashingInfoT
+	)
+
+	// Slashing info is only carried by block bodies from DenebPlus onwards.
+	if blk.Version() >= version.DenebPlus {
+		slashings = body.GetSlashingInfo()
+	}
+	evs := make(
+		[]cmtabci.Event, 0,
+		1+len(deposits)+len(withdrawals)+len(slashings)+len(updates),
+	)
+
+	evs = append(evs, newEvent(
+		EventTypeBlock,
+		indexedAttr(AttributeKeySlot, slot.Base10()),
+		indexedAttr(AttributeKeyProposerIndex, blk.GetProposerIndex().Base10()),
+		attr(AttributeKeyNumDeposits, strconv.Itoa(len(deposits))),
+		attr(AttributeKeyNumWithdrawals, strconv.Itoa(len(withdrawals))),
+		attr(AttributeKeyNumSlashings, strconv.Itoa(len(slashings))),
+		attr(
+			AttributeKeyNumBlobs,
+			strconv.Itoa(len(body.GetBlobKzgCommitments())),
+		),
+	))
+
+	for _, deposit := range deposits {
+		credentials := deposit.GetWithdrawalCredentials()
+		attrs := []cmtabci.EventAttribute{
+			indexedAttr(AttributeKeySlot, slot.Base10()),
+			indexedAttr(AttributeKeyIndex, deposit.GetIndex().Base10()),
+			indexedAttr(AttributeKeyPubkey, deposit.GetPubkey().String()),
+			attr(AttributeKeyAmount, deposit.GetAmount().Base10()),
+			attr(AttributeKeyWithdrawalCredentials, credentials.String()),
+		}
+		// Only execution credentials resolve to an address, other
+		// credential types are reported through their raw value alone.
+		if addr, err := credentials.ToExecutionAddress(); err == nil {
+			attrs = append(attrs, indexedAttr(
+				AttributeKeyExecutionAddress, addr.Hex(),
+			))
+		}
+		evs = append(evs, newEvent(EventTypeDeposit, attrs...))
+	}
+
+	for _, withdrawal := range withdrawals {
+		evs = append(evs, newEvent(
+			EventTypeWithdrawal,
+			indexedAttr(AttributeKeySlot, slot.Base10()),
+			indexedAttr(AttributeKeyIndex, withdrawal.GetIndex().Base10()),
+			indexedAttr(
+				AttributeKeyValidatorIndex,
+				withdrawal.GetValidatorIndex().Base10(),
+			),
+			indexedAttr(
+				AttributeKeyExecutionAddress, withdrawal.GetAddress().Hex(),
+			),
+			attr(AttributeKeyAmount, withdrawal.GetAmount().Base10()),
+		))
+	}
+
+	for _, slashing := range slashings {
+		evs = append(evs, newEvent(
+			EventTypeSlashing,
+			indexedAttr(AttributeKeySlot, slot.Base10()),
+			indexedAttr(
+				AttributeKeyValidatorIndex,
+				math.ValidatorIndex(slashing.GetIndex()).Base10(),
+			),
+			attr(AttributeKeyInfractionSlot, slashing.GetSlot().Base10()),
+		))
+	}
+
+	for _, update := range updates {
+		if update == nil {
+			continue
+		}
+		evs = append(evs, newEvent(
+			EventTypeValidatorUpdate,
+			indexedAttr(AttributeKeySlot, slot.Base10()),
+			indexedAttr(AttributeKeyPubkey, update.Pubkey.String()),
+			attr(
+				AttributeKeyEffectiveBalance,
+				update.EffectiveBalance.Base10(),
+			),
+		))
+	}
+
+	return evs
+}
+
+// newEvent creates a new ABCI event of the given type.
+func newEvent(
+	eventType string,
+	attrs ...cmtabci.EventAttribute,
+) cmtabci.Event {
+	return cmtabci.Event{Type: eventType, Attributes: attrs}
+}
+
+// attr creates a new event attribute that is not indexed.
+func attr(key, value string) cmtabci.EventAttribute {
+	return cmtabci.EventAttribute{Key: key, Value: value}
+}
+
+// indexedAttr creates a new event attribute that is indexed.
+func indexedAttr(key, value string) cmtabci.EventAttribute {
+	return cmtabci.EventAttribute{Key: key, Value: value, Index: true}
+}
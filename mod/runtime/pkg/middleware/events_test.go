@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errNoExecutionAddress = errors.New("no execution address")
+
+type testCredentials struct {
+	address *common.ExecutionAddress
+}
+
+func (c testCredentials) ToExecutionAddress() (
+	common.ExecutionAddress, error,
+) {
+	if c.address == nil {
+		return common.ExecutionAddress{}, errNoExecutionAddress
+	}
+	return *c.address, nil
+}
+
+func (c testCredentials) String() string { return "0xcredentials" }
+
+type testDeposit struct {
+	index       math.U64
+	credentials testCredentials
+}
+
+func (d *testDeposit) GetAmount() math.Gwei        { return 32e9 }
+func (d *testDeposit) GetIndex() math.U64          { return d.index }
+func (d *testDeposit) GetPubkey() crypto.BLSPubkey { return crypto.BLSPubkey{} }
+func (d *testDeposit) GetWithdrawalCredentials() testCredentials {
+	return d.credentials
+}
+
+type testWithdrawal struct {
+	index math.U64
+}
+
+func (w *testWithdrawal) GetIndex() math.U64 { return w.index }
+func (w *testWithdrawal) GetValidatorIndex() math.ValidatorIndex {
+	return 7
+}
+func (w *testWithdrawal) GetAddress() common.ExecutionAddress {
+	return common.ExecutionAddress{0x1}
+}
+func (w *testWithdrawal) GetAmount() math.Gwei { return 1e9 }
+
+type testPayload struct {
+	withdrawals []*testWithdrawal
+}
+
+func (p *testPayload) GetWithdrawals() []*testWithdrawal {
+	return p.withdrawals
+}
+
+type testSlashing struct {
+	index math.U64
+}
+
+func (s *testSlashing) GetSlot() math.Slot { return 3 }
+func (s *testSlashing) GetIndex() math.U64 { return s.index }
+
+type testCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+// testBody mirrors the consensus-types block bodies, whose slashing info is
+// only implemented from DenebPlus onwards.
+type testBody struct {
+	version   uint32
+	deposits  []*testDeposit
+	payload   *testPayload
+	slashings []*testSlashing
+}
+
+func (b *testBody) GetDeposits() []*testDeposit       { return b.deposits }
+func (b *testBody) GetExecutionPayload() *testPayload { return b.payload }
+
+func (b *testBody) GetSlashingInfo() []*testSlashing {
+	if b.version < version.DenebPlus {
+		panic("not implemented")
+	}
+	return b.slashings
+}
+
+func (b *testBody) GetBlobKzgCommitments() testCommitments {
+	return make(testCommitments, 2)
+}
+
+type testBlock struct {
+	body *testBody
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) { return nil, nil }
+func (b *testBlock) UnmarshalSSZ([]byte) error   { return nil }
+func (b *testBlock) IsNil() bool                 { return b == nil }
+func (b *testBlock) Empty() *testBlock           { return new(testBlock) }
+func (b *testBlock) GetSlot() math.Slot          { return 5 }
+func (b *testBlock) GetBody() *testBody          { return b.body }
+func (b *testBlock) HashTreeRoot() common.Root   { return common.Root{} }
+func (b *testBlock) Version() uint32             { return b.body.version }
+
+func (b *testBlock) GetProposerIndex() math.ValidatorIndex {
+	return 2
+}
+
+func (b *testBlock) NewFromSSZ([]byte, uint32) (*testBlock, error) {
+	return new(testBlock), nil
+}
+
+func blockEvents(
+	blk *testBlock,
+	updates transition.ValidatorUpdates,
+) []cmtabci.Event {
+	return middleware.BlockEvents[
+		*testBlock, *testBody, *testDeposit, *testPayload, *testSlashing,
+		*testWithdrawal, testCredentials,
+	](blk, updates)
+}
+
+// attrs returns the attributes of the event as a map.
+func attrs(ev cmtabci.Event) map[string]string {
+	out := make(map[string]string, len(ev.Attributes))
+	for _, a := range ev.Attributes {
+		out[a.Key] = a.Value
+	}
+	return out
+}
+
+func TestBlockEvents_Deneb(t *testing.T) {
+	address := common.ExecutionAddress{0xaa}
+	blk := &testBlock{body: &testBody{
+		version: version.Deneb,
+		deposits: []*testDeposit{
+			{index: 0, credentials: testCredentials{address: &address}},
+			{index: 1},
+		},
+		payload: &testPayload{
+			withdrawals: []*testWithdrawal{{index: 4}},
+		},
+	}}
+	updates := transition.ValidatorUpdates{
+		nil, {EffectiveBalance: 32e9},
+	}
+
+	var evs []cmtabci.Event
+	require.NotPanics(t, func() { evs = blockEvents(blk, updates) })
+
+	types := make([]string, 0, len(evs))
+	for _, ev := range evs {
+		types = append(types, ev.Type)
+	}
+	require.Equal(t, []string{
+		middleware.EventTypeBlock,
+		middleware.EventTypeDeposit,
+		middleware.EventTypeDeposit,
+		middleware.EventTypeWithdrawal,
+		middleware.EventTypeValidatorUpdate,
+	}, types)
+
+	require.Equal(t, map[string]string{
+		middleware.AttributeKeySlot:           "5",
+		middleware.AttributeKeyProposerIndex:  "2",
+		middleware.AttributeKeyNumDeposits:    "2",
+		middleware.AttributeKeyNumWithdrawals: "1",
+		middleware.AttributeKeyNumSlashings:   "0",
+		middleware.AttributeKeyNumBlobs:       "2",
+	}, attrs(evs[0]))
+
+	// Only execution credentials resolve to an address.
+	require.Equal(
+		t, address.Hex(),
+		attrs(evs[1])[middleware.AttributeKeyExecutionAddress],
+	)
+	require.NotContains(
+		t, attrs(evs[2]), middleware.AttributeKeyExecutionAddress,
+	)
+	require.Equal(
+		t, "32000000000",
+		attrs(evs[4])[middleware.AttributeKeyEffectiveBalance],
+	)
+}
+
+func TestBlockEvents_DenebPlusSlashings(t *testing.T) {
+	blk := &testBlock{body: &testBody{
+		version:   version.DenebPlus,
+		payload:   &testPayload{},
+		slashings: []*testSlashing{{index: 9}},
+	}}
+
+	evs := blockEvents(blk, nil)
+	require.Len(t, evs, 2)
+	require.Equal(
+		t, "1", attrs(evs[0])[middleware.AttributeKeyNumSlashings],
+	)
+	require.Equal(t, middleware.EventTypeSlashing, evs[1].Type)
+	require.Equal(t, map[string]string{
+		middleware.AttributeKeySlot:           "5",
+		middleware.AttributeKeyValidatorIndex: "9",
+		middleware.AttributeKeyInfractionSlot: "3",
+	}, attrs(evs[1]))
+}
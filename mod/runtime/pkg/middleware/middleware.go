@@ -23,6 +23,8 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"sync"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
@@ -40,15 +42,21 @@ import (
 // ABCIMiddleware is a middleware between ABCI and the validator logic.
 type ABCIMiddleware[
 	AvailabilityStoreT any,
-	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[
+		DepositT, ExecutionPayloadT, SlashingInfoT,
+	],
 	BlobSidecarsT interface {
 		constraints.SSZMarshallable
 		Empty() BlobSidecarsT
 	},
-	DepositT,
-	ExecutionPayloadT any,
+	DepositT Deposit[WithdrawalCredentialsT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalT],
 	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	SlashingInfoT SlashingInfo,
+	SlotDataT SlotData,
+	WithdrawalT Withdrawal,
+	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
@@ -68,6 +76,9 @@ type ABCIMiddleware[
 		encoding.ABCIRequest,
 		BeaconBlockT,
 	]
+	// blobFetcher assembles the blob sidecars of a proposal from the blob
+	// pool of the execution client.
+	blobFetcher BlobFetcher[BeaconBlockT, BlobSidecarsT]
 	// metrics is the metrics emitter.
 	metrics *ABCIMiddlewareMetrics
 	// logger is the logger for the middleware.
@@ -86,6 +97,13 @@ type ABCIMiddleware[
 
 	// TODO: this is a temporary hack.
 	req *cmtabci.FinalizeBlockRequest
+	// fetchedSidecars holds the sidecars assembled for the verified
+	// proposals of the current height, keyed by block root.
+	fetchedSidecars map[common.Root]BlobSidecarsT
+	// fetchedSidecarsMu protects fetchedSidecars.
+	fetchedSidecarsMu sync.Mutex
+	// processedHeight is the last height a proposal was processed at.
+	processedHeight atomic.Int64
 
 	// Channels
 	// blkCh is used to communicate the beacon block to the EndBlock method.
@@ -100,31 +118,40 @@ type ABCIMiddleware[
 // NewABCIMiddleware creates a new instance of the Handler struct.
 func NewABCIMiddleware[
 	AvailabilityStoreT any,
-	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[
+		DepositT, ExecutionPayloadT, SlashingInfoT,
+	],
 	BlobSidecarsT interface {
 		constraints.SSZMarshallable
 		Empty() BlobSidecarsT
 	},
-	DepositT,
-	ExecutionPayloadT any,
+	DepositT Deposit[WithdrawalCredentialsT],
+	ExecutionPayloadT ExecutionPayload[WithdrawalT],
 	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	SlashingInfoT SlashingInfo,
+	SlotDataT SlotData,
+	WithdrawalT Withdrawal,
+	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
 	telemetrySink TelemetrySink,
+	blobFetcher BlobFetcher[BeaconBlockT, BlobSidecarsT],
 	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]],
 	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]],
 	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]],
 	slotBroker *broker.Broker[*asynctypes.Event[SlotDataT]],
 	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates],
 ) *ABCIMiddleware[
-	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-	ExecutionPayloadT, GenesisT, SlotDataT,
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
+	DepositT, ExecutionPayloadT, GenesisT, SlashingInfoT, SlotDataT,
+	WithdrawalT, WithdrawalCredentialsT,
 ] {
 	return &ABCIMiddleware[
-		AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-		ExecutionPayloadT, GenesisT, SlotDataT,
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
+		DepositT, ExecutionPayloadT, GenesisT, SlashingInfoT, SlotDataT,
+		WithdrawalT, WithdrawalCredentialsT,
 	]{
 		chainSpec: chainSpec,
 		blobGossiper: rp2p.NewNoopBlobHandler[
@@ -136,12 +163,14 @@ func NewABCIMiddleware[
 		](
 			chainSpec,
 		),
-		logger:         logger,
-		metrics:        newABCIMiddlewareMetrics(telemetrySink),
-		genesisBroker:  genesisBroker,
-		blkBroker:      blkBroker,
-		sidecarsBroker: sidecarsBroker,
-		slotBroker:     slotBroker,
+		blobFetcher:     blobFetcher,
+		logger:          logger,
+		metrics:         newABCIMiddlewareMetrics(telemetrySink),
+		genesisBroker:   genesisBroker,
+		blkBroker:       blkBroker,
+		sidecarsBroker:  sidecarsBroker,
+		slotBroker:      slotBroker,
+		fetchedSidecars: make(map[common.Root]BlobSidecarsT),
 		blkCh: make(
 			chan *asynctypes.Event[BeaconBlockT],
 			1,
@@ -156,15 +185,14 @@ func NewABCIMiddleware[
 
 // Name returns the name of the middleware.
 func (am *ABCIMiddleware[
-	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-	ExecutionPayloadT, GenesisT, SlotDataT,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "abci-middleware"
 }
 
 // Start the middleware.
 func (am *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
 	subBlkCh, err := am.blkBroker.Subscribe()
 	if err != nil {
@@ -182,7 +210,8 @@ func (am *ABCIMiddleware[
 
 // start starts the middleware.
 func (am *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, BeaconBlockT, _, BlobSidecarsT, _, _,
+	_, _, _, _, _,
 ]) start(
 	ctx context.Context,
 	blkCh chan *asynctypes.Event[BeaconBlockT],
@@ -25,18 +25,95 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // BeaconBlock is an interface for accessing the beacon block.
-type BeaconBlock[SelfT any] interface {
+type BeaconBlock[SelfT, BeaconBlockBodyT any] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	GetSlot() math.Slot
+	GetProposerIndex() math.ValidatorIndex
+	GetBody() BeaconBlockBodyT
+	HashTreeRoot() common.Root
 	NewFromSSZ([]byte, uint32) (SelfT, error)
+	Version() uint32
+}
+
+// BeaconBlockBody is an interface for accessing the beacon block body.
+type BeaconBlockBody[DepositT, ExecutionPayloadT, SlashingInfoT any] interface {
+	// GetDeposits returns the deposits included in the block body.
+	GetDeposits() []DepositT
+	// GetExecutionPayload returns the execution payload of the block body.
+	GetExecutionPayload() ExecutionPayloadT
+	// GetSlashingInfo returns the slashing info included in the block body.
+	GetSlashingInfo() []SlashingInfoT
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+// BlobFetcher is the interface for assembling the blob sidecars of a block.
+type BlobFetcher[BeaconBlockT, BlobSidecarsT any] interface {
+	// OmitPooledSidecars returns the given sidecars of a proposal without
+	// the ones the validators are expected to find in their blob pool.
+	OmitPooledSidecars(ctx context.Context, sidecars BlobSidecarsT) BlobSidecarsT
+	// FetchSidecars returns the sidecars of the given proposal, preferring
+	// locally available blobs over the given proposed sidecars. The
+	// sidecars taken from the proposal are returned separately, as they
+	// still need to be verified.
+	FetchSidecars(
+		ctx context.Context,
+		blk BeaconBlockT,
+		proposed BlobSidecarsT,
+	) (BlobSidecarsT, BlobSidecarsT, error)
+	// RecoverSidecars returns the sidecars of the given finalized block,
+	// taking the blobs that are not available locally from the given
+	// included sidecars, then from the peers. The sidecars that were not
+	// built locally are returned separately, as they still need to be
+	// verified.
+	RecoverSidecars(
+		ctx context.Context,
+		blk BeaconBlockT,
+		included BlobSidecarsT,
+	) (BlobSidecarsT, BlobSidecarsT, error)
+}
+
+// Deposit is an interface for accessing a deposit.
+type Deposit[WithdrawalCredentialsT any] interface {
+	// GetAmount returns the amount of the deposit.
+	GetAmount() math.Gwei
+	// GetIndex returns the index of the deposit.
+	GetIndex() math.U64
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetWithdrawalCredentials returns the withdrawal credentials.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+}
+
+// ExecutionPayload is an interface for accessing the execution payload.
+type ExecutionPayload[WithdrawalT any] interface {
+	// GetWithdrawals returns the withdrawals of the execution payload.
+	GetWithdrawals() []WithdrawalT
+}
+
+// SlashingInfo is an interface for accessing the slashing info.
+type SlashingInfo interface {
+	// GetSlot returns the slot of the slashing.
+	GetSlot() math.Slot
+	// GetIndex returns the index of the slashed validator.
+	GetIndex() math.U64
+}
+
+// SlotData is an interface for accessing the slot of a proposal.
+type SlotData interface {
+	// GetSlot returns the slot of the proposal.
+	GetSlot() math.Slot
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -72,3 +149,24 @@ type BlockchainService[
 		blk BeaconBlockT,
 	) error
 }
+
+// Withdrawal is an interface for accessing a withdrawal.
+type Withdrawal interface {
+	// GetIndex returns the index of the withdrawal.
+	GetIndex() math.U64
+	// GetValidatorIndex returns the index of the withdrawing validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetAddress returns the execution address of the withdrawal.
+	GetAddress() common.ExecutionAddress
+	// GetAmount returns the amount of the withdrawal.
+	GetAmount() math.Gwei
+}
+
+// WithdrawalCredentials is an interface for accessing the withdrawal
+// credentials of a deposit.
+type WithdrawalCredentials interface {
+	// ToExecutionAddress returns the execution address of the credentials.
+	ToExecutionAddress() (common.ExecutionAddress, error)
+	// String returns the hex string representation of the credentials.
+	String() string
+}
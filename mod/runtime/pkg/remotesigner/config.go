@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import "time"
+
+const (
+	// defaultTimeout is the default timeout of a request to the remote
+	// signer.
+	defaultTimeout = 2 * time.Second
+)
+
+// Config is the configuration for the remote signer.
+type Config struct {
+	// Enabled determines if messages are signed by the remote signer
+	// instead of the local private validator key.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the address of the Web3Signer-compatible remote signer.
+	URL string `mapstructure:"url"`
+	// Pubkey is the public key of the validator held by the remote signer.
+	// It may be left empty if the remote signer holds a single key.
+	Pubkey string `mapstructure:"pubkey"`
+	// Timeout is the timeout of each request to the remote signer.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// TLSCertPath is the path of the client certificate presented to the
+	// remote signer.
+	TLSCertPath string `mapstructure:"tls-cert-path"`
+	// TLSKeyPath is the path of the private key of the client certificate.
+	TLSKeyPath string `mapstructure:"tls-key-path"`
+	// TLSCAPath is the path of the certificate authority the certificate of
+	// the remote signer is verified against, the system pool is used if
+	// empty.
+	TLSCAPath string `mapstructure:"tls-ca-path"`
+}
+
+// DefaultConfig returns the default remote signer configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		URL:         "",
+		Pubkey:      "",
+		Timeout:     defaultTimeout,
+		TLSCertPath: "",
+		TLSKeyPath:  "",
+		TLSCAPath:   "",
+	}
+}
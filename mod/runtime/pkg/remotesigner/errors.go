@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrMissingURL is returned when the remote signer is enabled without
+	// an URL.
+	ErrMissingURL = errors.New("remote signer url is not set")
+
+	// ErrIncompleteTLSKeyPair is returned when only one of the client
+	// certificate and its private key is configured.
+	ErrIncompleteTLSKeyPair = errors.New(
+		"both the tls certificate and key must be set",
+	)
+
+	// ErrInvalidCA is returned when the certificate authority file holds no
+	// certificate.
+	ErrInvalidCA = errors.New("no certificate found in tls ca file")
+
+	// ErrUnknownPubkey is returned when the remote signer does not hold the
+	// key of the validator.
+	ErrUnknownPubkey = errors.New("pubkey not held by remote signer")
+
+	// ErrAmbiguousPubkey is returned when no pubkey is configured and the
+	// remote signer holds more than one key.
+	ErrAmbiguousPubkey = errors.New(
+		"remote signer holds several keys, the pubkey must be set",
+	)
+
+	// ErrUnexpectedStatus is returned when the remote signer answers with an
+	// unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected status from remote signer")
+
+	// ErrInvalidSignature is returned when the signature of the remote
+	// signer does not verify against the public key of the validator.
+	ErrInvalidSignature = errors.New("invalid signature from remote signer")
+)
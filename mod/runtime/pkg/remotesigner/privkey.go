@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+// PrivKey is a CometBFT private key whose signatures are made by the remote
+// signer, it holds no key material.
+type PrivKey struct {
+	signer *Signer
+	// pubKey is the CometBFT public key of the validator.
+	pubKey cmtcrypto.PubKey
+}
+
+// NewPrivKey creates a CometBFT private key signing with the given remote
+// signer. The given public key must be the one of the remote signer, in the
+// BLS key type CometBFT is built with.
+func NewPrivKey(signer *Signer, pubKey cmtcrypto.PubKey) *PrivKey {
+	return &PrivKey{signer: signer, pubKey: pubKey}
+}
+
+// Bytes returns nothing, the key material stays on the remote signer.
+func (k *PrivKey) Bytes() []byte {
+	return nil
+}
+
+// Sign requests the remote signer to sign the given message. As for the
+// local BLS keys of CometBFT, the SHA256 sum of the messages longer than
+// bls12381.MaxMsgLen is signed instead of the raw bytes.
+func (k *PrivKey) Sign(msg []byte) ([]byte, error) {
+	if len(msg) > bls12381.MaxMsgLen {
+		hash := sha256.Sum256(msg)
+		msg = hash[:]
+	}
+	signature, err := k.signer.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	return signature[:], nil
+}
+
+// PubKey returns the public key of the validator.
+func (k *PrivKey) PubKey() cmtcrypto.PubKey {
+	return k.pubKey
+}
+
+// Equals returns true if the given key is a key of the same validator.
+func (k *PrivKey) Equals(other cmtcrypto.PrivKey) bool {
+	return other.Type() == k.Type() &&
+		bytes.Equal(other.PubKey().Bytes(), k.PubKey().Bytes())
+}
+
+// Type returns the type of the key.
+func (k *PrivKey) Type() string {
+	return k.pubKey.Type()
+}
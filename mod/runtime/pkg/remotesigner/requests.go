@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// SigningType is the type of a signing request, it tells the remote signer
+// which message is signed so that it can apply its slashing protection.
+type SigningType string
+
+const (
+	// SigningTypeRandaoReveal is the type of a randao reveal.
+	SigningTypeRandaoReveal SigningType = "RANDAO_REVEAL"
+	// SigningTypeBlock is the type of a beacon block header.
+	SigningTypeBlock SigningType = "BLOCK_V2"
+	// SigningTypeDeposit is the type of a deposit message.
+	SigningTypeDeposit SigningType = "DEPOSIT"
+	// SigningTypeRaw is the type of the messages which have no dedicated
+	// request, such as the CometBFT votes and proposals. It is an extension
+	// of the Web3Signer API the remote signer must support.
+	SigningTypeRaw SigningType = "RAW"
+)
+
+// SigningRequest is the body of a request to the sign endpoint of the
+// remote signer. Only the field matching its type is set.
+type SigningRequest struct {
+	// Type is the type of the signed message.
+	Type SigningType `json:"type"`
+	// SigningRoot is the root signed by the remote signer, it is not set
+	// for the raw requests.
+	SigningRoot *common.Root `json:"signingRoot,omitempty"`
+	// RandaoReveal is set for the randao reveal requests.
+	RandaoReveal *RandaoReveal `json:"randao_reveal,omitempty"`
+	// BeaconBlock is set for the block requests.
+	BeaconBlock *BeaconBlock `json:"beacon_block,omitempty"`
+	// Deposit is set for the deposit requests.
+	Deposit *Deposit `json:"deposit,omitempty"`
+	// Message is the message signed as is by the remote signer, it is set
+	// for the raw requests.
+	Message bytes.Bytes `json:"message,omitempty"`
+}
+
+// RandaoReveal is the randao reveal of a signing request.
+type RandaoReveal struct {
+	// Epoch is the epoch of the randao reveal, in base 10.
+	Epoch string `json:"epoch"`
+}
+
+// BeaconBlock is the beacon block of a signing request.
+type BeaconBlock struct {
+	// Version is the fork name of the block.
+	Version string `json:"version"`
+	// BlockHeader is the header of the block.
+	BlockHeader *BlockHeader `json:"block_header"`
+}
+
+// BlockHeader is the header of a beacon block, the numbers are in base 10.
+type BlockHeader struct {
+	Slot          string      `json:"slot"`
+	ProposerIndex string      `json:"proposer_index"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	BodyRoot      common.Root `json:"body_root"`
+}
+
+// Deposit is the deposit message of a signing request.
+type Deposit struct {
+	Pubkey                crypto.BLSPubkey `json:"pubkey"`
+	WithdrawalCredentials common.Bytes32   `json:"withdrawal_credentials"`
+	// Amount is the amount of the deposit in gwei, in base 10.
+	Amount             string         `json:"amount"`
+	GenesisForkVersion common.Version `json:"genesis_fork_version"`
+}
+
+// signingResponse is the response of the sign endpoint of the remote
+// signer.
+type signingResponse struct {
+	Signature crypto.BLSSignature `json:"signature"`
+}
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// publicKeysPath is the path of the endpoint listing the keys held by
+	// the remote signer.
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	// signPath is the path of the sign endpoint of the remote signer, it is
+	// followed by the public key of the signing key.
+	signPath = "/api/v1/eth2/sign/"
+	// blockVersion is the fork name of the signed block headers.
+	blockVersion = "DENEB"
+	// maxErrorBodySize is the maximum size of an error body of the remote
+	// signer that is reported.
+	maxErrorBodySize = 1 << 10
+)
+
+// VerifyFn verifies a signature against a message and a public key.
+type VerifyFn func(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error
+
+// Signer is a crypto.BLSSigner signing with a key held by a
+// Web3Signer-compatible remote signer, so that no key material lives on the
+// validator host. Every signature returned by the remote signer is verified
+// before being used.
+type Signer struct {
+	// cfg is the remote signer configuration.
+	cfg *Config
+	// pubkey is the public key of the validator.
+	pubkey crypto.BLSPubkey
+	// verify verifies the signatures.
+	verify VerifyFn
+	// client is the underlying HTTP client.
+	client *http.Client
+}
+
+// NewSigner creates a new remote signer, checking that the remote signer
+// holds the key of the validator.
+func NewSigner(cfg *Config, verify VerifyFn) (*Signer, error) {
+	if cfg.URL == "" {
+		return nil, ErrMissingURL
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Signer{
+		cfg:    cfg,
+		verify: verify,
+		client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+	if s.pubkey, err = s.resolvePubkey(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// PublicKey returns the public key of the validator.
+func (s *Signer) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign requests the remote signer to sign the given message as is.
+func (s *Signer) Sign(msg []byte) (crypto.BLSSignature, error) {
+	return s.sign(&SigningRequest{
+		Type:    SigningTypeRaw,
+		Message: msg,
+	}, msg)
+}
+
+// SignRandaoReveal requests the remote signer to sign the randao reveal of
+// the given epoch.
+func (s *Signer) SignRandaoReveal(
+	epoch math.Epoch,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&SigningRequest{
+		Type:         SigningTypeRandaoReveal,
+		SigningRoot:  &signingRoot,
+		RandaoReveal: &RandaoReveal{Epoch: epoch.Base10()},
+	}, signingRoot[:])
+}
+
+// SignBlock requests the remote signer to sign the header of the block
+// proposed at the given slot.
+func (s *Signer) SignBlock(
+	slot math.Slot,
+	proposerIndex math.ValidatorIndex,
+	parentRoot common.Root,
+	stateRoot common.Root,
+	bodyRoot common.Root,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&SigningRequest{
+		Type:        SigningTypeBlock,
+		SigningRoot: &signingRoot,
+		BeaconBlock: &BeaconBlock{
+			Version: blockVersion,
+			BlockHeader: &BlockHeader{
+				Slot:          slot.Base10(),
+				ProposerIndex: proposerIndex.Base10(),
+				ParentRoot:    parentRoot,
+				StateRoot:     stateRoot,
+				BodyRoot:      bodyRoot,
+			},
+		},
+	}, signingRoot[:])
+}
+
+// SignDeposit requests the remote signer to sign the given deposit message.
+func (s *Signer) SignDeposit(
+	pubkey crypto.BLSPubkey,
+	credentials common.Bytes32,
+	amount math.Gwei,
+	genesisForkVersion common.Version,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&SigningRequest{
+		Type:        SigningTypeDeposit,
+		SigningRoot: &signingRoot,
+		Deposit: &Deposit{
+			Pubkey:                pubkey,
+			WithdrawalCredentials: credentials,
+			Amount:                amount.Base10(),
+			GenesisForkVersion:    genesisForkVersion,
+		},
+	}, signingRoot[:])
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (s *Signer) VerifySignature(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return s.verify(pubkey, msg, signature)
+}
+
+// sign sends the given signing request and verifies the returned signature
+// against the given signed message.
+func (s *Signer) sign(
+	request *SigningRequest,
+	signed []byte,
+) (crypto.BLSSignature, error) {
+	var resp signingResponse
+	if err := s.do(
+		http.MethodPost, signPath+s.pubkey.String(), request, &resp,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	if err := s.verify(s.pubkey, signed, resp.Signature); err != nil {
+		return crypto.BLSSignature{}, errors.Wrapf(
+			ErrInvalidSignature, "%s request: %v", request.Type, err,
+		)
+	}
+	return resp.Signature, nil
+}
+
+// resolvePubkey returns the configured public key, or the only key held by
+// the remote signer if none is configured.
+func (s *Signer) resolvePubkey() (crypto.BLSPubkey, error) {
+	var pubkeys []crypto.BLSPubkey
+	if err := s.do(
+		http.MethodGet, publicKeysPath, nil, &pubkeys,
+	); err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+
+	if s.cfg.Pubkey == "" {
+		if len(pubkeys) != 1 {
+			return crypto.BLSPubkey{}, errors.Wrapf(
+				ErrAmbiguousPubkey, "%d keys", len(pubkeys),
+			)
+		}
+		return pubkeys[0], nil
+	}
+
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(s.cfg.Pubkey)); err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+	if !slices.Contains(pubkeys, pubkey) {
+		return crypto.BLSPubkey{}, errors.Wrap(
+			ErrUnknownPubkey, pubkey.String(),
+		)
+	}
+	return pubkey, nil
+}
+
+// do sends a request to the remote signer and decodes its response into
+// the given result.
+func (s *Signer) do(
+	method string,
+	path string,
+	body any,
+	result any,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		bz, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, s.cfg.URL+path, reader,
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Wrapf(
+			ErrUnexpectedStatus, "%d: %s", resp.StatusCode, msg,
+		)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// newTLSConfig builds the TLS configuration of the connection to the remote
+// signer, presenting the client certificate if one is configured.
+func newTLSConfig(cfg *Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	switch {
+	case cfg.TLSCertPath != "" && cfg.TLSKeyPath != "":
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case cfg.TLSCertPath != "" || cfg.TLSKeyPath != "":
+		return nil, ErrIncompleteTLSKeyPair
+	}
+
+	if cfg.TLSCAPath != "" {
+		pem, err := os.ReadFile(cfg.TLSCAPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Wrap(ErrInvalidCA, cfg.TLSCAPath)
+		}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,344 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/remotesigner"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pubkey      = crypto.BLSPubkey{0x01}
+	signingRoot = common.Root{0x02}
+)
+
+// fakeSign is the signature scheme of the stub signer, the signature is the
+// public key followed by the SHA256 sum of the message.
+func fakeSign(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+) crypto.BLSSignature {
+	var signature crypto.BLSSignature
+	hash := sha256.Sum256(msg)
+	copy(signature[:], pubkey[:])
+	copy(signature[len(pubkey):], hash[:])
+	return signature
+}
+
+// fakeVerify verifies the signatures of the stub signer.
+func fakeVerify(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	if fakeSign(pubkey, msg) != signature {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+// stubSigner is a Web3Signer-compatible signer recording the signing
+// requests it receives.
+type stubSigner struct {
+	mu       sync.Mutex
+	pubkeys  []crypto.BLSPubkey
+	requests []*remotesigner.SigningRequest
+	// corrupt makes the stub return invalid signatures.
+	corrupt bool
+	// delay delays the answers of the stub.
+	delay time.Duration
+}
+
+func (s *stubSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	delay := s.delay
+	s.mu.Unlock()
+	time.Sleep(delay)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/eth2/publicKeys":
+		_ = json.NewEncoder(w).Encode(s.pubkeys)
+	case r.Method == http.MethodPost &&
+		strings.HasPrefix(r.URL.Path, "/api/v1/eth2/sign/"):
+		var key crypto.BLSPubkey
+		if err := key.UnmarshalText(
+			[]byte(strings.TrimPrefix(r.URL.Path, "/api/v1/eth2/sign/")),
+		); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req := new(remotesigner.SigningRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.requests = append(s.requests, req)
+
+		msg := []byte(req.Message)
+		if req.SigningRoot != nil {
+			msg = req.SigningRoot[:]
+		}
+		signature := fakeSign(key, msg)
+		if s.corrupt {
+			signature[0] ^= 0xff
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"signature": signature,
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *stubSigner) lastRequest() *remotesigner.SigningRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[len(s.requests)-1]
+}
+
+func newSigner(
+	t *testing.T,
+	stub *stubSigner,
+) *remotesigner.Signer {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	cfg := remotesigner.DefaultConfig()
+	cfg.Enabled = true
+	cfg.URL = server.URL
+	cfg.Timeout = 100 * time.Millisecond
+	signer, err := remotesigner.NewSigner(&cfg, fakeVerify)
+	require.NoError(t, err)
+	return signer
+}
+
+func TestSigner_ResolvesPubkey(t *testing.T) {
+	stub := &stubSigner{pubkeys: []crypto.BLSPubkey{pubkey, {0x03}}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	cfg := remotesigner.DefaultConfig()
+	cfg.URL = server.URL
+
+	_, err := remotesigner.NewSigner(&cfg, fakeVerify)
+	require.ErrorIs(t, err, remotesigner.ErrAmbiguousPubkey)
+
+	cfg.Pubkey = crypto.BLSPubkey{0x04}.String()
+	_, err = remotesigner.NewSigner(&cfg, fakeVerify)
+	require.ErrorIs(t, err, remotesigner.ErrUnknownPubkey)
+
+	cfg.Pubkey = pubkey.String()
+	signer, err := remotesigner.NewSigner(&cfg, fakeVerify)
+	require.NoError(t, err)
+	require.Equal(t, pubkey, signer.PublicKey())
+}
+
+func TestSigner_TypedRequests(t *testing.T) {
+	stub := &stubSigner{pubkeys: []crypto.BLSPubkey{pubkey}}
+	signer := newSigner(t, stub)
+
+	signature, err := signer.SignRandaoReveal(math.Epoch(5), signingRoot)
+	require.NoError(t, err)
+	require.Equal(t, fakeSign(pubkey, signingRoot[:]), signature)
+	req := stub.lastRequest()
+	require.Equal(t, remotesigner.SigningTypeRandaoReveal, req.Type)
+	require.Equal(t, signingRoot, *req.SigningRoot)
+	require.Equal(t, "5", req.RandaoReveal.Epoch)
+
+	_, err = signer.SignBlock(
+		math.Slot(7), math.ValidatorIndex(1),
+		common.Root{0x05}, common.Root{0x07}, common.Root{0x06}, signingRoot,
+	)
+	require.NoError(t, err)
+	req = stub.lastRequest()
+	require.Equal(t, remotesigner.SigningTypeBlock, req.Type)
+	require.Equal(t, "7", req.BeaconBlock.BlockHeader.Slot)
+	require.Equal(t, "1", req.BeaconBlock.BlockHeader.ProposerIndex)
+	require.Equal(t, common.Root{0x05}, req.BeaconBlock.BlockHeader.ParentRoot)
+	require.Equal(t, common.Root{0x07}, req.BeaconBlock.BlockHeader.StateRoot)
+	require.Equal(t, common.Root{0x06}, req.BeaconBlock.BlockHeader.BodyRoot)
+
+	_, err = signer.SignDeposit(
+		pubkey, common.Bytes32{0x01}, math.Gwei(32e9),
+		common.Version{0x04}, signingRoot,
+	)
+	require.NoError(t, err)
+	req = stub.lastRequest()
+	require.Equal(t, remotesigner.SigningTypeDeposit, req.Type)
+	require.Equal(t, pubkey, req.Deposit.Pubkey)
+	require.Equal(t, "32000000000", req.Deposit.Amount)
+	require.Equal(t, common.Version{0x04}, req.Deposit.GenesisForkVersion)
+
+	msg := []byte("message")
+	signature, err = signer.Sign(msg)
+	require.NoError(t, err)
+	require.Equal(t, fakeSign(pubkey, msg), signature)
+	req = stub.lastRequest()
+	require.Equal(t, remotesigner.SigningTypeRaw, req.Type)
+	require.Nil(t, req.SigningRoot)
+	require.Equal(t, msg, []byte(req.Message))
+}
+
+func TestSigner_RejectsInvalidSignature(t *testing.T) {
+	stub := &stubSigner{pubkeys: []crypto.BLSPubkey{pubkey}}
+	signer := newSigner(t, stub)
+
+	stub.mu.Lock()
+	stub.corrupt = true
+	stub.mu.Unlock()
+	_, err := signer.SignRandaoReveal(math.Epoch(1), signingRoot)
+	require.ErrorIs(t, err, remotesigner.ErrInvalidSignature)
+}
+
+func TestSigner_Timeout(t *testing.T) {
+	stub := &stubSigner{pubkeys: []crypto.BLSPubkey{pubkey}}
+	signer := newSigner(t, stub)
+
+	stub.mu.Lock()
+	stub.delay = 200 * time.Millisecond
+	stub.mu.Unlock()
+	_, err := signer.Sign([]byte("message"))
+	require.Error(t, err)
+}
+
+func TestSigner_TLSClientAuth(t *testing.T) {
+	dir := t.TempDir()
+	clientCert := generateClientCert(t, dir)
+
+	server := httptest.NewUnstartedServer(
+		&stubSigner{pubkeys: []crypto.BLSPubkey{pubkey}},
+	)
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  x509.NewCertPool(),
+	}
+	server.TLS.ClientCAs.AddCert(clientCert)
+	server.StartTLS()
+	defer server.Close()
+
+	caPath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: server.Certificate().Raw,
+	}), 0o600))
+
+	cfg := remotesigner.DefaultConfig()
+	cfg.URL = server.URL
+	cfg.TLSCAPath = caPath
+
+	// The server rejects the clients without a certificate.
+	_, err := remotesigner.NewSigner(&cfg, fakeVerify)
+	require.Error(t, err)
+
+	cfg.TLSCertPath = filepath.Join(dir, "client.pem")
+	_, err = remotesigner.NewSigner(&cfg, fakeVerify)
+	require.ErrorIs(t, err, remotesigner.ErrIncompleteTLSKeyPair)
+
+	cfg.TLSKeyPath = filepath.Join(dir, "client.key")
+	signer, err := remotesigner.NewSigner(&cfg, fakeVerify)
+	require.NoError(t, err)
+	_, err = signer.SignRandaoReveal(math.Epoch(1), signingRoot)
+	require.NoError(t, err)
+}
+
+func TestPrivKey_HashesLongMessages(t *testing.T) {
+	stub := &stubSigner{pubkeys: []crypto.BLSPubkey{pubkey}}
+	key := remotesigner.NewPrivKey(
+		newSigner(t, stub), bls12381.PubKey(pubkey[:]),
+	)
+
+	short := []byte("vote")
+	signature, err := key.Sign(short)
+	require.NoError(t, err)
+	expected := fakeSign(pubkey, short)
+	require.Equal(t, expected[:], signature)
+
+	long := make([]byte, 64)
+	signature, err = key.Sign(long)
+	require.NoError(t, err)
+	hash := sha256.Sum256(long)
+	expected = fakeSign(pubkey, hash[:])
+	require.Equal(t, expected[:], signature)
+	require.Nil(t, key.Bytes())
+}
+
+// generateClientCert writes a self-signed client certificate and its key to
+// the given directory.
+func generateClientCert(t *testing.T, dir string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(
+		rand.Reader, template, template, &key.PublicKey, key,
+	)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "client.pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "client.key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0o600,
+	))
+	return cert
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnsupportedFormat is returned when restoring a snapshot written in a
+	// format this extension does not understand.
+	ErrUnsupportedFormat = errors.New("unsupported snapshot format")
+	// ErrEmptyPayload is returned when a payload item is too short to decode.
+	ErrEmptyPayload = errors.New("empty snapshot payload")
+	// ErrUnknownPayloadKind is returned when a payload item has an unknown
+	// kind.
+	ErrUnknownPayloadKind = errors.New("unknown snapshot payload kind")
+	// ErrBlockRootMismatch is returned when a restored block does not chain
+	// to the state committed in the app hash.
+	ErrBlockRootMismatch = errors.New("snapshot block root mismatch")
+	// ErrMissingHeadBlock is returned when the block the snapshotted state
+	// was built on is not in the block store, or not in the snapshot being
+	// restored.
+	ErrMissingHeadBlock = errors.New("snapshot head block missing")
+	// ErrUnexpectedSidecars is returned when sidecars are not preceded by
+	// their block.
+	ErrUnexpectedSidecars = errors.New("snapshot sidecars without a block")
+	// ErrDataNotAvailable is returned when the restored sidecars do not cover
+	// all the commitments of their block.
+	ErrDataNotAvailable = errors.New("snapshot sidecars are incomplete")
+	// ErrDepositIndexMismatch is returned when the restored deposits are not
+	// contiguous from the deposit index of the restored state.
+	ErrDepositIndexMismatch = errors.New("snapshot deposit index mismatch")
+)
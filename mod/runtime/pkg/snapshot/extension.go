@@ -0,0 +1,529 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"context"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// ExtensionName is the name the extension is registered under with the
+	// snapshot manager.
+	ExtensionName = "beacon-kit"
+	// ExtensionFormat is the format the extension writes its payloads in.
+	ExtensionFormat uint32 = 1
+	// depositBatchSize is the number of deposits read from or written to the
+	// deposit store at once.
+	depositBatchSize = 256
+)
+
+// Extension is a state sync snapshot extension that carries the beacon-kit
+// stores living outside of the multistore: the recent blocks of the block
+// store, their blob sidecars and the queued deposits. The beacon state is part
+// of the multistore snapshot itself and is used on restore to verify that the
+// restored blocks chain up to the state committed in the app hash.
+type Extension[
+	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+	BlobProcessorT BlobProcessor[AvailabilityStoreT, BlobSidecarsT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
+	BlockStoreT BlockStore[BeaconBlockT],
+	ContextT context.Context,
+	DepositT Deposit[DepositT],
+	DepositStoreT DepositStore[DepositT],
+	NodeT Node[ContextT],
+	StorageBackendT StorageBackend[
+		AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT,
+	],
+] struct {
+	// logger is used for logging.
+	logger log.Logger[any]
+	// chainSpec is the chain spec.
+	chainSpec ChainSpec
+	// blobProcessor verifies and persists restored blob sidecars.
+	blobProcessor BlobProcessorT
+	// sb is the storage backend.
+	sb StorageBackendT
+	// node is used to read the beacon state at a given height.
+	node NodeT
+	// blockStoreEnabled is true if the block store is filled, the blocks
+	// are left out of the snapshots otherwise.
+	blockStoreEnabled bool
+	// availabilityWindow is the number of recent blocks to include.
+	availabilityWindow uint64
+}
+
+// NewExtension creates a new snapshot extension.
+func NewExtension[
+	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+	BlobProcessorT BlobProcessor[AvailabilityStoreT, BlobSidecarsT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
+	BlockStoreT BlockStore[BeaconBlockT],
+	ContextT context.Context,
+	DepositT Deposit[DepositT],
+	DepositStoreT DepositStore[DepositT],
+	NodeT Node[ContextT],
+	StorageBackendT StorageBackend[
+		AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT,
+	],
+](
+	logger log.Logger[any],
+	chainSpec ChainSpec,
+	blobProcessor BlobProcessorT,
+	sb StorageBackendT,
+	blockStoreEnabled bool,
+	availabilityWindow uint64,
+) *Extension[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobProcessorT, BlobSidecarsT, BlockStoreT, ContextT,
+	DepositT, DepositStoreT, NodeT, StorageBackendT,
+] {
+	return &Extension[
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BeaconStateT, BlobProcessorT, BlobSidecarsT, BlockStoreT, ContextT,
+		DepositT, DepositStoreT, NodeT, StorageBackendT,
+	]{
+		logger:             logger,
+		chainSpec:          chainSpec,
+		blobProcessor:      blobProcessor,
+		sb:                 sb,
+		blockStoreEnabled:  blockStoreEnabled,
+		availabilityWindow: availabilityWindow,
+	}
+}
+
+// AttachNode sets the node used to read the beacon state at a given height.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, NodeT, _,
+]) AttachNode(node NodeT) {
+	e.node = node
+}
+
+// SnapshotName returns the name of the extension.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SnapshotName() string {
+	return ExtensionName
+}
+
+// SnapshotFormat returns the format the extension writes its payloads in.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SnapshotFormat() uint32 {
+	return ExtensionFormat
+}
+
+// SupportedFormats returns the formats the extension can restore from.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SupportedFormats() []uint32 {
+	return []uint32{ExtensionFormat}
+}
+
+// SnapshotExtension writes the blocks within the availability window, from
+// the head backwards, each followed by its in-window blob sidecars, and then
+// the deposits that have not yet been processed by the state at the given
+// height. While the block store is disabled, a marker stands in for the
+// blocks.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SnapshotExtension(
+	height uint64,
+	payloadWriter func([]byte) error,
+) error {
+	st, err := e.stateAtHeight(height)
+	if err != nil {
+		return err
+	}
+
+	headSlot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	var numBlocks int
+	if e.blockStoreEnabled {
+		numBlocks, err = e.writeBlocks(headSlot, payloadWriter)
+	} else {
+		err = payloadWriter(encodePayload(payloadKindNoBlocks, nil))
+	}
+	if err != nil {
+		return err
+	}
+
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	numDeposits, err := e.writeDeposits(depositIndex, payloadWriter)
+	if err != nil {
+		return err
+	}
+
+	e.logger.Info(
+		"Wrote beacon-kit snapshot extension 📸",
+		"height", height,
+		"num_blocks", numBlocks,
+		"num_deposits", numDeposits,
+	)
+	return nil
+}
+
+// RestoreExtension restores the blocks, blob sidecars and deposits written by
+// SnapshotExtension. The multistore has already been restored and verified
+// against the app hash at this point, so the blocks are checked to chain up to
+// the latest block header of the restored beacon state and the deposits to
+// follow its deposit index. A snapshot without blocks is only accepted if
+// it was taken while the block store was disabled.
+func (e *Extension[
+	_, BeaconBlockT, _, _, _, _, BlobSidecarsT, _, _, DepositT, _, _, _,
+]) RestoreExtension(
+	height uint64,
+	format uint32,
+	payloadReader func() ([]byte, error),
+) error {
+	if format != ExtensionFormat {
+		return errors.Wrapf(ErrUnsupportedFormat, "format %d", format)
+	}
+
+	st, err := e.stateAtHeight(height)
+	if err != nil {
+		return err
+	}
+
+	headSlot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	expectedRoot, err := headBlockRoot(st)
+	if err != nil {
+		return err
+	}
+
+	nextDepositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	var (
+		blk         BeaconBlockT
+		hasBlock    bool
+		noBlocks    bool
+		numBlocks   int
+		deposits    = make([]DepositT, 0, depositBatchSize)
+		numDeposits int
+	)
+	for {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		kind, body, err := decodePayload(payload)
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case payloadKindBlock:
+			if hasBlock {
+				if err = e.verifyDataAvailable(blk, headSlot); err != nil {
+					return err
+				}
+			}
+			if blk, err = e.restoreBlock(body, expectedRoot); err != nil {
+				return err
+			}
+			hasBlock = true
+			expectedRoot = blk.GetParentBlockRoot()
+			numBlocks++
+		case payloadKindNoBlocks:
+			noBlocks = true
+		case payloadKindSidecars:
+			if !hasBlock {
+				return ErrUnexpectedSidecars
+			}
+			if err = e.restoreSidecars(body); err != nil {
+				return err
+			}
+		case payloadKindDeposit:
+			var deposit DepositT
+			deposit = deposit.Empty()
+			if err = deposit.UnmarshalSSZ(body); err != nil {
+				return err
+			}
+			if deposit.GetIndex().Unwrap() != nextDepositIndex {
+				return errors.Wrapf(
+					ErrDepositIndexMismatch,
+					"expected %d, got %d",
+					nextDepositIndex, deposit.GetIndex().Unwrap(),
+				)
+			}
+			nextDepositIndex++
+			numDeposits++
+			if deposits = append(deposits, deposit); len(
+				deposits,
+			) == depositBatchSize {
+				if err = e.sb.DepositStore().EnqueueDeposits(
+					deposits,
+				); err != nil {
+					return err
+				}
+				deposits = deposits[:0]
+			}
+		}
+	}
+
+	// Past genesis, the snapshot must at least carry the head block unless
+	// the block store of the node taking it was disabled.
+	if !hasBlock && !noBlocks && headSlot > 0 {
+		return errors.Wrapf(ErrMissingHeadBlock, "slot %d", headSlot)
+	}
+	if hasBlock {
+		if err = e.verifyDataAvailable(blk, headSlot); err != nil {
+			return err
+		}
+	}
+	if err = e.sb.DepositStore().EnqueueDeposits(deposits); err != nil {
+		return err
+	}
+
+	e.logger.Info(
+		"Restored beacon-kit snapshot extension 📸",
+		"height", height,
+		"num_blocks", numBlocks,
+		"num_deposits", numDeposits,
+	)
+	return nil
+}
+
+// writeBlocks writes the blocks within the availability window, from the
+// given head slot backwards, stopping at the first missing block. The head
+// block itself must be present, a snapshot without it could not be verified
+// on restore.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) writeBlocks(
+	headSlot math.Slot,
+	payloadWriter func([]byte) error,
+) (int, error) {
+	// The head block is always written, even with an empty window.
+	window := max(e.availabilityWindow, 1)
+	var lowestSlot math.Slot
+	if headSlot.Unwrap() > window {
+		lowestSlot = headSlot - math.Slot(window)
+	}
+
+	numBlocks := 0
+	for slot := headSlot; slot > lowestSlot; slot-- {
+		blk, err := e.sb.BlockStore().Get(slot)
+		if (err != nil || blk.IsNil()) && slot == headSlot {
+			// The block store is filled asynchronously and may not have
+			// caught up with the state yet.
+			return numBlocks, errors.Wrapf(
+				ErrMissingHeadBlock, "slot %d", headSlot,
+			)
+		} else if err != nil || blk.IsNil() {
+			// The block store only holds finalized blocks while it is
+			// enabled, there is nothing older to chain to.
+			break
+		}
+
+		bz, err := blk.MarshalSSZ()
+		if err != nil {
+			return numBlocks, err
+		}
+		if err = payloadWriter(
+			encodeBlockPayload(blk.Version(), bz),
+		); err != nil {
+			return numBlocks, err
+		}
+		numBlocks++
+
+		if !e.chainSpec.WithinDAPeriod(slot, headSlot) {
+			continue
+		}
+		sidecars, err := e.sb.AvailabilityStore().GetBlobSidecars(
+			slot, blk.GetBody(),
+		)
+		if err != nil {
+			return numBlocks, err
+		}
+		if sidecars.Len() == 0 {
+			continue
+		}
+		if bz, err = sidecars.MarshalSSZ(); err != nil {
+			return numBlocks, err
+		}
+		if err = payloadWriter(
+			encodePayload(payloadKindSidecars, bz),
+		); err != nil {
+			return numBlocks, err
+		}
+	}
+	return numBlocks, nil
+}
+
+// writeDeposits writes the deposits starting at the given index.
+func (e *Extension[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) writeDeposits(
+	startIndex uint64,
+	payloadWriter func([]byte) error,
+) (int, error) {
+	numDeposits := 0
+	for {
+		deposits, err := e.sb.DepositStore().GetDepositsByIndex(
+			startIndex, depositBatchSize,
+		)
+		if err != nil {
+			return numDeposits, err
+		}
+
+		for _, deposit := range deposits {
+			bz, err := deposit.MarshalSSZ()
+			if err != nil {
+				return numDeposits, err
+			}
+			if err = payloadWriter(
+				encodePayload(payloadKindDeposit, bz),
+			); err != nil {
+				return numDeposits, err
+			}
+		}
+
+		numDeposits += len(deposits)
+		if len(deposits) < depositBatchSize {
+			return numDeposits, nil
+		}
+		startIndex += depositBatchSize
+	}
+}
+
+// restoreBlock decodes a block, verifies that its root matches the expected
+// root and stores it.
+func (e *Extension[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) restoreBlock(
+	body []byte,
+	expectedRoot common.Root,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	version, bz, err := decodeBlockPayload(body)
+	if err != nil {
+		return blk, err
+	}
+
+	if blk, err = blk.NewFromSSZ(bz, version); err != nil {
+		return blk, err
+	}
+
+	if root := blk.HashTreeRoot(); root != expectedRoot {
+		return blk, errors.Wrapf(
+			ErrBlockRootMismatch,
+			"slot %d: expected %s, got %s",
+			blk.GetSlot().Unwrap(), expectedRoot, root,
+		)
+	}
+	return blk, e.sb.BlockStore().Set(blk.GetSlot(), blk)
+}
+
+// restoreSidecars decodes, verifies and persists blob sidecars.
+func (e *Extension[
+	_, _, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _,
+]) restoreSidecars(body []byte) error {
+	var sidecars BlobSidecarsT
+	sidecars = sidecars.Empty()
+	if err := sidecars.UnmarshalSSZ(body); err != nil {
+		return err
+	}
+	if err := e.blobProcessor.VerifySidecars(sidecars); err != nil {
+		return err
+	}
+	return e.blobProcessor.ProcessSidecars(
+		e.sb.AvailabilityStore(), sidecars,
+	)
+}
+
+// verifyDataAvailable checks that the sidecars of an in-window block have all
+// been restored.
+func (e *Extension[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) verifyDataAvailable(blk BeaconBlockT, headSlot math.Slot) error {
+	if !e.chainSpec.WithinDAPeriod(blk.GetSlot(), headSlot) {
+		return nil
+	}
+	if !e.sb.AvailabilityStore().IsDataAvailable(
+		context.Background(), blk.GetSlot(), blk.GetBody(),
+	) {
+		return errors.Wrapf(
+			ErrDataNotAvailable, "slot %d", blk.GetSlot().Unwrap(),
+		)
+	}
+	return nil
+}
+
+// stateAtHeight returns the beacon state committed at the given height.
+func (e *Extension[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _,
+]) stateAtHeight(height uint64) (BeaconStateT, error) {
+	var st BeaconStateT
+	//#nosec:G701 // not an issue in practice.
+	queryCtx, err := e.node.CreateQueryContext(int64(height), false)
+	if err != nil {
+		return st, err
+	}
+	return e.sb.StateFromContext(queryCtx), nil
+}
+
+// headBlockRoot returns the root of the latest block processed by the given
+// state. The state root of the latest block header is only filled in when the
+// next slot is processed, so it is set to the root of the state here.
+func headBlockRoot[
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
+](st BeaconStateT) (common.Root, error) {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if header.GetStateRoot() == (common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return header.HashTreeRoot(), nil
+}
@@ -0,0 +1,456 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotFound = errors.New("not found")
+
+type testBody struct {
+	numBlobs uint64
+}
+
+type testBlock struct {
+	slot      math.Slot
+	parent    common.Root
+	stateRoot common.Root
+	body      *testBody
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	bz := binary.BigEndian.AppendUint64(nil, b.slot.Unwrap())
+	bz = append(bz, b.parent[:]...)
+	bz = append(bz, b.stateRoot[:]...)
+	return binary.BigEndian.AppendUint64(bz, b.body.numBlobs), nil
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	b.slot = math.Slot(binary.BigEndian.Uint64(bz))
+	copy(b.parent[:], bz[8:40])
+	copy(b.stateRoot[:], bz[40:72])
+	b.body = &testBody{numBlobs: binary.BigEndian.Uint64(bz[72:])}
+	return nil
+}
+
+func (b *testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	blk := new(testBlock)
+	return blk, blk.UnmarshalSSZ(bz)
+}
+
+func (b *testBlock) IsNil() bool                     { return b == nil }
+func (b *testBlock) Version() uint32                 { return 0 }
+func (b *testBlock) GetSlot() math.Slot              { return b.slot }
+func (b *testBlock) GetParentBlockRoot() common.Root { return b.parent }
+func (b *testBlock) GetBody() *testBody              { return b.body }
+
+func (b *testBlock) HashTreeRoot() common.Root {
+	return headerRoot(b.contentRoot(), b.stateRoot)
+}
+
+// contentRoot returns the root of the block without its state root.
+func (b *testBlock) contentRoot() common.Root {
+	var root common.Root
+	bz := binary.BigEndian.AppendUint64(nil, b.slot.Unwrap())
+	bz = append(bz, b.parent[:]...)
+	bz = binary.BigEndian.AppendUint64(bz, b.body.numBlobs)
+	for i, c := range bz {
+		root[i%len(root)] ^= c + byte(i)
+	}
+	return root
+}
+
+// headerRoot mixes the state root into the root of the rest of a block, so
+// that a header only matches its block when it carries the same state root.
+func headerRoot(contentRoot, stateRoot common.Root) common.Root {
+	root := contentRoot
+	for i, c := range stateRoot {
+		root[i] ^= c*31 + byte(i)
+	}
+	return root
+}
+
+type testHeader struct {
+	contentRoot common.Root
+	stateRoot   common.Root
+}
+
+func (h *testHeader) HashTreeRoot() common.Root {
+	return headerRoot(h.contentRoot, h.stateRoot)
+}
+
+func (h *testHeader) GetStateRoot() common.Root     { return h.stateRoot }
+func (h *testHeader) SetStateRoot(root common.Root) { h.stateRoot = root }
+
+type testState struct {
+	root         common.Root
+	slot         math.Slot
+	depositIndex uint64
+	// headContentRoot and headStateRoot make up the latest block header,
+	// whose state root is only set once the next slot is processed.
+	headContentRoot common.Root
+	headStateRoot   common.Root
+}
+
+func (s *testState) HashTreeRoot() common.Root   { return s.root }
+func (s *testState) GetSlot() (math.Slot, error) { return s.slot, nil }
+func (s *testState) GetEth1DepositIndex() (uint64, error) {
+	return s.depositIndex, nil
+}
+func (s *testState) GetLatestBlockHeader() (*testHeader, error) {
+	return &testHeader{
+		contentRoot: s.headContentRoot, stateRoot: s.headStateRoot,
+	}, nil
+}
+
+type testSidecars struct {
+	slot  math.Slot
+	count uint64
+}
+
+func (s *testSidecars) Empty() *testSidecars { return new(testSidecars) }
+func (s *testSidecars) Len() int             { return int(s.count) }
+
+func (s *testSidecars) MarshalSSZ() ([]byte, error) {
+	bz := binary.BigEndian.AppendUint64(nil, s.slot.Unwrap())
+	return binary.BigEndian.AppendUint64(bz, s.count), nil
+}
+
+func (s *testSidecars) UnmarshalSSZ(bz []byte) error {
+	s.slot = math.Slot(binary.BigEndian.Uint64(bz))
+	s.count = binary.BigEndian.Uint64(bz[8:])
+	return nil
+}
+
+type testAvailabilityStore struct {
+	sidecars map[math.Slot]uint64
+}
+
+func (s *testAvailabilityStore) GetBlobSidecars(
+	slot math.Slot, _ *testBody,
+) (*testSidecars, error) {
+	return &testSidecars{slot: slot, count: s.sidecars[slot]}, nil
+}
+
+func (s *testAvailabilityStore) IsDataAvailable(
+	_ context.Context, slot math.Slot, body *testBody,
+) bool {
+	return s.sidecars[slot] == body.numBlobs
+}
+
+type testBlobProcessor struct{}
+
+func (testBlobProcessor) VerifySidecars(*testSidecars) error { return nil }
+
+func (testBlobProcessor) ProcessSidecars(
+	avs *testAvailabilityStore, sidecars *testSidecars,
+) error {
+	avs.sidecars[sidecars.slot] = sidecars.count
+	return nil
+}
+
+type testBlockStore struct {
+	blocks map[math.Slot]*testBlock
+}
+
+func (s *testBlockStore) Get(slot math.Slot) (*testBlock, error) {
+	blk, ok := s.blocks[slot]
+	if !ok {
+		return nil, errNotFound
+	}
+	return blk, nil
+}
+
+func (s *testBlockStore) Set(slot math.Slot, blk *testBlock) error {
+	s.blocks[slot] = blk
+	return nil
+}
+
+type testDeposit struct {
+	index math.U64
+}
+
+func (d *testDeposit) Empty() *testDeposit { return new(testDeposit) }
+func (d *testDeposit) GetIndex() math.U64  { return d.index }
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return binary.BigEndian.AppendUint64(nil, d.index.Unwrap()), nil
+}
+
+func (d *testDeposit) UnmarshalSSZ(bz []byte) error {
+	d.index = math.U64(binary.BigEndian.Uint64(bz))
+	return nil
+}
+
+type testDepositStore struct {
+	deposits []*testDeposit
+}
+
+func (s *testDepositStore) GetDepositsByIndex(
+	start, num uint64,
+) ([]*testDeposit, error) {
+	out := []*testDeposit{}
+	for _, d := range s.deposits {
+		if d.index.Unwrap() >= start && uint64(len(out)) < num {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *testDepositStore) EnqueueDeposits(deposits []*testDeposit) error {
+	s.deposits = append(s.deposits, deposits...)
+	return nil
+}
+
+type testNode struct{}
+
+func (testNode) CreateQueryContext(int64, bool) (context.Context, error) {
+	return context.Background(), nil
+}
+
+type testBackend struct {
+	as *testAvailabilityStore
+	bs *testBlockStore
+	ds *testDepositStore
+	st *testState
+}
+
+func (b *testBackend) AvailabilityStore() *testAvailabilityStore { return b.as }
+func (b *testBackend) BlockStore() *testBlockStore               { return b.bs }
+func (b *testBackend) DepositStore() *testDepositStore           { return b.ds }
+func (b *testBackend) StateFromContext(context.Context) *testState {
+	return b.st
+}
+
+type testChainSpec struct{}
+
+func (testChainSpec) WithinDAPeriod(block, current math.Slot) bool {
+	return block+2 >= current
+}
+
+type testExtension = snapshot.Extension[
+	*testAvailabilityStore, *testBlock, *testBody, *testHeader, *testState,
+	testBlobProcessor, *testSidecars, *testBlockStore, context.Context,
+	*testDeposit, *testDepositStore, testNode, *testBackend,
+]
+
+func newTestExtension(sb *testBackend) *testExtension {
+	return newExtension(sb, true)
+}
+
+func newExtension(sb *testBackend, blockStoreEnabled bool) *testExtension {
+	ext := snapshot.NewExtension[
+		*testAvailabilityStore, *testBlock, *testBody, *testHeader,
+		*testState, testBlobProcessor, *testSidecars, *testBlockStore,
+		context.Context, *testDeposit, *testDepositStore, testNode,
+	](
+		noop.NewLogger[any](), testChainSpec{}, testBlobProcessor{}, sb,
+		blockStoreEnabled, 4,
+	)
+	ext.AttachNode(testNode{})
+	return ext
+}
+
+func newEmptyBackend(st *testState) *testBackend {
+	return &testBackend{
+		as: &testAvailabilityStore{sidecars: map[math.Slot]uint64{}},
+		bs: &testBlockStore{blocks: map[math.Slot]*testBlock{}},
+		ds: &testDepositStore{},
+		st: st,
+	}
+}
+
+// buildSource builds a backend holding a chain of blocks up to slot 10, blobs
+// for every block and deposits 3 to 5. The latest block header of the state
+// does not carry its state root yet, as right after the block was processed.
+func buildSource(t *testing.T) *testBackend {
+	t.Helper()
+	sb := newEmptyBackend(
+		&testState{root: common.Root{0x1}, slot: 10, depositIndex: 3},
+	)
+	var (
+		parent common.Root
+		blk    *testBlock
+	)
+	for slot := math.Slot(1); slot <= 10; slot++ {
+		blk = &testBlock{
+			slot:      slot,
+			parent:    parent,
+			stateRoot: common.Root{0xa0, byte(slot)},
+			body:      &testBody{numBlobs: 2},
+		}
+		if slot == 10 {
+			blk.stateRoot = sb.st.root
+		}
+		sb.bs.blocks[slot] = blk
+		sb.as.sidecars[slot] = 2
+		parent = blk.HashTreeRoot()
+	}
+	sb.st.headContentRoot = blk.contentRoot()
+	for i := range uint64(6) {
+		sb.ds.deposits = append(sb.ds.deposits, &testDeposit{index: math.U64(i)})
+	}
+	return sb
+}
+
+func takeSnapshot(t *testing.T, sb *testBackend) [][]byte {
+	t.Helper()
+	var payloads [][]byte
+	require.NoError(t, newTestExtension(sb).SnapshotExtension(
+		10, func(bz []byte) error {
+			payloads = append(payloads, bz)
+			return nil
+		},
+	))
+	return payloads
+}
+
+func readerFor(payloads [][]byte) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		next := payloads[0]
+		payloads = payloads[1:]
+		return next, nil
+	}
+}
+
+func TestExtension_RoundTrip(t *testing.T) {
+	src := buildSource(t)
+	payloads := takeSnapshot(t, src)
+
+	dst := newEmptyBackend(src.st)
+	require.NoError(t, newTestExtension(dst).RestoreExtension(
+		10, snapshot.ExtensionFormat, readerFor(payloads),
+	))
+
+	// Only the blocks within the availability window are restored.
+	require.Len(t, dst.bs.blocks, 4)
+	for slot := math.Slot(7); slot <= 10; slot++ {
+		require.Equal(t, src.bs.blocks[slot], dst.bs.blocks[slot])
+	}
+
+	// Only the sidecars within the DA period are restored.
+	require.Equal(
+		t, map[math.Slot]uint64{8: 2, 9: 2, 10: 2}, dst.as.sidecars,
+	)
+
+	// Only the deposits that are not yet processed are restored.
+	require.Len(t, dst.ds.deposits, 3)
+	require.Equal(t, math.U64(3), dst.ds.deposits[0].GetIndex())
+}
+
+func TestExtension_RestoreRejectsForeignChain(t *testing.T) {
+	src := buildSource(t)
+	payloads := takeSnapshot(t, src)
+
+	st := *src.st
+	st.headContentRoot = common.Root{0xff}
+	err := newTestExtension(newEmptyBackend(&st)).RestoreExtension(
+		10, snapshot.ExtensionFormat, readerFor(payloads),
+	)
+	require.ErrorIs(t, err, snapshot.ErrBlockRootMismatch)
+}
+
+func TestExtension_RestoreKeepsSetStateRoot(t *testing.T) {
+	src := buildSource(t)
+	payloads := takeSnapshot(t, src)
+
+	// Once the next slot is processed, the header carries the state root of
+	// the head block while the state moved on. Patching the header with the
+	// root of the state would break the chain.
+	st := *src.st
+	st.headStateRoot = st.root
+	st.root = common.Root{0x2}
+	require.NoError(t, newTestExtension(newEmptyBackend(&st)).RestoreExtension(
+		10, snapshot.ExtensionFormat, readerFor(payloads),
+	))
+}
+
+func TestExtension_SnapshotRequiresHeadBlock(t *testing.T) {
+	src := buildSource(t)
+	delete(src.bs.blocks, 10)
+
+	err := newTestExtension(src).SnapshotExtension(
+		10, func([]byte) error { return nil },
+	)
+	require.ErrorIs(t, err, snapshot.ErrMissingHeadBlock)
+}
+
+func TestExtension_RestoreRequiresHeadBlock(t *testing.T) {
+	src := buildSource(t)
+	err := newTestExtension(newEmptyBackend(src.st)).RestoreExtension(
+		10, snapshot.ExtensionFormat, readerFor(nil),
+	)
+	require.ErrorIs(t, err, snapshot.ErrMissingHeadBlock)
+}
+
+func TestExtension_RoundTripWithoutBlockStore(t *testing.T) {
+	// With the block store disabled, as by default, the block store is
+	// empty and the snapshot only carries the deposits.
+	src := buildSource(t)
+	src.bs.blocks = map[math.Slot]*testBlock{}
+	var payloads [][]byte
+	require.NoError(t, newExtension(src, false).SnapshotExtension(
+		10, func(bz []byte) error {
+			payloads = append(payloads, bz)
+			return nil
+		},
+	))
+
+	dst := newEmptyBackend(src.st)
+	require.NoError(t, newTestExtension(dst).RestoreExtension(
+		10, snapshot.ExtensionFormat, readerFor(payloads),
+	))
+	require.Empty(t, dst.bs.blocks)
+	require.Len(t, dst.ds.deposits, 3)
+}
+
+func TestExtension_RestoreRejectsMissingSidecars(t *testing.T) {
+	src := buildSource(t)
+	delete(src.as.sidecars, 9)
+	payloads := takeSnapshot(t, src)
+
+	err := newTestExtension(newEmptyBackend(src.st)).RestoreExtension(
+		10, snapshot.ExtensionFormat, readerFor(payloads),
+	)
+	require.ErrorIs(t, err, snapshot.ErrDataNotAvailable)
+}
+
+func TestExtension_RestoreRejectsUnknownFormat(t *testing.T) {
+	err := newTestExtension(newEmptyBackend(&testState{})).RestoreExtension(
+		10, snapshot.ExtensionFormat+1, readerFor(nil),
+	)
+	require.ErrorIs(t, err, snapshot.ErrUnsupportedFormat)
+}
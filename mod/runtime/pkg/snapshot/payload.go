@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// payloadKind identifies the type of an item in the extension payload stream.
+type payloadKind byte
+
+const (
+	// payloadKindBlock is a beacon block, prefixed with its fork version.
+	payloadKindBlock payloadKind = iota + 1
+	// payloadKindSidecars are the blob sidecars of the preceding block.
+	payloadKindSidecars
+	// payloadKindDeposit is a single queued deposit.
+	payloadKindDeposit
+	// payloadKindNoBlocks marks a snapshot taken while the block store was
+	// disabled, which carries no blocks.
+	payloadKindNoBlocks
+)
+
+// versionLength is the length of the fork version prefix of a block item.
+const versionLength = 4
+
+// encodePayload prefixes the given SSZ bytes with the payload kind.
+func encodePayload(kind payloadKind, bz []byte) []byte {
+	return append([]byte{byte(kind)}, bz...)
+}
+
+// encodeBlockPayload prefixes the given SSZ bytes with the payload kind and
+// the fork version of the block.
+func encodeBlockPayload(version uint32, bz []byte) []byte {
+	out := make([]byte, 1+versionLength, 1+versionLength+len(bz))
+	out[0] = byte(payloadKindBlock)
+	binary.BigEndian.PutUint32(out[1:], version)
+	return append(out, bz...)
+}
+
+// decodePayload splits a payload item into its kind and its body.
+func decodePayload(payload []byte) (payloadKind, []byte, error) {
+	if len(payload) == 0 {
+		return 0, nil, ErrEmptyPayload
+	}
+	kind := payloadKind(payload[0])
+	switch kind {
+	case payloadKindBlock, payloadKindSidecars, payloadKindDeposit,
+		payloadKindNoBlocks:
+		return kind, payload[1:], nil
+	default:
+		return 0, nil, errors.Wrapf(
+			ErrUnknownPayloadKind, "kind %d", payload[0],
+		)
+	}
+}
+
+// decodeBlockPayload splits the body of a block item into the fork version
+// and the SSZ bytes of the block.
+func decodeBlockPayload(body []byte) (uint32, []byte, error) {
+	if len(body) < versionLength {
+		return 0, nil, ErrEmptyPayload
+	}
+	return binary.BigEndian.Uint32(body), body[versionLength:], nil
+}
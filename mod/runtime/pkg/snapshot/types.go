@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// AvailabilityStore is the interface for the blob sidecar store.
+type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
+	// GetBlobSidecars returns the sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot, BeaconBlockBodyT) (BlobSidecarsT, error)
+	// IsDataAvailable ensures that all blobs referenced in the block are
+	// stored.
+	IsDataAvailable(context.Context, math.Slot, BeaconBlockBodyT) bool
+}
+
+// BlobProcessor is the interface for the blob processor.
+type BlobProcessor[AvailabilityStoreT, BlobSidecarsT any] interface {
+	// VerifySidecars verifies the given sidecars.
+	VerifySidecars(sidecars BlobSidecarsT) error
+	// ProcessSidecars persists the given sidecars in the availability store.
+	ProcessSidecars(avs AvailabilityStoreT, sidecars BlobSidecarsT) error
+}
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[SelfT any, BeaconBlockBodyT any] interface {
+	constraints.SSZMarshallable
+	constraints.Nillable
+	// NewFromSSZ creates a new beacon block from the given SSZ bytes and fork
+	// version.
+	NewFromSSZ([]byte, uint32) (SelfT, error)
+	// Version returns the fork version of the block.
+	Version() uint32
+	// HashTreeRoot returns the hash tree root of the block.
+	HashTreeRoot() common.Root
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetParentBlockRoot returns the root of the parent block.
+	GetParentBlockRoot() common.Root
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockHeader is the interface for a beacon block header.
+type BeaconBlockHeader interface {
+	// HashTreeRoot returns the hash tree root of the header.
+	HashTreeRoot() common.Root
+	// GetStateRoot returns the state root of the header.
+	GetStateRoot() common.Root
+	// SetStateRoot sets the state root of the header.
+	SetStateRoot(common.Root)
+}
+
+// BeaconState is the interface for the beacon state.
+type BeaconState[BeaconBlockHeaderT BeaconBlockHeader] interface {
+	// HashTreeRoot returns the hash tree root of the state.
+	HashTreeRoot() common.Root
+	// GetSlot returns the slot of the state.
+	GetSlot() (math.Slot, error)
+	// GetLatestBlockHeader returns the latest block header.
+	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
+	// GetEth1DepositIndex returns the index of the next deposit to process.
+	GetEth1DepositIndex() (uint64, error)
+}
+
+// BlobSidecars is the interface for the blob sidecars of a block.
+type BlobSidecars[SelfT any] interface {
+	constraints.SSZMarshallable
+	constraints.Empty[SelfT]
+	// Len returns the number of sidecars.
+	Len() int
+}
+
+// BlockStore is the interface for the beacon block store.
+type BlockStore[BeaconBlockT any] interface {
+	// Get retrieves the block at the given slot.
+	Get(math.Slot) (BeaconBlockT, error)
+	// Set stores the block at the given slot.
+	Set(math.Slot, BeaconBlockT) error
+}
+
+// ChainSpec is the interface for the chain spec.
+type ChainSpec interface {
+	// WithinDAPeriod checks if a block slot is within the data availability
+	// period relative to the current slot.
+	WithinDAPeriod(block, current math.Slot) bool
+}
+
+// Deposit is the interface for a deposit.
+type Deposit[SelfT any] interface {
+	constraints.SSZMarshallable
+	constraints.Empty[SelfT]
+	// GetIndex returns the index of the deposit.
+	GetIndex() math.U64
+}
+
+// DepositStore is the interface for the deposit store.
+type DepositStore[DepositT any] interface {
+	// GetDepositsByIndex returns up to `numView` deposits starting at
+	// `startIndex`.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
+	// EnqueueDeposits adds the given deposits to the store.
+	EnqueueDeposits([]DepositT) error
+}
+
+// Node is the interface for the node the snapshots are taken from.
+type Node[ContextT context.Context] interface {
+	// CreateQueryContext creates a query context for the given height.
+	CreateQueryContext(height int64, prove bool) (ContextT, error)
+}
+
+// StorageBackend is the interface for the beacon storage backend.
+type StorageBackend[
+	AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT any,
+] interface {
+	// AvailabilityStore returns the availability store.
+	AvailabilityStore() AvailabilityStoreT
+	// BlockStore returns the block store.
+	BlockStore() BlockStoreT
+	// DepositStore returns the deposit store.
+	DepositStore() DepositStoreT
+	// StateFromContext returns the beacon state from the given context.
+	StateFromContext(context.Context) BeaconStateT
+}
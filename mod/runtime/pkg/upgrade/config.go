@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+// Config is the configuration of the upgrade plan followed by the node.
+type Config struct {
+	// Name is the name of the upgrade plan, empty if none is scheduled.
+	Name string `mapstructure:"name"`
+	// Slot is the slot at which the upgrade comes into effect. Nodes without
+	// a handler for the plan halt before processing it.
+	Slot uint64 `mapstructure:"slot"`
+}
+
+// DefaultConfig returns the default configuration, with no upgrade planned.
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// IsScheduled returns true if an upgrade plan is configured.
+func (c Config) IsScheduled() bool {
+	return c.Name != "" && c.Slot > 0
+}
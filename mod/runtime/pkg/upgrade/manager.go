@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// Manager coordinates the upgrade plan of the node. Binaries that do not know
+// the plan halt, through the halt height of the application, right after
+// committing the block preceding its slot, binaries that do run the handler of
+// the plan on every state the block at its slot is built or verified against.
+type Manager[
+	BeaconStateT any,
+	StorageBackendT StorageBackend[BeaconStateT],
+] struct {
+	// cfg is the configuration of the upgrade plan.
+	cfg Config
+	// logger is the logger for the manager.
+	logger log.Logger[any]
+	// sb is the storage backend the handlers migrate.
+	sb StorageBackendT
+	// handlers are the upgrade handlers of this binary, keyed by plan name.
+	handlers map[string]Handler[BeaconStateT]
+}
+
+// NewManager creates a new upgrade manager.
+func NewManager[
+	BeaconStateT any,
+	StorageBackendT StorageBackend[BeaconStateT],
+](
+	cfg Config,
+	logger log.Logger[any],
+	sb StorageBackendT,
+	handlers map[string]Handler[BeaconStateT],
+) *Manager[BeaconStateT, StorageBackendT] {
+	return &Manager[BeaconStateT, StorageBackendT]{
+		cfg:      cfg,
+		logger:   logger,
+		sb:       sb,
+		handlers: handlers,
+	}
+}
+
+// CheckStartup returns an error if the node, having committed the given
+// height, must not process any further blocks with this binary.
+func (m *Manager[_, _]) CheckStartup(lastHeight int64) error {
+	if !m.cfg.IsScheduled() || m.hasHandler() {
+		return nil
+	}
+	//#nosec:G701 // heights are never negative.
+	if uint64(lastHeight)+1 >= m.cfg.Slot {
+		return m.errUpgradeNeeded()
+	}
+	m.logger.Warn(
+		"Binary has no handler for the upgrade, the node will halt before it",
+		"plan", m.cfg.Name,
+		"slot", m.cfg.Slot,
+	)
+	return nil
+}
+
+// HaltHeight returns the height after which the node must halt, i.e. the
+// height preceding the slot of an upgrade this binary lacks, or 0 if none.
+func (m *Manager[_, _]) HaltHeight() uint64 {
+	if !m.cfg.IsScheduled() || m.hasHandler() {
+		return 0
+	}
+	return m.cfg.Slot - 1
+}
+
+// PreBlock runs the handler of the upgrade plan on the state of the given
+// context if the given height is the slot of the plan. It must be run before
+// preparing, processing and finalizing a block, such that the proposer and
+// the verifiers all build on the migrated state. It returns an error if this
+// binary lacks the handler of a plan whose slot has been reached.
+func (m *Manager[_, _]) PreBlock(ctx context.Context, height int64) error {
+	//#nosec:G701 // heights are never negative.
+	if !m.cfg.IsScheduled() || uint64(height) < m.cfg.Slot {
+		return nil
+	}
+
+	handler, ok := m.handlers[m.cfg.Name]
+	if !ok {
+		return m.errUpgradeNeeded()
+	}
+	//#nosec:G701 // heights are never negative.
+	if uint64(height) != m.cfg.Slot {
+		return nil
+	}
+
+	m.logger.Info(
+		"Applying upgrade ⬆️",
+		"plan", m.cfg.Name,
+		"slot", m.cfg.Slot,
+	)
+	if err := handler(ctx, m.sb.StateFromContext(ctx)); err != nil {
+		return errors.Wrapf(err, "failed to apply upgrade %s", m.cfg.Name)
+	}
+	return nil
+}
+
+// hasHandler returns true if this binary has a handler for the plan.
+func (m *Manager[_, _]) hasHandler() bool {
+	_, ok := m.handlers[m.cfg.Name]
+	return ok
+}
+
+// errUpgradeNeeded returns the error reported when the binary lacks the
+// handler of the plan.
+func (m *Manager[_, _]) errUpgradeNeeded() error {
+	return errors.Wrapf(
+		ErrUpgradeNeeded,
+		"upgrade %q is scheduled at slot %d but this binary has no handler "+
+			"for it, install the new binary",
+		m.cfg.Name, m.cfg.Slot,
+	)
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/upgrade"
+	"github.com/stretchr/testify/require"
+)
+
+type testState struct {
+	migrated int
+}
+
+type testStorageBackend struct {
+	st *testState
+}
+
+func (sb testStorageBackend) StateFromContext(context.Context) *testState {
+	return sb.st
+}
+
+func newManager(
+	cfg upgrade.Config,
+	handlers map[string]upgrade.Handler[*testState],
+) (*upgrade.Manager[*testState, testStorageBackend], *testState) {
+	st := &testState{}
+	return upgrade.NewManager[*testState, testStorageBackend](
+		cfg, noop.NewLogger[any](), testStorageBackend{st: st}, handlers,
+	), st
+}
+
+func migrate(_ context.Context, st *testState) error {
+	st.migrated++
+	return nil
+}
+
+func TestManagerWithoutPlan(t *testing.T) {
+	m, _ := newManager(upgrade.DefaultConfig(), nil)
+	require.NoError(t, m.CheckStartup(100))
+	require.Zero(t, m.HaltHeight())
+	require.NoError(t, m.PreBlock(context.Background(), 100))
+}
+
+func TestManagerHaltsWithoutHandler(t *testing.T) {
+	cfg := upgrade.Config{Name: "v2", Slot: 10}
+	m, _ := newManager(cfg, nil)
+
+	require.NoError(t, m.CheckStartup(8))
+	require.Equal(t, uint64(9), m.HaltHeight())
+
+	require.ErrorIs(t, m.CheckStartup(9), upgrade.ErrUpgradeNeeded)
+	require.NoError(t, m.PreBlock(context.Background(), 9))
+	require.ErrorIs(
+		t, m.PreBlock(context.Background(), 10), upgrade.ErrUpgradeNeeded,
+	)
+}
+
+func TestManagerRunsHandlerOnce(t *testing.T) {
+	cfg := upgrade.Config{Name: "v2", Slot: 10}
+	m, st := newManager(cfg, map[string]upgrade.Handler[*testState]{
+		"v2": migrate,
+	})
+
+	require.NoError(t, m.CheckStartup(9))
+	require.Zero(t, m.HaltHeight())
+
+	for height := int64(8); height <= 12; height++ {
+		require.NoError(t, m.PreBlock(context.Background(), height))
+	}
+	require.Equal(t, 1, st.migrated)
+}
+
+// branchKey is the context key of the state branch a test block runs on.
+type branchKey struct{}
+
+// branchStorageBackend returns the state branch carried by the context, as the
+// proposal and finalize states of the application are distinct branches of
+// the committed state.
+type branchStorageBackend struct{}
+
+func (branchStorageBackend) StateFromContext(
+	ctx context.Context,
+) *branchState {
+	//nolint:forcetypeassert // the tests always set the branch.
+	return ctx.Value(branchKey{}).(*branchState)
+}
+
+type branchState struct {
+	balances map[string]uint64
+}
+
+// root returns a digest of the state, standing in for its state root.
+func (st *branchState) root() string {
+	return fmt.Sprint(st.balances)
+}
+
+func TestManagerMigratesEveryBranch(t *testing.T) {
+	committed := map[string]uint64{"alice": 10, "bob": 20}
+	m := upgrade.NewManager[*branchState, branchStorageBackend](
+		upgrade.Config{Name: "v2", Slot: 10},
+		noop.NewLogger[any](),
+		branchStorageBackend{},
+		map[string]upgrade.Handler[*branchState]{
+			"v2": func(_ context.Context, st *branchState) error {
+				st.balances["bob"] += st.balances["alice"]
+				delete(st.balances, "alice")
+				return nil
+			},
+		},
+	)
+
+	// The block at the slot of the plan is prepared, processed and finalized
+	// against distinct branches of the committed state, each of which must
+	// be migrated for the proposer and the verifiers to agree on its root.
+	roots := make([]string, 0, 3)
+	for range 3 {
+		st := &branchState{balances: maps.Clone(committed)}
+		ctx := context.WithValue(context.Background(), branchKey{}, st)
+		require.NoError(t, m.PreBlock(ctx, 10))
+		require.Equal(t, map[string]uint64{"bob": 30}, st.balances)
+		roots = append(roots, st.root())
+	}
+	require.Equal(t, roots[0], roots[1])
+	require.Equal(t, roots[0], roots[2])
+
+	// Blocks past the slot run on the already migrated state.
+	st := &branchState{balances: map[string]uint64{"bob": 30}}
+	ctx := context.WithValue(context.Background(), branchKey{}, st)
+	require.NoError(t, m.PreBlock(ctx, 11))
+	require.Equal(t, map[string]uint64{"bob": 30}, st.balances)
+}
@@ -38,6 +38,14 @@ var (
 	// execution payload does not match the expected value.
 	ErrParentPayloadHashMismatch = errors.New("payload parent hash mismatch")
 
+	// ErrMalformedEmptyPayload is returned when an execution payload without
+	// block hash carries data.
+	ErrMalformedEmptyPayload = errors.New("empty payload carries data")
+
+	// ErrBlobsWithoutPayload is returned when a block carrying an empty
+	// execution payload commits to blobs.
+	ErrBlobsWithoutPayload = errors.New("blobs in block without payload")
+
 	// ErrRandaoMixMismatch is returned when the randao mix in an execution
 	// payload does not match the expected value.
 	ErrRandaoMixMismatch = errors.New("randao mix mismatch")
@@ -25,50 +25,53 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
-	"golang.org/x/sync/errgroup"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 )
 
 // processExecutionPayload processes the execution payload and ensures it
 // matches the local state.
 func (sp *StateProcessor[
 	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, ExecutionPayloadHeaderT, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) processExecutionPayload(
 	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
 	var (
-		body    = blk.GetBody()
-		payload = body.GetExecutionPayload()
-		header  ExecutionPayloadHeaderT
-		g, gCtx = errgroup.WithContext(context.Background())
+		body                  = blk.GetBody()
+		payload               = body.GetExecutionPayload()
+		parentBeaconBlockRoot = blk.GetParentBlockRoot()
 	)
 
-	// Skip payload verification if the context is configured as such.
-	if !ctx.GetSkipPayloadVerification() {
-		g.Go(func() error {
-			return sp.validateExecutionPayload(
-				gCtx, st, blk, ctx.GetOptimisticEngine(),
-			)
-		})
+	// A block may carry an empty payload in place of a new execution block,
+	// it then keeps the execution head of its parent. This lets a proposer
+	// whose execution client fails still propose a block.
+	if isEmptyPayload(payload) {
+		return sp.validateEmptyPayload(blk)
 	}
 
-	// Get the execution payload header. TODO: This is live on bArtio with a bug
-	// and needs to be hardforked off of. We check for version and convert to
+	// Get the execution payload header, verifying the block hash of the
+	// payload locally before any call to the execution client. This rejects
+	// malformed payloads cheaply and is done even when verification by the
+	// execution client is skipped. TODO: This is live on bArtio with a bug and
+	// needs to be hardforked off of. We check for version and convert to
 	// header based on that version as a temporary solution to avoid breaking
 	// changes.
-	g.Go(func() error {
-		var err error
-		header, err = payload.ToHeader(
-			sp.cs.MaxWithdrawalsPerPayload(),
-			sp.cs.DepositEth1ChainID(),
-		)
+	header, err := payload.ToHeader(
+		sp.cs.DepositEth1ChainID(), &parentBeaconBlockRoot,
+	)
+	if err != nil {
 		return err
-	})
+	}
 
-	if err := g.Wait(); err != nil {
-		return err
+	// Skip payload verification if the context is configured as such.
+	if !ctx.GetSkipPayloadVerification() {
+		if err = sp.validateExecutionPayload(
+			context.Background(), st, blk, ctx.GetOptimisticEngine(),
+		); err != nil {
+			return err
+		}
 	}
 
 	// Set the latest execution payload header.
@@ -179,3 +182,34 @@ func (sp *StateProcessor[
 	}
 	return nil
 }
+
+// isEmptyPayload returns true if the given payload is empty, that is if the
+// block carrying it keeps the execution head of its parent. No execution
+// block hashes to zero.
+func isEmptyPayload(
+	payload interface {
+		GetBlockHash() gethprimitives.ExecutionHash
+	},
+) bool {
+	return payload.GetBlockHash() == gethprimitives.ExecutionHash{}
+}
+
+// validateEmptyPayload ensures the empty payload of the block carries no
+// data, and the block no blobs, as blobs are bound to an execution payload.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) validateEmptyPayload(blk BeaconBlockT) error {
+	var (
+		body     = blk.GetBody()
+		payload  = body.GetExecutionPayload()
+		numBlobs = len(body.GetBlobKzgCommitments())
+	)
+	empty := payload.Empty(payload.Version())
+	if payload.HashTreeRoot() != empty.HashTreeRoot() {
+		return ErrMalformedEmptyPayload
+	}
+	if numBlobs > 0 {
+		return errors.Wrapf(ErrBlobsWithoutPayload, "%d blobs", numBlobs)
+	}
+	return nil
+}
@@ -237,6 +237,11 @@ func (sp *StateProcessor[
 		payloadWithdrawals = payload.GetWithdrawals()
 	)
 
+	// An empty payload leaves the withdrawals to the following payload.
+	if isEmptyPayload(payload) {
+		return nil
+	}
+
 	// Get the expected withdrawals.
 	expectedWithdrawals, err := st.ExpectedWithdrawals()
 	if err != nil {
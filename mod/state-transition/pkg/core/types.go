@@ -146,6 +146,7 @@ type ExecutionPayload[
 	ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT any,
 ] interface {
 	constraints.EngineType[ExecutionPayloadT]
+	HashTreeRoot() common.Root
 	GetTransactions() engineprimitives.Transactions
 	GetParentHash() gethprimitives.ExecutionHash
 	GetBlockHash() gethprimitives.ExecutionHash
@@ -164,8 +165,8 @@ type ExecutionPayload[
 	GetBlobGasUsed() math.U64
 	GetExcessBlobGas() math.U64
 	ToHeader(
-		maxWithdrawalsPerPayload uint64,
 		eth1ChainID uint64,
+		parentBeaconBlockRoot *common.Root,
 	) (ExecutionPayloadHeaderT, error)
 }
 
@@ -24,10 +24,12 @@ const (
 	BlockKeyPrefix byte = iota
 	RootsKeyPrefix
 	ExecutionNumbersKeyPrefix
+	BlindedBlockKeyPrefix
 )
 
 const (
 	BlocksMapName           = "blocks"
 	RootsMapName            = "roots"
 	ExecutionNumbersMapName = "execution_numbers"
+	BlindedBlocksMapName    = "blinded_blocks"
 )
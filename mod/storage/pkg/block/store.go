@@ -33,23 +33,42 @@ import (
 )
 
 // KVStore is a simple KV store based implementation that stores beacon blocks.
-type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+// When blinded storage is enabled, blocks are persisted with their execution
+// payload replaced by its header and are reconstructed from the execution
+// client on read.
+type KVStore[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[BlindedBeaconBlockT],
+] struct {
 	blocks           sdkcollections.Map[math.Slot, BeaconBlockT]
+	blindedBlocks    sdkcollections.Map[math.Slot, BlindedBeaconBlockT]
 	roots            sdkcollections.Map[[]byte, math.Slot]
 	executionNumbers sdkcollections.Map[math.U64, math.Slot]
 
 	mu           sync.RWMutex
 	cdc          *encoding.SSZInterfaceCodec[BeaconBlockT]
+	blindedCdc   *encoding.SSZInterfaceCodec[BlindedBeaconBlockT]
 	earliestSlot math.Slot
+
+	// blinded determines whether new blocks are stored blinded.
+	blinded bool
+	// reconstructor rebuilds full blocks from blinded ones.
+	reconstructor Reconstructor[BeaconBlockT, BlindedBeaconBlockT]
 }
 
 // NewStore creates a new block store.
-func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+func NewStore[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BlindedBeaconBlockT],
+	BlindedBeaconBlockT BlindedBeaconBlock[BlindedBeaconBlockT],
+](
 	kvsp store.KVStoreService,
-) *KVStore[BeaconBlockT] {
+	blinded bool,
+	reconstructor Reconstructor[BeaconBlockT, BlindedBeaconBlockT],
+) *KVStore[BeaconBlockT, BlindedBeaconBlockT] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
 	cdc := &encoding.SSZInterfaceCodec[BeaconBlockT]{}
-	return &KVStore[BeaconBlockT]{
+	blindedCdc := &encoding.SSZInterfaceCodec[BlindedBeaconBlockT]{}
+	return &KVStore[BeaconBlockT, BlindedBeaconBlockT]{
 		blocks: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{BlockKeyPrefix}),
@@ -57,6 +76,13 @@ func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 			encoding.U64Key,
 			cdc,
 		),
+		blindedBlocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{BlindedBlockKeyPrefix}),
+			BlindedBlocksMapName,
+			encoding.U64Key,
+			blindedCdc,
+		),
 		roots: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{RootsKeyPrefix}),
@@ -71,27 +97,62 @@ func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 			encoding.U64Key,
 			encoding.U64Value,
 		),
-		cdc: cdc,
+		cdc:           cdc,
+		blindedCdc:    blindedCdc,
+		blinded:       blinded,
+		reconstructor: reconstructor,
 	}
 }
 
-// Get retrieves the block by a given index from the store.
-func (kv *KVStore[BeaconBlockT]) Get(slot math.Slot) (BeaconBlockT, error) {
+// Get retrieves the block by a given index from the store. Blocks that were
+// stored blinded are reconstructed using the execution client.
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT]) Get(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	var (
+		ctx = context.TODO()
+		blk BeaconBlockT
+	)
+
 	kv.mu.RLock()
-	defer kv.mu.RUnlock()
+	blk, err := kv.blocks.Get(ctx, slot)
+	if !errors.Is(err, sdkcollections.ErrNotFound) {
+		kv.mu.RUnlock()
+		return blk, err
+	}
+	blinded, err := kv.blindedBlocks.Get(ctx, slot)
+	kv.mu.RUnlock()
+	if err != nil {
+		return blk, err
+	}
 
-	return kv.blocks.Get(context.TODO(), slot)
+	// Reconstruct outside of the lock, since it calls out to the
+	// execution client.
+	if kv.reconstructor == nil {
+		return blk, ErrReconstructorNotSet
+	}
+	return kv.reconstructor.ReconstructBlock(ctx, blinded)
 }
 
 // Set sets the block by a given index in the store and also stores the
 // block root.
-func (kv *KVStore[BeaconBlockT]) Set(slot math.Slot, blk BeaconBlockT) error {
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT]) Set(
+	slot math.Slot,
+	blk BeaconBlockT,
+) error {
 	var (
-		ctx  = context.TODO()
-		root = blk.HashTreeRoot()
-		err  error
+		ctx     = context.TODO()
+		root    = blk.HashTreeRoot()
+		blinded BlindedBeaconBlockT
+		err     error
 	)
 
+	if kv.blinded {
+		if blinded, err = blk.Blind(); err != nil {
+			return err
+		}
+	}
+
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
 
@@ -107,13 +168,19 @@ func (kv *KVStore[BeaconBlockT]) Set(slot math.Slot, blk BeaconBlockT) error {
 		return err
 	}
 
-	// Set the block in the blocks map.
+	// Set the block in the blinded blocks map if enabled.
+	if kv.blinded {
+		kv.blindedCdc.SetActiveForkVersion(blinded.Version())
+		return kv.blindedBlocks.Set(ctx, slot, blinded)
+	}
+
+	// Otherwise set the block in the blocks map.
 	kv.cdc.SetActiveForkVersion(blk.Version())
 	return kv.blocks.Set(ctx, slot, blk)
 }
 
 // GetSlotByRoot retrieves the slot by a given root from the store.
-func (kv *KVStore[BeaconBlockT]) GetSlotByRoot(
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT]) GetSlotByRoot(
 	root common.Root,
 ) (math.Slot, error) {
 	kv.mu.RLock()
@@ -124,7 +191,7 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByRoot(
 
 // GetSlotByExecutionNumber retrieves the slot by a given execution number from
 // the store.
-func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT]) GetSlotByExecutionNumber(
 	executionNumber math.U64,
 ) (math.Slot, error) {
 	kv.mu.RLock()
@@ -134,7 +201,9 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
 }
 
 // Prune removes the [start, end) blocks from the store.
-func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT]) Prune(
+	start, end uint64,
+) error {
 	var (
 		ctx  = context.TODO()
 		s, e = math.Slot(start), math.Slot(end)
@@ -146,33 +215,59 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 	// We only return early from this loop with an error if the key
 	// passed in cannot be encoded.
 	for i := max(s, kv.earliestSlot); i < e; i++ {
-		block, err := kv.blocks.Get(ctx, i)
-		if !errors.Is(err, sdkcollections.ErrNotFound) {
-			// If block is found and still errors, exit and return.
-			if err != nil {
-				return err
-			}
+		root, executionNumber, found, err := kv.lookup(ctx, i)
+		if err != nil {
+			return err
+		}
 
+		if found {
 			// Block is found so remove from roots map.
-			root := block.HashTreeRoot()
 			if err = kv.roots.Remove(ctx, root[:]); err != nil {
 				return err
 			}
 
 			// Block is found so also remove from execution numbers map.
 			if err = kv.executionNumbers.Remove(
-				ctx, block.GetExecutionNumber(),
+				ctx, executionNumber,
 			); err != nil {
 				return err
 			}
 		}
 
-		// Finally remove the block from the blocks map.
+		// Finally remove the block from the blocks maps.
 		if err = kv.blocks.Remove(ctx, i); err != nil {
 			return err
 		}
+		if err = kv.blindedBlocks.Remove(ctx, i); err != nil {
+			return err
+		}
 	}
 
 	kv.earliestSlot = e
 	return nil
 }
+
+// lookup returns the root and execution number of the block stored at the
+// given slot, whether it was stored full or blinded. A blinded block shares
+// its hash tree root with the full block.
+func (kv *KVStore[BeaconBlockT, BlindedBeaconBlockT]) lookup(
+	ctx context.Context,
+	slot math.Slot,
+) (common.Root, math.U64, bool, error) {
+	block, err := kv.blocks.Get(ctx, slot)
+	switch {
+	case err == nil:
+		return block.HashTreeRoot(), block.GetExecutionNumber(), true, nil
+	case !errors.Is(err, sdkcollections.ErrNotFound):
+		return common.Root{}, 0, false, err
+	}
+
+	blinded, err := kv.blindedBlocks.Get(ctx, slot)
+	switch {
+	case err == nil:
+		return blinded.HashTreeRoot(), blinded.GetExecutionNumber(), true, nil
+	case !errors.Is(err, sdkcollections.ErrNotFound):
+		return common.Root{}, 0, false, err
+	}
+	return common.Root{}, 0, false, nil
+}
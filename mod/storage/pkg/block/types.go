@@ -21,15 +21,40 @@
 package block
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-type BeaconBlock[T any] interface {
+type BeaconBlock[T, BlindedBeaconBlockT any] interface {
+	constraints.SSZMarshallable
+	NewFromSSZ(bz []byte, version uint32) (T, error)
+	Version() uint32
+	HashTreeRoot() common.Root
+	GetExecutionNumber() math.U64
+	// Blind returns the block with its execution payload replaced by the
+	// corresponding execution payload header.
+	Blind() (BlindedBeaconBlockT, error)
+}
+
+// BlindedBeaconBlock is a beacon block that only carries the header of its
+// execution payload.
+type BlindedBeaconBlock[T any] interface {
 	constraints.SSZMarshallable
 	NewFromSSZ(bz []byte, version uint32) (T, error)
 	Version() uint32
 	HashTreeRoot() common.Root
 	GetExecutionNumber() math.U64
 }
+
+// Reconstructor rebuilds full beacon blocks from their blinded counterpart.
+type Reconstructor[BeaconBlockT, BlindedBeaconBlockT any] interface {
+	// ReconstructBlock fetches the execution payload body of the given
+	// blinded block and returns the full block.
+	ReconstructBlock(
+		ctx context.Context,
+		blk BlindedBeaconBlockT,
+	) (BeaconBlockT, error)
+}
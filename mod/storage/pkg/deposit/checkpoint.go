@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// checkpointSize is the size of an encoded checkpoint: the block number, the
+// block hash, the index of the first deposit and the number of deposits.
+const checkpointSize = 8 + 32 + 8 + 8
+
+// checkpoint records the last execution block of an ingested log range
+// together with the deposits that were read from it.
+type checkpoint struct {
+	// Number is the number of the last block of the range.
+	Number uint64 `json:"number"`
+	// Hash is the hash of the last block of the range.
+	Hash common.ExecutionHash `json:"hash"`
+	// FirstIndex is the index of the first deposit read from the range.
+	FirstIndex uint64 `json:"first_index"`
+	// NumDeposits is the number of deposits read from the range.
+	NumDeposits uint64 `json:"num_deposits"`
+}
+
+// checkpointCodec is the collections value codec for checkpoints.
+type checkpointCodec struct{}
+
+// Encode encodes the checkpoint into a fixed size byte slice.
+func (checkpointCodec) Encode(c checkpoint) ([]byte, error) {
+	bz := make([]byte, 0, checkpointSize)
+	bz = binary.BigEndian.AppendUint64(bz, c.Number)
+	bz = append(bz, c.Hash[:]...)
+	bz = binary.BigEndian.AppendUint64(bz, c.FirstIndex)
+	return binary.BigEndian.AppendUint64(bz, c.NumDeposits), nil
+}
+
+// Decode decodes a checkpoint encoded by Encode.
+func (checkpointCodec) Decode(bz []byte) (checkpoint, error) {
+	if len(bz) != checkpointSize {
+		return checkpoint{}, ErrInvalidCheckpoint
+	}
+	//nolint:mnd // offsets follow checkpointSize.
+	return checkpoint{
+		Number:      binary.BigEndian.Uint64(bz[0:8]),
+		Hash:        common.ExecutionHash(bz[8:40]),
+		FirstIndex:  binary.BigEndian.Uint64(bz[40:48]),
+		NumDeposits: binary.BigEndian.Uint64(bz[48:56]),
+	}, nil
+}
+
+// EncodeJSON encodes the checkpoint as JSON.
+func (checkpointCodec) EncodeJSON(c checkpoint) ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// DecodeJSON decodes a checkpoint from JSON.
+func (checkpointCodec) DecodeJSON(bz []byte) (checkpoint, error) {
+	var c checkpoint
+	return c, json.Unmarshal(bz, &c)
+}
+
+// Stringify returns a human readable representation of the checkpoint.
+func (checkpointCodec) Stringify(c checkpoint) string {
+	return fmt.Sprintf("%+v", c)
+}
+
+// ValueType returns the name of the encoded type.
+func (checkpointCodec) ValueType() string {
+	return "deposit.checkpoint"
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"errors"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// GetSyncCursor returns the number and hash of the last execution block whose
+// deposit logs were ingested. The zero hash is returned if no log range has
+// been ingested yet.
+func (kv *KVStore[DepositT]) GetSyncCursor() (
+	uint64, common.ExecutionHash, error,
+) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	c, err := kv.cursor.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, common.ExecutionHash{}, nil
+	}
+	return c.Number, c.Hash, err
+}
+
+// IngestRange stores the deposits read from a log range ending at the given
+// block and advances the sync cursor to that block.
+func (kv *KVStore[DepositT]) IngestRange(
+	number uint64,
+	hash common.ExecutionHash,
+	deposits []DepositT,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	c := checkpoint{Number: number, Hash: hash}
+	for _, deposit := range deposits {
+		if err := kv.setDeposit(deposit); err != nil {
+			return err
+		}
+	}
+	if len(deposits) > 0 {
+		c.FirstIndex = uint64(deposits[0].GetIndex())
+		c.NumDeposits = uint64(len(deposits))
+		if err := kv.checkpoints.Set(context.TODO(), number, c); err != nil {
+			return err
+		}
+	}
+	return kv.cursor.Set(context.TODO(), c)
+}
+
+// PreviousCheckpoint returns the number and hash of the last block of the
+// most recent ingested log range that ends before the given block and
+// contained deposits. The zero hash is returned if there is none.
+func (kv *KVStore[DepositT]) PreviousCheckpoint(number uint64) (
+	uint64, common.ExecutionHash, error,
+) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.checkpoints.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndExclusive(number).Descending(),
+	)
+	if err != nil {
+		return 0, common.ExecutionHash{}, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, common.ExecutionHash{}, nil
+	}
+	c, err := iter.Value()
+	return c.Number, c.Hash, err
+}
+
+// Rollback removes the deposits read from log ranges ending after the given
+// block and rewinds the sync cursor to it. Rolling back to the zero hash
+// resets the cursor. It returns the number of deposits removed.
+func (kv *KVStore[DepositT]) Rollback(
+	number uint64,
+	hash common.ExecutionHash,
+) (uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	stale, err := kv.checkpoints.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).StartExclusive(number),
+	)
+	if err != nil {
+		return 0, err
+	}
+	checkpoints, err := stale.Values()
+	if err != nil {
+		return 0, err
+	}
+
+	var removed uint64
+	for _, c := range checkpoints {
+		for i := range c.NumDeposits {
+			if err = kv.store.Remove(
+				context.TODO(), c.FirstIndex+i,
+			); err != nil {
+				return removed, err
+			}
+		}
+		removed += c.NumDeposits
+		if err = kv.checkpoints.Remove(context.TODO(), c.Number); err != nil {
+			return removed, err
+		}
+	}
+
+	if hash == (common.ExecutionHash{}) {
+		return removed, kv.cursor.Remove(context.TODO())
+	}
+	return removed, kv.cursor.Set(
+		context.TODO(), checkpoint{Number: number, Hash: hash},
+	)
+}
+
+// pruneCheckpoints removes the checkpoints of log ranges whose deposits all
+// have an index below the given one.
+func (kv *KVStore[DepositT]) pruneCheckpoints(index uint64) error {
+	iter, err := kv.checkpoints.Iterate(context.TODO(), nil)
+	if err != nil {
+		return err
+	}
+	checkpoints, err := iter.Values()
+	if err != nil {
+		return err
+	}
+	for _, c := range checkpoints {
+		if c.FirstIndex+c.NumDeposits > index {
+			return nil
+		}
+		if err = kv.checkpoints.Remove(context.TODO(), c.Number); err != nil {
+			return err
+		}
+	}
+	return nil
+}
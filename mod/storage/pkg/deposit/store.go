@@ -30,13 +30,22 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix    = "deposit"
+	KeyCheckpointPrefix = "checkpoint"
+	KeySyncCursorPrefix = "sync_cursor"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// checkpoints maps the last block number of every ingested log range
+	// that contained deposits to the checkpoint of that range.
+	checkpoints sdkcollections.Map[uint64, checkpoint]
+	// cursor is the checkpoint of the last ingested log range.
+	cursor sdkcollections.Item[checkpoint]
+	mu     sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -52,6 +61,19 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		checkpoints: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyCheckpointPrefix)),
+			KeyCheckpointPrefix,
+			sdkcollections.Uint64Key,
+			checkpointCodec{},
+		),
+		cursor: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySyncCursorPrefix)),
+			KeySyncCursorPrefix,
+			checkpointCodec{},
+		),
 	}
 }
 
@@ -102,7 +124,8 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), uint64(deposit.GetIndex()), deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
+// Prune removes the [start, end) deposits from the store, along with the
+// checkpoints of log ranges whose deposits have all been pruned.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
@@ -112,5 +135,5 @@ func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 			return err
 		}
 	}
-	return nil
+	return kv.pruneCheckpoints(start + end)
 }
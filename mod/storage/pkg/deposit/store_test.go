@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// kvStoreService serves the same in-memory store for every context.
+type kvStoreService struct {
+	store.KVStore
+}
+
+func (s kvStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.KVStore
+}
+
+// testDeposit is a deposit identified by its index only.
+type testDeposit struct {
+	index uint64
+}
+
+func (*testDeposit) Empty() *testDeposit {
+	return new(testDeposit)
+}
+
+func (d *testDeposit) GetIndex() math.U64 {
+	return math.U64(d.index)
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, d.index), nil
+}
+
+func (d *testDeposit) UnmarshalSSZ(bz []byte) error {
+	d.index = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func newStore() *deposit.KVStore[*testDeposit] {
+	return deposit.NewStore[*testDeposit](
+		kvStoreService{coretesting.NewMemKV()},
+	)
+}
+
+// blockHash returns the hash of the execution block with the given number.
+func blockHash(number uint64) common.ExecutionHash {
+	return common.ExecutionHash{byte(number)}
+}
+
+// deposits returns the deposits with indexes in [start, end).
+func deposits(start, end uint64) []*testDeposit {
+	ds := make([]*testDeposit, 0, end-start)
+	for i := start; i < end; i++ {
+		ds = append(ds, &testDeposit{index: i})
+	}
+	return ds
+}
+
+// ingest ingests the log ranges ending at blocks 10, 20 and 30, containing
+// the deposits [0, 2), [2, 5) and [5, 6) respectively, and a log range
+// ending at block 40 without deposits.
+func ingest(t *testing.T, kv *deposit.KVStore[*testDeposit]) {
+	t.Helper()
+	require.NoError(t, kv.IngestRange(10, blockHash(10), deposits(0, 2)))
+	require.NoError(t, kv.IngestRange(20, blockHash(20), deposits(2, 5)))
+	require.NoError(t, kv.IngestRange(30, blockHash(30), deposits(5, 6)))
+	require.NoError(t, kv.IngestRange(40, blockHash(40), nil))
+}
+
+// requireIndexes asserts the indexes of the deposits in the store.
+func requireIndexes(
+	t *testing.T,
+	kv *deposit.KVStore[*testDeposit],
+	start uint64,
+	want ...uint64,
+) {
+	t.Helper()
+	ds, err := kv.GetDepositsByIndex(start, 16)
+	require.NoError(t, err)
+	var got []uint64
+	for _, d := range ds {
+		got = append(got, d.GetIndex().Unwrap())
+	}
+	require.Equal(t, want, got)
+}
+
+// requireCursor asserts the sync cursor of the store.
+func requireCursor(
+	t *testing.T,
+	kv *deposit.KVStore[*testDeposit],
+	number uint64,
+	hash common.ExecutionHash,
+) {
+	t.Helper()
+	gotNumber, gotHash, err := kv.GetSyncCursor()
+	require.NoError(t, err)
+	require.Equal(t, number, gotNumber)
+	require.Equal(t, hash, gotHash)
+}
+
+// requirePreviousCheckpoint asserts the checkpoint preceding the given block.
+func requirePreviousCheckpoint(
+	t *testing.T,
+	kv *deposit.KVStore[*testDeposit],
+	before uint64,
+	number uint64,
+	hash common.ExecutionHash,
+) {
+	t.Helper()
+	gotNumber, gotHash, err := kv.PreviousCheckpoint(before)
+	require.NoError(t, err)
+	require.Equal(t, number, gotNumber)
+	require.Equal(t, hash, gotHash)
+}
+
+func TestIngestRange(t *testing.T) {
+	kv := newStore()
+	requireCursor(t, kv, 0, common.ExecutionHash{})
+	requirePreviousCheckpoint(t, kv, 100, 0, common.ExecutionHash{})
+
+	ingest(t, kv)
+	requireIndexes(t, kv, 0, 0, 1, 2, 3, 4, 5)
+	requireCursor(t, kv, 40, blockHash(40))
+
+	// Only the log ranges containing deposits are checkpoints, the end of
+	// the range is exclusive.
+	requirePreviousCheckpoint(t, kv, 100, 30, blockHash(30))
+	requirePreviousCheckpoint(t, kv, 30, 20, blockHash(20))
+	requirePreviousCheckpoint(t, kv, 11, 10, blockHash(10))
+	requirePreviousCheckpoint(t, kv, 10, 0, common.ExecutionHash{})
+}
+
+func TestRollbackAcrossCheckpoints(t *testing.T) {
+	kv := newStore()
+	ingest(t, kv)
+
+	// Rolling back into the second range removes the deposits of every
+	// range ending after the given block.
+	removed, err := kv.Rollback(15, blockHash(15))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), removed)
+	requireIndexes(t, kv, 0, 0, 1)
+	requireCursor(t, kv, 15, blockHash(15))
+	requirePreviousCheckpoint(t, kv, 100, 10, blockHash(10))
+
+	// The reorged blocks are ingested again from the cursor.
+	require.NoError(t, kv.IngestRange(25, blockHash(25), deposits(2, 4)))
+	requireIndexes(t, kv, 0, 0, 1, 2, 3)
+	requireCursor(t, kv, 25, blockHash(25))
+	requirePreviousCheckpoint(t, kv, 100, 25, blockHash(25))
+
+	// Rolling back to a checkpoint keeps its deposits.
+	removed, err = kv.Rollback(10, blockHash(10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), removed)
+	requireIndexes(t, kv, 0, 0, 1)
+	requireCursor(t, kv, 10, blockHash(10))
+}
+
+func TestRollbackToZeroHash(t *testing.T) {
+	kv := newStore()
+	ingest(t, kv)
+
+	removed, err := kv.Rollback(0, common.ExecutionHash{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), removed)
+	requireIndexes(t, kv, 0)
+	requireCursor(t, kv, 0, common.ExecutionHash{})
+	requirePreviousCheckpoint(t, kv, 100, 0, common.ExecutionHash{})
+}
+
+func TestPruneRemovesCheckpoints(t *testing.T) {
+	kv := newStore()
+	ingest(t, kv)
+
+	// Pruning part of the second range only removes the checkpoint of the
+	// first one.
+	require.NoError(t, kv.Prune(0, 3))
+	requireIndexes(t, kv, 3, 3, 4, 5)
+	requirePreviousCheckpoint(t, kv, 20, 0, common.ExecutionHash{})
+	requirePreviousCheckpoint(t, kv, 30, 20, blockHash(20))
+	requireCursor(t, kv, 40, blockHash(40))
+
+	// A rollback below the pruned checkpoints removes the deposits of the
+	// remaining ones.
+	removed, err := kv.Rollback(10, blockHash(10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), removed)
+	requireIndexes(t, kv, 3)
+	requirePreviousCheckpoint(t, kv, 100, 0, common.ExecutionHash{})
+
+	// Pruning every deposit removes every checkpoint.
+	kv = newStore()
+	ingest(t, kv)
+	require.NoError(t, kv.Prune(0, 6))
+	requireIndexes(t, kv, 0)
+	requirePreviousCheckpoint(t, kv, 100, 0, common.ExecutionHash{})
+}
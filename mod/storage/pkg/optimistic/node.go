@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package optimistic
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Status is the execution status of a beacon block as far as the
+// execution client has been able to tell us.
+type Status uint8
+
+const (
+	// StatusUnknown is the status of a payload the execution client has not
+	// reported on.
+	StatusUnknown Status = iota
+	// StatusOptimistic is the status of a block that was imported before its
+	// payload was verified by the execution client.
+	StatusOptimistic
+	// StatusValid is the status of a block whose payload was verified by the
+	// execution client.
+	StatusValid
+	// StatusInvalid is the status of a block whose payload, or the payload of
+	// one of its ancestors, was rejected by the execution client.
+	StatusInvalid
+)
+
+// String returns the string representation of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusOptimistic:
+		return "optimistic"
+	case StatusValid:
+		return "valid"
+	case StatusInvalid:
+		return "invalid"
+	default:
+		return "unknown"
+	}
+}
+
+// nodeLength is the length of an encoded node.
+const nodeLength = 8 + 32 + 32 + 1
+
+// node is a beacon block that is tracked by the store.
+type node struct {
+	// Slot is the slot of the block.
+	Slot math.Slot
+	// ParentRoot is the root of the parent block.
+	ParentRoot common.Root
+	// ExecutionHash is the hash of the execution payload in the block.
+	ExecutionHash common.ExecutionHash
+	// Status is the execution status of the block.
+	Status Status
+}
+
+// nodeCodec encodes nodes into a fixed width binary format.
+type nodeCodec struct{}
+
+// Encode encodes the node.
+func (nodeCodec) Encode(n node) ([]byte, error) {
+	bz := make([]byte, nodeLength)
+	binary.BigEndian.PutUint64(bz[:8], n.Slot.Unwrap())
+	copy(bz[8:40], n.ParentRoot[:])
+	copy(bz[40:72], n.ExecutionHash[:])
+	bz[72] = byte(n.Status)
+	return bz, nil
+}
+
+// Decode decodes the node.
+func (nodeCodec) Decode(bz []byte) (node, error) {
+	if len(bz) != nodeLength {
+		return node{}, errors.Wrapf(
+			ErrInvalidNodeLength, "expected %d, got %d", nodeLength, len(bz),
+		)
+	}
+	return node{
+		Slot:          math.Slot(binary.BigEndian.Uint64(bz[:8])),
+		ParentRoot:    common.Root(bz[8:40]),
+		ExecutionHash: common.ExecutionHash(bz[40:72]),
+		Status:        Status(bz[72]),
+	}, nil
+}
+
+// EncodeJSON is not implemented and will panic if called.
+func (nodeCodec) EncodeJSON(node) ([]byte, error) {
+	panic("not implemented")
+}
+
+// DecodeJSON is not implemented and will panic if called.
+func (nodeCodec) DecodeJSON([]byte) (node, error) {
+	panic("not implemented")
+}
+
+// Stringify returns the string representation of the node.
+func (nodeCodec) Stringify(n node) string {
+	return fmt.Sprintf(
+		"node{slot: %d, parent: %s, payload: %s, status: %s}",
+		n.Slot, n.ParentRoot, n.ExecutionHash, n.Status,
+	)
+}
+
+// ValueType returns the name of the type this codec is intended for.
+func (nodeCodec) ValueType() string {
+	return "optimistic.node"
+}
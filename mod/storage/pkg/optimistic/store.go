@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package optimistic
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// maxPendingPayloads is the maximum number of payload statuses kept around
+// for blocks that have not been imported yet.
+const maxPendingPayloads = 128
+
+// Store tracks beacon blocks that were imported optimistically, following
+// the optimistic sync design of the consensus specs.
+//
+// Only blocks that are optimistic or invalid are persisted, blocks that are
+// absent from the store are considered to be fully verified. Once the
+// execution client verifies a payload, the block carrying it and all of its
+// optimistic ancestors are removed from the store.
+type Store struct {
+	// blocks maps block roots to the tracked blocks.
+	blocks sdkcollections.Map[[]byte, node]
+	// payloads maps execution block hashes to the tracked block roots.
+	payloads sdkcollections.Map[[]byte, []byte]
+	// pending holds the statuses reported by the execution client for
+	// payloads whose blocks have not been imported yet.
+	pending map[common.ExecutionHash]Status
+	// pendingOrder holds the hashes of the pending payloads, oldest first.
+	pendingOrder []common.ExecutionHash
+	mu           sync.RWMutex
+}
+
+// NewStore creates a new optimistic block store.
+func NewStore(kvsp store.KVStoreService) *Store {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &Store{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{BlocksKeyPrefix}),
+			BlocksMapName,
+			sdkcollections.BytesKey,
+			nodeCodec{},
+		),
+		payloads: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{PayloadsKeyPrefix}),
+			PayloadsMapName,
+			sdkcollections.BytesKey,
+			sdkcollections.BytesValue,
+		),
+		pending: make(map[common.ExecutionHash]Status),
+	}
+}
+
+// MarkPayloadOptimistic records that the execution client was not able to
+// verify the payload with the given hash.
+func (s *Store) MarkPayloadOptimistic(hash common.ExecutionHash) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setPending(hash, StatusOptimistic)
+}
+
+// MarkPayloadValid records that the execution client verified the payload
+// with the given hash. If the block carrying the payload was imported
+// optimistically, it and all of its ancestors are marked as valid.
+func (s *Store) MarkPayloadValid(hash common.ExecutionHash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	root, found, err := s.rootByPayload(hash)
+	if err != nil {
+		return err
+	} else if !found {
+		s.setPending(hash, StatusValid)
+		return nil
+	}
+	return s.validate(root)
+}
+
+// MarkPayloadInvalid records that the execution client rejected the payload
+// with the given hash. If the block carrying the payload was imported
+// optimistically, it is marked as invalid along with every optimistic
+// ancestor that descends from the block carrying the latest valid hash. A
+// nil latest valid hash only invalidates the block itself.
+func (s *Store) MarkPayloadInvalid(
+	hash common.ExecutionHash,
+	latestValidHash *common.ExecutionHash,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	root, found, err := s.rootByPayload(hash)
+	if err != nil {
+		return err
+	} else if !found {
+		s.setPending(hash, StatusInvalid)
+	} else if err = s.invalidate(root, latestValidHash); err != nil {
+		return err
+	}
+
+	if latestValidHash == nil {
+		return nil
+	}
+	if root, found, err = s.rootByPayload(*latestValidHash); err != nil {
+		return err
+	} else if found {
+		return s.validate(root)
+	}
+	return nil
+}
+
+// ImportBlock records the import of a beacon block, using the status that
+// the execution client reported for its payload and the status of its
+// parent to decide whether the block needs to be tracked.
+func (s *Store) ImportBlock(
+	root common.Root,
+	slot math.Slot,
+	parentRoot common.Root,
+	executionHash common.ExecutionHash,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// A block with an empty payload keeps the execution head of its parent,
+	// it is not indexed by execution hash and is only ever verified along
+	// with its descendants.
+	status, empty := StatusUnknown, executionHash == common.ExecutionHash{}
+	if !empty {
+		status = s.takePending(executionHash)
+	}
+
+	parentStatus, err := s.status(parentRoot)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case parentStatus == StatusInvalid:
+		// A block building on an invalid block is invalid itself.
+		status = StatusInvalid
+	case status == StatusValid:
+		// A valid payload implies that every ancestor is valid as well.
+		return s.validate(parentRoot)
+	case status == StatusUnknown && parentStatus == StatusOptimistic:
+		// A block building on an optimistic block is optimistic itself.
+		status = StatusOptimistic
+	case status == StatusUnknown:
+		return nil
+	}
+
+	if err = s.blocks.Set(context.TODO(), root[:], node{
+		Slot:          slot,
+		ParentRoot:    parentRoot,
+		ExecutionHash: executionHash,
+		Status:        status,
+	}); err != nil {
+		return err
+	}
+	if empty {
+		return nil
+	}
+	return s.payloads.Set(
+		context.TODO(), executionHash[:], root[:],
+	)
+}
+
+// Status returns the execution status of the block with the given root.
+// Blocks that are not tracked by the store are considered valid.
+func (s *Store) Status(root common.Root) (Status, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status(root)
+}
+
+// IsOptimistic returns true if the block with the given root was imported
+// optimistically and has not been verified yet.
+func (s *Store) IsOptimistic(root common.Root) (bool, error) {
+	status, err := s.Status(root)
+	return status == StatusOptimistic, err
+}
+
+// IsInvalid returns true if the block with the given root, or one of its
+// ancestors, was rejected by the execution client.
+func (s *Store) IsInvalid(root common.Root) (bool, error) {
+	status, err := s.Status(root)
+	return status == StatusInvalid, err
+}
+
+// status walks the tracked ancestry of the block with the given root and
+// returns its execution status.
+func (s *Store) status(root common.Root) (Status, error) {
+	status := StatusValid
+	for {
+		n, found, err := s.getNode(root)
+		if err != nil {
+			return StatusUnknown, err
+		} else if !found {
+			return status, nil
+		}
+
+		if n.Status == StatusInvalid {
+			return StatusInvalid, nil
+		}
+		status = StatusOptimistic
+		root = n.ParentRoot
+	}
+}
+
+// validate removes the block with the given root and all of its optimistic
+// ancestors from the store.
+func (s *Store) validate(root common.Root) error {
+	for {
+		n, found, err := s.getNode(root)
+		if err != nil {
+			return err
+		} else if !found || n.Status != StatusOptimistic {
+			return nil
+		}
+
+		if err = s.blocks.Remove(context.TODO(), root[:]); err != nil {
+			return err
+		}
+		if err = s.payloads.Remove(
+			context.TODO(), n.ExecutionHash[:],
+		); err != nil {
+			return err
+		}
+		root = n.ParentRoot
+	}
+}
+
+// invalidate marks the block with the given root as invalid, along with
+// its optimistic ancestors up to the block carrying the latest valid hash.
+func (s *Store) invalidate(
+	root common.Root,
+	latestValidHash *common.ExecutionHash,
+) error {
+	for {
+		n, found, err := s.getNode(root)
+		if err != nil {
+			return err
+		} else if !found || n.Status == StatusInvalid {
+			return nil
+		}
+
+		if latestValidHash != nil && n.ExecutionHash == *latestValidHash {
+			return nil
+		}
+
+		n.Status = StatusInvalid
+		if err = s.blocks.Set(context.TODO(), root[:], n); err != nil {
+			return err
+		}
+
+		if latestValidHash == nil {
+			return nil
+		}
+		root = n.ParentRoot
+	}
+}
+
+// getNode returns the tracked block with the given root, if any.
+func (s *Store) getNode(root common.Root) (node, bool, error) {
+	n, err := s.blocks.Get(context.TODO(), root[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return node{}, false, nil
+	}
+	return n, err == nil, err
+}
+
+// rootByPayload returns the root of the tracked block carrying the payload
+// with the given hash, if any.
+func (s *Store) rootByPayload(
+	hash common.ExecutionHash,
+) (common.Root, bool, error) {
+	root, err := s.payloads.Get(context.TODO(), hash[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return common.Root{}, false, nil
+	} else if err != nil {
+		return common.Root{}, false, err
+	}
+	return common.Root(root), true, nil
+}
+
+// setPending records the status of a payload whose block has not been
+// imported yet. Once the pending set is full, the status of the oldest
+// payload is dropped, as its block is the least likely to be imported.
+func (s *Store) setPending(hash common.ExecutionHash, status Status) {
+	if _, ok := s.pending[hash]; !ok {
+		if len(s.pendingOrder) >= maxPendingPayloads {
+			delete(s.pending, s.pendingOrder[0])
+			s.pendingOrder = s.pendingOrder[1:]
+		}
+		s.pendingOrder = append(s.pendingOrder, hash)
+	}
+	s.pending[hash] = status
+}
+
+// takePending removes and returns the status recorded for a payload whose
+// block is being imported.
+func (s *Store) takePending(hash common.ExecutionHash) Status {
+	status, ok := s.pending[hash]
+	if !ok {
+		return StatusUnknown
+	}
+	delete(s.pending, hash)
+	s.pendingOrder = slices.DeleteFunc(
+		s.pendingOrder, func(h common.ExecutionHash) bool {
+			return h == hash
+		},
+	)
+	return status
+}
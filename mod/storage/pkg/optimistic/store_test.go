@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package optimistic_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/optimistic"
+	"github.com/stretchr/testify/require"
+)
+
+// kvStoreService serves the same in-memory store for every context.
+type kvStoreService struct {
+	store.KVStore
+}
+
+func (s kvStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.KVStore
+}
+
+func newStore() *optimistic.Store {
+	return optimistic.NewStore(kvStoreService{coretesting.NewMemKV()})
+}
+
+// testBlock is a beacon block identified by a single byte, carrying a
+// payload identified by the same byte.
+type testBlock byte
+
+func (b testBlock) root() common.Root {
+	return common.Root{byte(b)}
+}
+
+func (b testBlock) hash() common.ExecutionHash {
+	return common.ExecutionHash{byte(b)}
+}
+
+// importChain imports the given blocks, each building on the previous one
+// and the first building on a verified block.
+func importChain(t *testing.T, s *optimistic.Store, blocks ...testBlock) {
+	t.Helper()
+	parent := common.Root{0xff}
+	for i, b := range blocks {
+		require.NoError(t, s.ImportBlock(
+			b.root(), math.Slot(i+1), parent, b.hash(),
+		))
+		parent = b.root()
+	}
+}
+
+// requireStatus asserts the status of the given blocks.
+func requireStatus(
+	t *testing.T,
+	s *optimistic.Store,
+	want optimistic.Status,
+	blocks ...testBlock,
+) {
+	t.Helper()
+	for _, b := range blocks {
+		status, err := s.Status(b.root())
+		require.NoError(t, err)
+		require.Equal(t, want, status, "block %d", b)
+	}
+}
+
+func TestStoreDoesNotTrackVerifiedBlocks(t *testing.T) {
+	s := newStore()
+	importChain(t, s, 1, 2)
+	requireStatus(t, s, optimistic.StatusValid, 1, 2)
+}
+
+func TestStoreValidatesOptimisticAncestors(t *testing.T) {
+	s := newStore()
+	s.MarkPayloadOptimistic(testBlock(1).hash())
+	importChain(t, s, 1, 2, 3)
+
+	// Descendants of an optimistic block are optimistic themselves.
+	requireStatus(t, s, optimistic.StatusOptimistic, 1, 2, 3)
+	isOptimistic, err := s.IsOptimistic(testBlock(3).root())
+	require.NoError(t, err)
+	require.True(t, isOptimistic)
+
+	// A valid payload validates every ancestor.
+	require.NoError(t, s.MarkPayloadValid(testBlock(2).hash()))
+	requireStatus(t, s, optimistic.StatusValid, 1, 2)
+	requireStatus(t, s, optimistic.StatusOptimistic, 3)
+}
+
+func TestStoreInvalidatesUpToLatestValidHash(t *testing.T) {
+	s := newStore()
+	s.MarkPayloadOptimistic(testBlock(1).hash())
+	importChain(t, s, 1, 2, 3)
+
+	latestValid := testBlock(1).hash()
+	require.NoError(t, s.MarkPayloadInvalid(testBlock(3).hash(), &latestValid))
+	requireStatus(t, s, optimistic.StatusValid, 1)
+	requireStatus(t, s, optimistic.StatusInvalid, 2, 3)
+
+	// A block building on an invalid block is invalid itself.
+	require.NoError(t, s.ImportBlock(
+		testBlock(4).root(), 4, testBlock(3).root(), testBlock(4).hash(),
+	))
+	invalid, err := s.IsInvalid(testBlock(4).root())
+	require.NoError(t, err)
+	require.True(t, invalid)
+}
+
+func TestStoreInvalidatesOnlyBlockWithoutLatestValidHash(t *testing.T) {
+	s := newStore()
+	s.MarkPayloadOptimistic(testBlock(1).hash())
+	importChain(t, s, 1, 2)
+
+	require.NoError(t, s.MarkPayloadInvalid(testBlock(2).hash(), nil))
+	requireStatus(t, s, optimistic.StatusOptimistic, 1)
+	requireStatus(t, s, optimistic.StatusInvalid, 2)
+}
+
+func TestStoreAppliesStatusReportedBeforeImport(t *testing.T) {
+	s := newStore()
+	s.MarkPayloadOptimistic(testBlock(1).hash())
+	importChain(t, s, 1)
+
+	// The payload of the next block is verified before the block is
+	// imported, which validates its ancestors on import.
+	require.NoError(t, s.MarkPayloadValid(testBlock(2).hash()))
+	importChain(t, s, 1, 2)
+	requireStatus(t, s, optimistic.StatusValid, 1, 2)
+
+	// An invalid payload reported before import marks its block invalid.
+	require.NoError(t, s.MarkPayloadInvalid(testBlock(3).hash(), nil))
+	require.NoError(t, s.ImportBlock(
+		testBlock(3).root(), 3, testBlock(2).root(), testBlock(3).hash(),
+	))
+	requireStatus(t, s, optimistic.StatusInvalid, 3)
+}
+
+func TestStoreTracksEmptyPayloadsWithDescendants(t *testing.T) {
+	s := newStore()
+	s.MarkPayloadOptimistic(testBlock(1).hash())
+	importChain(t, s, 1)
+
+	// Blocks carrying an empty payload take the status of their parent.
+	for b := testBlock(2); b <= 3; b++ {
+		require.NoError(t, s.ImportBlock(
+			b.root(), math.Slot(b), (b-1).root(), common.ExecutionHash{},
+		))
+	}
+	requireStatus(t, s, optimistic.StatusOptimistic, 1, 2, 3)
+
+	// They are not indexed by execution hash, but verified along with the
+	// payload of a descendant.
+	require.NoError(t, s.MarkPayloadValid(common.ExecutionHash{}))
+	requireStatus(t, s, optimistic.StatusOptimistic, 2, 3)
+	require.NoError(t, s.ImportBlock(
+		testBlock(4).root(), 4, testBlock(3).root(), testBlock(4).hash(),
+	))
+	require.NoError(t, s.MarkPayloadValid(testBlock(4).hash()))
+	requireStatus(t, s, optimistic.StatusValid, 1, 2, 3, 4)
+}
+
+func TestStoreEvictsOldestPendingStatus(t *testing.T) {
+	s := newStore()
+
+	// Fill the pending set, reporting the first payload twice.
+	for i := range optimistic.MaxPendingPayloads {
+		s.MarkPayloadOptimistic(common.ExecutionHash{0x01, byte(i)})
+	}
+	s.MarkPayloadOptimistic(common.ExecutionHash{0x01, 0x00})
+
+	// One more payload evicts the oldest status only.
+	s.MarkPayloadOptimistic(common.ExecutionHash{0x02})
+	for i, hash := range []common.ExecutionHash{
+		{0x01, 0x00},
+		{0x01, 0x01},
+		{0x02},
+	} {
+		root := common.Root{byte(i + 1)}
+		require.NoError(t, s.ImportBlock(root, 1, common.Root{0xff}, hash))
+		isOptimistic, err := s.IsOptimistic(root)
+		require.NoError(t, err)
+		require.Equal(t, i > 0, isOptimistic, "payload %s", hash)
+	}
+}